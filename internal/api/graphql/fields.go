@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,8 @@ const (
 	daysPerWeek = 7
 	// daysPerMonth is an approximate number of days per month
 	daysPerMonth = 30
+	// iterationDateLayout is the date format of iteration start dates
+	iterationDateLayout = "2006-01-02"
 )
 
 // Field creation mutations and queries
@@ -31,6 +34,48 @@ type UpdateFieldMutation struct {
 	} `graphql:"updateProjectV2Field(input: $input)"`
 }
 
+// UpdateIterationFieldMutation represents the updateProjectV2Field mutation for iteration fields
+type UpdateIterationFieldMutation struct {
+	UpdateProjectV2Field struct {
+		ProjectV2Field struct {
+			IterationField ProjectV2IterationField `graphql:"... on ProjectV2IterationField"`
+		} `graphql:"projectV2Field"`
+	} `graphql:"updateProjectV2Field(input: $input)"`
+}
+
+// GetIterationFieldQuery fetches an iteration field and its iterations by field ID
+type GetIterationFieldQuery struct {
+	Node struct {
+		IterationField ProjectV2IterationField `graphql:"... on ProjectV2IterationField"`
+	} `graphql:"node(id: $fieldId)"`
+}
+
+// GetSingleSelectFieldQuery fetches a single select field and all of its options by field ID
+type GetSingleSelectFieldQuery struct {
+	Node struct {
+		SingleSelectField struct {
+			ID      string                             `graphql:"id"`
+			Name    string                             `graphql:"name"`
+			Options []ProjectV2SingleSelectFieldOption `graphql:"options"`
+		} `graphql:"... on ProjectV2SingleSelectField"`
+	} `graphql:"node(id: $fieldId)"`
+}
+
+// ProjectV2IterationField represents an iteration field with its configuration
+type ProjectV2IterationField struct {
+	ID            string                          `graphql:"id"`
+	Name          string                          `graphql:"name"`
+	Configuration ProjectV2IterationConfiguration `graphql:"configuration"`
+}
+
+// ProjectV2IterationConfiguration represents the iterations and cadence of an iteration field
+type ProjectV2IterationConfiguration struct {
+	Iterations          []ProjectV2IterationInfo `graphql:"iterations"`
+	CompletedIterations []ProjectV2IterationInfo `graphql:"completedIterations"`
+	Duration            int                      `graphql:"duration"`
+	StartDay            int                      `graphql:"startDay"`
+}
+
 // DeleteFieldMutation represents the deleteProjectV2Field mutation
 type DeleteFieldMutation struct {
 	DeleteProjectV2Field struct {
@@ -66,11 +111,38 @@ type CreateFieldInput struct {
 	DataType            ProjectV2FieldDataType `json:"dataType"`
 	SingleSelectOptions []string               `json:"singleSelectOptions,omitempty"`
 	Duration            string                 `json:"duration,omitempty"`
+	StartDate           string                 `json:"startDate,omitempty"`
 }
 
 type UpdateFieldInput struct {
-	Name    *string `json:"name,omitempty"`
-	FieldID string  `json:"fieldId"`
+	Name                   *string                      `json:"name,omitempty"`
+	IterationConfiguration *IterationConfigurationInput `json:"iterationConfiguration,omitempty"`
+	FieldID                string                       `json:"fieldId"`
+	SingleSelectOptions    []SingleSelectOptionInput    `json:"singleSelectOptions,omitempty"`
+}
+
+// SingleSelectOptionInput describes an option in the full option list of a single select field.
+// Options with an ID keep their identity, and the item values that use them, when the list is replaced.
+type SingleSelectOptionInput struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// IterationConfigurationInput replaces the iterations of an iteration field.
+// Duration is the default length of new iterations in days.
+type IterationConfigurationInput struct {
+	StartDate  string           `json:"startDate"`
+	Iterations []IterationInput `json:"iterations"`
+	Duration   int              `json:"duration"`
+}
+
+// IterationInput describes a single iteration; dates use the YYYY-MM-DD format
+type IterationInput struct {
+	Title     string `json:"title"`
+	StartDate string `json:"startDate"`
+	Duration  int    `json:"duration"`
 }
 
 type DeleteFieldInput struct {
@@ -115,10 +187,15 @@ func BuildCreateFieldVariables(input CreateFieldInput) map[string]interface{} {
 	}
 
 	// Add iteration field configuration
-	if input.DataType == ProjectV2FieldDataTypeIteration && input.Duration != "" {
-		inputMap["iterationSetting"] = map[string]interface{}{
-			"duration": parseDuration(input.Duration),
+	if input.DataType == ProjectV2FieldDataTypeIteration {
+		startDate := input.StartDate
+		if startDate == "" {
+			startDate = time.Now().Format(iterationDateLayout)
 		}
+		inputMap["iterationConfiguration"] = buildIterationConfiguration(IterationConfigurationInput{
+			StartDate: startDate,
+			Duration:  parseDuration(input.Duration),
+		})
 	}
 
 	return map[string]interface{}{
@@ -134,12 +211,59 @@ func BuildUpdateFieldVariables(input UpdateFieldInput) map[string]interface{} {
 	if input.Name != nil {
 		inputMap["name"] = *input.Name
 	}
+	if input.IterationConfiguration != nil {
+		inputMap["iterationConfiguration"] = buildIterationConfiguration(*input.IterationConfiguration)
+	}
+	if len(input.SingleSelectOptions) > 0 {
+		options := make([]map[string]interface{}, len(input.SingleSelectOptions))
+		for i, option := range input.SingleSelectOptions {
+			options[i] = map[string]interface{}{
+				"name":        option.Name,
+				"color":       option.Color,
+				"description": option.Description,
+			}
+			if option.ID != "" {
+				options[i]["id"] = option.ID
+			}
+		}
+		inputMap["singleSelectOptions"] = options
+	}
 
 	return map[string]interface{}{
 		"input": inputMap,
 	}
 }
 
+func buildIterationConfiguration(input IterationConfigurationInput) map[string]interface{} {
+	iterations := make([]map[string]interface{}, len(input.Iterations))
+	for i, iteration := range input.Iterations {
+		iterations[i] = map[string]interface{}{
+			"title":     iteration.Title,
+			"startDate": iteration.StartDate,
+			"duration":  iteration.Duration,
+		}
+	}
+
+	return map[string]interface{}{
+		"startDate":  input.StartDate,
+		"duration":   input.Duration,
+		"iterations": iterations,
+	}
+}
+
+func BuildGetIterationFieldVariables(fieldID string) map[string]interface{} {
+	return map[string]interface{}{
+		"fieldId": fieldID,
+	}
+}
+
+// BuildGetSingleSelectFieldVariables builds variables for fetching a single select field
+func BuildGetSingleSelectFieldVariables(fieldID string) map[string]interface{} {
+	return map[string]interface{}{
+		"fieldId": fieldID,
+	}
+}
+
 func BuildDeleteFieldVariables(input DeleteFieldInput) map[string]interface{} {
 	return map[string]interface{}{
 		"input": map[string]interface{}{
@@ -241,6 +365,15 @@ func parseDuration(duration string) int {
 		return defaultIterationDays // Default 2 weeks
 	}
 
+	days, err := ParseDurationDays(duration)
+	if err != nil {
+		return defaultIterationDays // Default fallback
+	}
+	return days
+}
+
+// ParseDurationDays parses a duration like "10d", "2w" or "1m" into days
+func ParseDurationDays(duration string) (int, error) {
 	duration = strings.ToLower(strings.TrimSpace(duration))
 
 	// Handle numeric part and unit
@@ -251,24 +384,24 @@ func parseDuration(duration string) int {
 		if char >= '0' && char <= '9' {
 			numStr += string(char)
 		} else {
-			unit = duration[i:]
+			unit = strings.TrimSpace(duration[i:])
 			break
 		}
 	}
 
 	num, err := strconv.Atoi(numStr)
 	if err != nil || num <= 0 {
-		return defaultIterationDays // Default fallback
+		return 0, fmt.Errorf("invalid duration: %s (expected e.g. 10d, 2w or 1m)", duration)
 	}
 
 	switch unit {
 	case "d", "day", "days":
-		return num
+		return num, nil
 	case "w", "week", "weeks":
-		return num * daysPerWeek
+		return num * daysPerWeek, nil
 	case "m", "month", "months":
-		return num * daysPerMonth
+		return num * daysPerMonth, nil
 	default:
-		return defaultIterationDays // Default fallback
+		return 0, fmt.Errorf("invalid duration unit: %s (expected d, w or m)", duration)
 	}
 }
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,6 +18,21 @@ func TestFieldMutations(t *testing.T) {
 		assert.NotNil(t, mutation)
 	})
 
+	t.Run("UpdateIterationField mutation structure", func(t *testing.T) {
+		mutation := &UpdateIterationFieldMutation{}
+		assert.NotNil(t, mutation)
+	})
+
+	t.Run("GetIterationField query structure", func(t *testing.T) {
+		query := &GetIterationFieldQuery{}
+		assert.NotNil(t, query)
+	})
+
+	t.Run("GetSingleSelectField query structure", func(t *testing.T) {
+		query := &GetSingleSelectFieldQuery{}
+		assert.NotNil(t, query)
+	})
+
 	t.Run("DeleteField mutation structure", func(t *testing.T) {
 		mutation := &DeleteFieldMutation{}
 		assert.NotNil(t, mutation)
@@ -94,6 +110,98 @@ func TestFieldVariableBuilders(t *testing.T) {
 		assert.Equal(t, "Updated Priority", inputVar["name"])
 	})
 
+	t.Run("BuildCreateFieldVariables with iteration configuration", func(t *testing.T) {
+		input := CreateFieldInput{
+			ProjectID: "project-id",
+			Name:      "Sprint",
+			DataType:  ProjectV2FieldDataTypeIteration,
+			Duration:  "1w",
+			StartDate: "2025-03-03",
+		}
+
+		variables := BuildCreateFieldVariables(input)
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.NotContains(t, inputVar, "iterationSetting")
+		config := inputVar["iterationConfiguration"].(map[string]interface{})
+		assert.Equal(t, 7, config["duration"])
+		assert.Equal(t, "2025-03-03", config["startDate"])
+		assert.Empty(t, config["iterations"])
+	})
+
+	t.Run("BuildCreateFieldVariables defaults iteration duration and start date", func(t *testing.T) {
+		input := CreateFieldInput{
+			ProjectID: "project-id",
+			Name:      "Sprint",
+			DataType:  ProjectV2FieldDataTypeIteration,
+		}
+
+		variables := BuildCreateFieldVariables(input)
+
+		config := variables["input"].(map[string]interface{})["iterationConfiguration"].(map[string]interface{})
+		assert.Equal(t, 14, config["duration"])
+		assert.Equal(t, time.Now().Format("2006-01-02"), config["startDate"])
+	})
+
+	t.Run("BuildUpdateFieldVariables with iteration configuration", func(t *testing.T) {
+		input := UpdateFieldInput{
+			FieldID: "field-id",
+			IterationConfiguration: &IterationConfigurationInput{
+				StartDate: "2025-03-03",
+				Duration:  14,
+				Iterations: []IterationInput{
+					{Title: "Sprint 1", StartDate: "2025-03-03", Duration: 14},
+					{Title: "Sprint 2", StartDate: "2025-03-24", Duration: 7},
+				},
+			},
+		}
+
+		variables := BuildUpdateFieldVariables(input)
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.NotContains(t, inputVar, "name")
+		config := inputVar["iterationConfiguration"].(map[string]interface{})
+		assert.Equal(t, "2025-03-03", config["startDate"])
+		assert.Equal(t, 14, config["duration"])
+
+		iterations := config["iterations"].([]map[string]interface{})
+		assert.Len(t, iterations, 2)
+		assert.Equal(t, "Sprint 2", iterations[1]["title"])
+		assert.Equal(t, "2025-03-24", iterations[1]["startDate"])
+		assert.Equal(t, 7, iterations[1]["duration"])
+	})
+
+	t.Run("BuildUpdateFieldVariables with single select options", func(t *testing.T) {
+		input := UpdateFieldInput{
+			FieldID: "field-id",
+			SingleSelectOptions: []SingleSelectOptionInput{
+				{ID: "option-2", Name: "High", Color: SingleSelectColorRed},
+				{Name: "Low", Color: SingleSelectColorGray, Description: "Someday"},
+			},
+		}
+
+		variables := BuildUpdateFieldVariables(input)
+
+		inputVar := variables["input"].(map[string]interface{})
+		options := inputVar["singleSelectOptions"].([]map[string]interface{})
+		assert.Len(t, options, 2)
+		assert.Equal(t, "option-2", options[0]["id"])
+		assert.Equal(t, "High", options[0]["name"])
+		assert.Equal(t, "", options[0]["description"])
+		assert.NotContains(t, options[1], "id")
+		assert.Equal(t, "Someday", options[1]["description"])
+	})
+
+	t.Run("BuildGetIterationFieldVariables creates proper variables", func(t *testing.T) {
+		variables := BuildGetIterationFieldVariables("field-id")
+		assert.Equal(t, "field-id", variables["fieldId"])
+	})
+
+	t.Run("BuildGetSingleSelectFieldVariables creates proper variables", func(t *testing.T) {
+		variables := BuildGetSingleSelectFieldVariables("field-id")
+		assert.Equal(t, "field-id", variables["fieldId"])
+	})
+
 	t.Run("BuildDeleteFieldVariables creates proper variables", func(t *testing.T) {
 		input := DeleteFieldInput{
 			FieldID: "field-id",
@@ -181,6 +289,36 @@ func TestFieldVariableBuilders(t *testing.T) {
 	})
 }
 
+func TestParseDurationDays(t *testing.T) {
+	t.Run("Parses days, weeks and months", func(t *testing.T) {
+		tests := map[string]int{
+			"10d":     10,
+			"2w":      14,
+			"3 weeks": 21,
+			"1m":      30,
+			" 1W ":    7,
+		}
+		for input, expected := range tests {
+			days, err := ParseDurationDays(input)
+			assert.NoError(t, err, input)
+			assert.Equal(t, expected, days, input)
+		}
+	})
+
+	t.Run("Rejects invalid durations", func(t *testing.T) {
+		for _, input := range []string{"", "w", "0w", "-1d", "2y", "two weeks"} {
+			_, err := ParseDurationDays(input)
+			assert.Error(t, err, input)
+		}
+	})
+
+	t.Run("parseDuration falls back to the default", func(t *testing.T) {
+		assert.Equal(t, defaultIterationDays, parseDuration(""))
+		assert.Equal(t, defaultIterationDays, parseDuration("2y"))
+		assert.Equal(t, 21, parseDuration("3w"))
+	})
+}
+
 func TestFieldDataTypes(t *testing.T) {
 	t.Run("All field data types defined", func(t *testing.T) {
 		assert.Equal(t, "TEXT", string(ProjectV2FieldDataTypeText))
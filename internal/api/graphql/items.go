@@ -115,6 +115,15 @@ type GetPullRequestQuery struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
+// GetContentNodeQuery gets an issue or pull request by node ID
+type GetContentNodeQuery struct {
+	Node struct {
+		Issue       Issue       `graphql:"... on Issue"`
+		PullRequest PullRequest `graphql:"... on PullRequest"`
+		TypeName    string      `graphql:"__typename"`
+	} `graphql:"node(id: $id)"`
+}
+
 // SearchIssuesQuery searches for issues
 type SearchIssuesQuery struct {
 	Search struct {
@@ -157,8 +166,210 @@ type ListRepositoryPullRequestsQuery struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
+// GetIssueProjectItemsQuery gets the project items of an issue
+type GetIssueProjectItemsQuery struct {
+	Repository struct {
+		Issue struct {
+			ProjectItems ContentProjectItems `graphql:"projectItems(first: 20, includeArchived: true)"`
+		} `graphql:"issue(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetPullRequestProjectItemsQuery gets the project items of a pull request
+type GetPullRequestProjectItemsQuery struct {
+	Repository struct {
+		PullRequest struct {
+			ProjectItems ContentProjectItems `graphql:"projectItems(first: 20, includeArchived: true)"`
+		} `graphql:"pullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ContentProjectItems represents the project items an issue or pull request belongs to
+type ContentProjectItems struct {
+	Nodes []ContentProjectItem `graphql:"nodes"`
+}
+
+// ContentProjectItem represents an issue or pull request's item in one project
+type ContentProjectItem struct {
+	ID         string `graphql:"id"`
+	IsArchived bool   `graphql:"isArchived"`
+	Project    struct {
+		ID     string `graphql:"id"`
+		Title  string `graphql:"title"`
+		URL    string `graphql:"url"`
+		Number int    `graphql:"number"`
+		Closed bool   `graphql:"closed"`
+		Owner  struct {
+			User struct {
+				Login string `graphql:"login"`
+			} `graphql:"... on User"`
+			Organization struct {
+				Login string `graphql:"login"`
+			} `graphql:"... on Organization"`
+		} `graphql:"owner"`
+	} `graphql:"project"`
+	FieldValues struct {
+		Nodes []ProjectV2ItemFieldValue `graphql:"nodes"`
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+// GetRepositoryQuery gets a repository
+type GetRepositoryQuery struct {
+	Repository RepositoryDetails `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListRepositoryLabelsQuery lists the labels of a repository
+type ListRepositoryLabelsQuery struct {
+	Repository struct {
+		Labels struct {
+			PageInfo PageInfo `graphql:"pageInfo"`
+			Nodes    []Label  `graphql:"nodes"`
+		} `graphql:"labels(first: $first, after: $after)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListRepositoryMilestonesQuery lists the open and closed milestones of a repository
+type ListRepositoryMilestonesQuery struct {
+	Repository struct {
+		Milestones struct {
+			PageInfo PageInfo    `graphql:"pageInfo"`
+			Nodes    []Milestone `graphql:"nodes"`
+		} `graphql:"milestones(first: $first, after: $after, states: [OPEN, CLOSED])"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetUserQuery gets a user by login
+type GetUserQuery struct {
+	User struct {
+		ID    string `graphql:"id"`
+		Login string `graphql:"login"`
+	} `graphql:"user(login: $login)"`
+}
+
+// RepositoryDetails represents a repository issues can be created in
+type RepositoryDetails struct {
+	ID            string `graphql:"id"`
+	NameWithOwner string `graphql:"nameWithOwner"`
+}
+
+// CreatedIssue represents an issue returned by createIssue
+type CreatedIssue struct {
+	ID     string `graphql:"id"`
+	Title  string `graphql:"title"`
+	URL    string `graphql:"url"`
+	Number int    `graphql:"number"`
+}
+
+// Label represents a repository label
+type Label struct {
+	Description *string `graphql:"description"`
+	ID          string  `graphql:"id"`
+	Name        string  `graphql:"name"`
+	Color       string  `graphql:"color"`
+}
+
+// Milestone represents a repository milestone
+type Milestone struct {
+	Description *string `graphql:"description"`
+	ID          string  `graphql:"id"`
+	Title       string  `graphql:"title"`
+	Number      int     `graphql:"number"`
+	Closed      bool    `graphql:"closed"`
+}
+
 // Mutations
 
+// ConvertDraftIssueMutation converts a draft issue item into a repository issue
+type ConvertDraftIssueMutation struct {
+	ConvertProjectV2DraftIssueItemToIssue struct {
+		Item ProjectV2Item `graphql:"item"`
+	} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+}
+
+// CreateIssueMutation creates an issue in a repository
+type CreateIssueMutation struct {
+	CreateIssue struct {
+		Issue CreatedIssue `graphql:"issue"`
+	} `graphql:"createIssue(input: $input)"`
+}
+
+// AddLabelsMutation adds labels to an issue or pull request
+type AddLabelsMutation struct {
+	AddLabelsToLabelable struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"addLabelsToLabelable(input: $input)"`
+}
+
+// RemoveLabelsMutation removes labels from an issue or pull request
+type RemoveLabelsMutation struct {
+	RemoveLabelsFromLabelable struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"removeLabelsFromLabelable(input: $input)"`
+}
+
+// AddAssigneesMutation adds assignees to an issue or pull request
+type AddAssigneesMutation struct {
+	AddAssigneesToAssignable struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"addAssigneesToAssignable(input: $input)"`
+}
+
+// RemoveAssigneesMutation removes assignees from an issue or pull request
+type RemoveAssigneesMutation struct {
+	RemoveAssigneesFromAssignable struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"removeAssigneesFromAssignable(input: $input)"`
+}
+
+// UpdateIssueMilestoneMutation sets or clears the milestone of an issue
+type UpdateIssueMilestoneMutation struct {
+	UpdateIssue struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"updateIssue(input: $input)"`
+}
+
+// UpdatePullRequestMilestoneMutation sets or clears the milestone of a pull request
+type UpdatePullRequestMilestoneMutation struct {
+	UpdatePullRequest struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"updatePullRequest(input: $input)"`
+}
+
+// CloseIssueMutation closes an issue
+type CloseIssueMutation struct {
+	CloseIssue struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"closeIssue(input: $input)"`
+}
+
+// ReopenIssueMutation reopens a closed issue
+type ReopenIssueMutation struct {
+	ReopenIssue struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"reopenIssue(input: $input)"`
+}
+
+// ClosePullRequestMutation closes a pull request
+type ClosePullRequestMutation struct {
+	ClosePullRequest struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"closePullRequest(input: $input)"`
+}
+
+// ReopenPullRequestMutation reopens a closed pull request
+type ReopenPullRequestMutation struct {
+	ReopenPullRequest struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"reopenPullRequest(input: $input)"`
+}
+
+// AddCommentMutation adds a comment to an issue or pull request
+type AddCommentMutation struct {
+	AddComment struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"addComment(input: $input)"`
+}
+
 // CreateDraftIssueMutation creates a draft issue in a project
 type CreateDraftIssueMutation struct {
 	AddProjectV2DraftIssue struct {
@@ -201,6 +412,53 @@ type DeleteDraftIssueInput struct {
 	ItemID string `json:"itemId"`
 }
 
+// ConvertDraftIssueInput represents input for converting a draft issue item
+type ConvertDraftIssueInput struct {
+	ItemID       string `json:"itemId"`
+	RepositoryID string `json:"repositoryId"`
+}
+
+// CreateIssueInput represents input for creating an issue
+type CreateIssueInput struct {
+	Body         *string  `json:"body,omitempty"`
+	MilestoneID  *string  `json:"milestoneId,omitempty"`
+	RepositoryID string   `json:"repositoryId"`
+	Title        string   `json:"title"`
+	LabelIDs     []string `json:"labelIds,omitempty"`
+	AssigneeIDs  []string `json:"assigneeIds,omitempty"`
+}
+
+// LabelsInput represents input for adding or removing labels on an issue or pull request
+type LabelsInput struct {
+	LabelableID string   `json:"labelableId"`
+	LabelIDs    []string `json:"labelIds"`
+}
+
+// AssigneesInput represents input for adding or removing assignees on an issue or pull request
+type AssigneesInput struct {
+	AssignableID string   `json:"assignableId"`
+	AssigneeIDs  []string `json:"assigneeIds"`
+}
+
+// MilestoneInput represents input for setting the milestone of an issue or pull request.
+// A nil MilestoneID clears the milestone.
+type MilestoneInput struct {
+	MilestoneID *string `json:"milestoneId"`
+	ContentID   string  `json:"id"`
+}
+
+// CloseIssueInput represents input for closing an issue
+type CloseIssueInput struct {
+	IssueID     string `json:"issueId"`
+	StateReason string `json:"stateReason,omitempty"`
+}
+
+// AddCommentInput represents input for commenting on an issue or pull request
+type AddCommentInput struct {
+	SubjectID string `json:"subjectId"`
+	Body      string `json:"body"`
+}
+
 // SearchOptions represents search options for issues/PRs
 type SearchOptions struct {
 	After *string
@@ -237,6 +495,13 @@ func BuildGetIssueVariables(owner, repo string, number int) map[string]interface
 	}
 }
 
+// BuildGetContentNodeVariables builds variables for getting an issue or pull request by node ID
+func BuildGetContentNodeVariables(id string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": id,
+	}
+}
+
 // BuildGetPullRequestVariables builds variables for getting a pull request
 func BuildGetPullRequestVariables(owner, repo string, number int) map[string]interface{} {
 	return map[string]interface{}{
@@ -375,3 +640,155 @@ func BuildDeleteDraftIssueVariables(input DeleteDraftIssueInput) map[string]inte
 		},
 	}
 }
+
+// BuildGetRepositoryVariables builds variables for getting a repository
+func BuildGetRepositoryVariables(owner, repo string) map[string]interface{} {
+	return map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+	}
+}
+
+// BuildListRepositoryMetadataVariables builds variables for listing a page of repository labels or milestones
+func BuildListRepositoryMetadataVariables(owner, repo string, first int, after *string) map[string]interface{} {
+	if first <= 0 {
+		first = 100
+	}
+
+	variables := map[string]interface{}{
+		"owner": owner,
+		"repo":  repo,
+		"first": first,
+	}
+
+	if after != nil {
+		variables["after"] = *after
+	}
+
+	return variables
+}
+
+// BuildGetUserVariables builds variables for getting a user
+func BuildGetUserVariables(login string) map[string]interface{} {
+	return map[string]interface{}{
+		"login": login,
+	}
+}
+
+// BuildConvertDraftIssueVariables builds variables for converting a draft issue
+func BuildConvertDraftIssueVariables(input ConvertDraftIssueInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"itemId":       input.ItemID,
+			"repositoryId": input.RepositoryID,
+		},
+	}
+}
+
+// BuildLabelsVariables builds variables for adding or removing labels
+func BuildLabelsVariables(input LabelsInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"labelableId": input.LabelableID,
+			"labelIds":    input.LabelIDs,
+		},
+	}
+}
+
+// BuildAssigneesVariables builds variables for adding or removing assignees
+func BuildAssigneesVariables(input AssigneesInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"assignableId": input.AssignableID,
+			"assigneeIds":  input.AssigneeIDs,
+		},
+	}
+}
+
+// BuildUpdateIssueMilestoneVariables builds variables for setting the milestone of an issue
+func BuildUpdateIssueMilestoneVariables(input MilestoneInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"id":          input.ContentID,
+			"milestoneId": input.MilestoneID,
+		},
+	}
+}
+
+// BuildUpdatePullRequestMilestoneVariables builds variables for setting the milestone of a pull request
+func BuildUpdatePullRequestMilestoneVariables(input MilestoneInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestId": input.ContentID,
+			"milestoneId":   input.MilestoneID,
+		},
+	}
+}
+
+// BuildCloseIssueVariables builds variables for closing an issue
+func BuildCloseIssueVariables(input CloseIssueInput) map[string]interface{} {
+	inputMap := map[string]interface{}{
+		"issueId": input.IssueID,
+	}
+
+	if input.StateReason != "" {
+		inputMap["stateReason"] = input.StateReason
+	}
+
+	return map[string]interface{}{
+		"input": inputMap,
+	}
+}
+
+// BuildReopenIssueVariables builds variables for reopening an issue
+func BuildReopenIssueVariables(issueID string) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId": issueID,
+		},
+	}
+}
+
+// BuildPullRequestStateVariables builds variables for closing or reopening a pull request
+func BuildPullRequestStateVariables(pullRequestID string) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"pullRequestId": pullRequestID,
+		},
+	}
+}
+
+// BuildCreateIssueVariables builds variables for creating an issue
+func BuildCreateIssueVariables(input CreateIssueInput) map[string]interface{} {
+	inputMap := map[string]interface{}{
+		"repositoryId": input.RepositoryID,
+		"title":        input.Title,
+	}
+
+	if input.Body != nil {
+		inputMap["body"] = *input.Body
+	}
+	if input.MilestoneID != nil {
+		inputMap["milestoneId"] = *input.MilestoneID
+	}
+	if len(input.LabelIDs) > 0 {
+		inputMap["labelIds"] = input.LabelIDs
+	}
+	if len(input.AssigneeIDs) > 0 {
+		inputMap["assigneeIds"] = input.AssigneeIDs
+	}
+
+	return map[string]interface{}{
+		"input": inputMap,
+	}
+}
+
+// BuildAddCommentVariables builds variables for commenting on an issue or pull request
+func BuildAddCommentVariables(input AddCommentInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"subjectId": input.SubjectID,
+			"body":      input.Body,
+		},
+	}
+}
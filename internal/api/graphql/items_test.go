@@ -17,6 +17,11 @@ func TestItemQueries(t *testing.T) {
 		assert.NotNil(t, query)
 	})
 
+	t.Run("GetContentNode query structure", func(t *testing.T) {
+		query := &GetContentNodeQuery{}
+		assert.NotNil(t, query)
+	})
+
 	t.Run("SearchIssues query structure", func(t *testing.T) {
 		query := &SearchIssuesQuery{}
 		assert.NotNil(t, query)
@@ -26,6 +31,21 @@ func TestItemQueries(t *testing.T) {
 		query := &ListRepositoryIssuesQuery{}
 		assert.NotNil(t, query)
 	})
+
+	t.Run("ListRepositoryLabels and ListRepositoryMilestones query structure", func(t *testing.T) {
+		assert.NotNil(t, &ListRepositoryLabelsQuery{})
+		assert.NotNil(t, &ListRepositoryMilestonesQuery{})
+	})
+
+	t.Run("GetIssueProjectItems query structure", func(t *testing.T) {
+		query := &GetIssueProjectItemsQuery{}
+		assert.NotNil(t, query)
+	})
+
+	t.Run("GetPullRequestProjectItems query structure", func(t *testing.T) {
+		query := &GetPullRequestProjectItemsQuery{}
+		assert.NotNil(t, query)
+	})
 }
 
 func TestDraftIssueMutations(t *testing.T) {
@@ -43,9 +63,142 @@ func TestDraftIssueMutations(t *testing.T) {
 		mutation := &DeleteDraftIssueMutation{}
 		assert.NotNil(t, mutation)
 	})
+
+	t.Run("ConvertDraftIssue mutation structure", func(t *testing.T) {
+		mutation := &ConvertDraftIssueMutation{}
+		assert.NotNil(t, mutation)
+	})
 }
 
 func TestItemVariableBuilders(t *testing.T) {
+	t.Run("BuildConvertDraftIssueVariables creates proper variables", func(t *testing.T) {
+		variables := BuildConvertDraftIssueVariables(ConvertDraftIssueInput{
+			ItemID:       "item-id",
+			RepositoryID: "repo-id",
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "item-id", inputVar["itemId"])
+		assert.Equal(t, "repo-id", inputVar["repositoryId"])
+	})
+
+	t.Run("BuildLabelsVariables creates proper variables", func(t *testing.T) {
+		variables := BuildLabelsVariables(LabelsInput{
+			LabelableID: "issue-id",
+			LabelIDs:    []string{"label-1", "label-2"},
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "issue-id", inputVar["labelableId"])
+		assert.Equal(t, []string{"label-1", "label-2"}, inputVar["labelIds"])
+	})
+
+	t.Run("BuildAssigneesVariables creates proper variables", func(t *testing.T) {
+		variables := BuildAssigneesVariables(AssigneesInput{
+			AssignableID: "issue-id",
+			AssigneeIDs:  []string{"user-1"},
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "issue-id", inputVar["assignableId"])
+		assert.Equal(t, []string{"user-1"}, inputVar["assigneeIds"])
+	})
+
+	t.Run("BuildUpdateIssueMilestoneVariables sets null to clear", func(t *testing.T) {
+		variables := BuildUpdateIssueMilestoneVariables(MilestoneInput{ContentID: "issue-id"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "issue-id", inputVar["id"])
+		assert.Contains(t, inputVar, "milestoneId")
+		assert.Nil(t, inputVar["milestoneId"])
+	})
+
+	t.Run("BuildUpdatePullRequestMilestoneVariables creates proper variables", func(t *testing.T) {
+		milestoneID := "milestone-id"
+		variables := BuildUpdatePullRequestMilestoneVariables(MilestoneInput{ContentID: "pr-id", MilestoneID: &milestoneID})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "pr-id", inputVar["pullRequestId"])
+		assert.Equal(t, &milestoneID, inputVar["milestoneId"])
+	})
+
+	t.Run("BuildCloseIssueVariables includes state reason", func(t *testing.T) {
+		variables := BuildCloseIssueVariables(CloseIssueInput{IssueID: "issue-id", StateReason: "NOT_PLANNED"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "issue-id", inputVar["issueId"])
+		assert.Equal(t, "NOT_PLANNED", inputVar["stateReason"])
+	})
+
+	t.Run("BuildCloseIssueVariables omits empty state reason", func(t *testing.T) {
+		variables := BuildCloseIssueVariables(CloseIssueInput{IssueID: "issue-id"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.NotContains(t, inputVar, "stateReason")
+	})
+
+	t.Run("BuildCreateIssueVariables includes optional fields", func(t *testing.T) {
+		body := "Steps to reproduce"
+		milestone := "milestone-id"
+		variables := BuildCreateIssueVariables(CreateIssueInput{
+			RepositoryID: "repo-id",
+			Title:        "Fix login bug",
+			Body:         &body,
+			MilestoneID:  &milestone,
+			LabelIDs:     []string{"label-id"},
+			AssigneeIDs:  []string{"user-id"},
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "repo-id", inputVar["repositoryId"])
+		assert.Equal(t, "Fix login bug", inputVar["title"])
+		assert.Equal(t, "Steps to reproduce", inputVar["body"])
+		assert.Equal(t, "milestone-id", inputVar["milestoneId"])
+		assert.Equal(t, []string{"label-id"}, inputVar["labelIds"])
+		assert.Equal(t, []string{"user-id"}, inputVar["assigneeIds"])
+	})
+
+	t.Run("BuildCreateIssueVariables omits empty optional fields", func(t *testing.T) {
+		variables := BuildCreateIssueVariables(CreateIssueInput{RepositoryID: "repo-id", Title: "Fix login bug"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Len(t, inputVar, 2)
+	})
+
+	t.Run("BuildAddCommentVariables creates proper variables", func(t *testing.T) {
+		variables := BuildAddCommentVariables(AddCommentInput{SubjectID: "issue-id", Body: "Still relevant?"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "issue-id", inputVar["subjectId"])
+		assert.Equal(t, "Still relevant?", inputVar["body"])
+	})
+
+	t.Run("BuildGetContentNodeVariables creates proper variables", func(t *testing.T) {
+		variables := BuildGetContentNodeVariables("I_kwDOABC")
+
+		assert.Equal(t, "I_kwDOABC", variables["id"])
+	})
+
+	t.Run("BuildGetRepositoryVariables creates proper variables", func(t *testing.T) {
+		variables := BuildGetRepositoryVariables("owner", "repo")
+
+		assert.Equal(t, "owner", variables["owner"])
+		assert.Equal(t, "repo", variables["repo"])
+	})
+
+	t.Run("BuildListRepositoryMetadataVariables pages with a cursor", func(t *testing.T) {
+		variables := BuildListRepositoryMetadataVariables("owner", "repo", 0, nil)
+
+		assert.Equal(t, "owner", variables["owner"])
+		assert.Equal(t, 100, variables["first"])
+		assert.NotContains(t, variables, "after")
+
+		cursor := "Y3Vyc29y"
+		variables = BuildListRepositoryMetadataVariables("owner", "repo", 50, &cursor)
+		assert.Equal(t, 50, variables["first"])
+		assert.Equal(t, cursor, variables["after"])
+	})
+
 	t.Run("BuildGetIssueVariables creates proper variables", func(t *testing.T) {
 		variables := BuildGetIssueVariables("owner", "repo", 123)
 
@@ -34,6 +34,20 @@ type ProjectV2Field struct {
 	Options struct {
 		Nodes []ProjectV2SingleSelectFieldOption `graphql:"nodes"`
 	} `graphql:"... on ProjectV2SingleSelectField { options(first: 20) }"`
+
+	IterationField struct {
+		Configuration struct {
+			Iterations []ProjectV2IterationInfo `graphql:"iterations"`
+		} `graphql:"configuration"`
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+// ProjectV2IterationInfo represents an iteration of an iteration field
+type ProjectV2IterationInfo struct {
+	ID        string `graphql:"id"`
+	Title     string `graphql:"title"`
+	StartDate string `graphql:"startDate"`
+	Duration  int    `graphql:"duration"`
 }
 
 // ProjectV2FieldDataType represents the data type of a field
@@ -60,26 +74,93 @@ type ProjectV2Item struct {
 	CreatedAt   time.Time `graphql:"createdAt"`
 	UpdatedAt   time.Time `graphql:"updatedAt"`
 	ID          string    `graphql:"id"`
+	IsArchived  bool      `graphql:"isArchived"`
 	FieldValues struct {
-		Nodes []ProjectV2ItemFieldValue `graphql:"nodes"`
+		PageInfo PageInfo                  `graphql:"pageInfo"`
+		Nodes    []ProjectV2ItemFieldValue `graphql:"nodes"`
 	} `graphql:"fieldValues(first: 20)"`
 	Content struct {
-		DraftBody   *string `graphql:"... on DraftIssue { body }"`
-		PRTitle     string  `graphql:"... on PullRequest { title }"`
-		IssueURL    string  `graphql:"... on Issue { url }"`
-		IssueState  string  `graphql:"... on Issue { state }"`
-		TypeName    string  `graphql:"__typename"`
-		PRURL       string  `graphql:"... on PullRequest { url }"`
-		PRState     string  `graphql:"... on PullRequest { state }"`
-		DraftTitle  string  `graphql:"... on DraftIssue { title }"`
-		IssueTitle  string  `graphql:"... on Issue { title }"`
-		IssueNumber int     `graphql:"... on Issue { number }"`
-		PRNumber    int     `graphql:"... on PullRequest { number }"`
-		IssueClosed bool    `graphql:"... on Issue { closed }"`
-		PRClosed    bool    `graphql:"... on PullRequest { closed }"`
+		DraftBody       *string `graphql:"... on DraftIssue { body }"`
+		PRTitle         string  `graphql:"... on PullRequest { title }"`
+		IssueURL        string  `graphql:"... on Issue { url }"`
+		IssueState      string  `graphql:"... on Issue { state }"`
+		TypeName        string  `graphql:"__typename"`
+		PRURL           string  `graphql:"... on PullRequest { url }"`
+		PRState         string  `graphql:"... on PullRequest { state }"`
+		DraftTitle      string  `graphql:"... on DraftIssue { title }"`
+		IssueTitle      string  `graphql:"... on Issue { title }"`
+		IssueNumber     int     `graphql:"... on Issue { number }"`
+		PRNumber        int     `graphql:"... on PullRequest { number }"`
+		IssueClosed     bool    `graphql:"... on Issue { closed }"`
+		PRClosed        bool    `graphql:"... on PullRequest { closed }"`
+		IssueRepository struct {
+			Repository ContentRepository `graphql:"repository"`
+		} `graphql:"... on Issue"`
+		PRRepository struct {
+			Repository ContentRepository `graphql:"repository"`
+		} `graphql:"... on PullRequest"`
+		IssueLabels struct {
+			Labels ContentLabels `graphql:"labels(first: 20)"`
+		} `graphql:"... on Issue"`
+		PRLabels struct {
+			Labels ContentLabels `graphql:"labels(first: 20)"`
+		} `graphql:"... on PullRequest"`
+		IssueAssignees struct {
+			Assignees ContentAssignees `graphql:"assignees(first: 10)"`
+		} `graphql:"... on Issue"`
+		PRAssignees struct {
+			Assignees ContentAssignees `graphql:"assignees(first: 10)"`
+		} `graphql:"... on PullRequest"`
+		DraftAssignees struct {
+			Assignees ContentAssignees `graphql:"assignees(first: 10)"`
+		} `graphql:"... on DraftIssue"`
+		IssueNode struct {
+			ID string `graphql:"id"`
+		} `graphql:"... on Issue"`
+		PRNode struct {
+			ID string `graphql:"id"`
+		} `graphql:"... on PullRequest"`
+		IssueHierarchy struct {
+			Parent *ContentIssueReference `graphql:"parent"`
+		} `graphql:"... on Issue"`
+		IssueMilestone struct {
+			Milestone *ContentMilestone `graphql:"milestone"`
+		} `graphql:"... on Issue"`
+		PRMilestone struct {
+			Milestone *ContentMilestone `graphql:"milestone"`
+		} `graphql:"... on PullRequest"`
+		PRClosingIssues struct {
+			ClosingIssuesReferences struct {
+				Nodes []ContentIssueReference `graphql:"nodes"`
+			} `graphql:"closingIssuesReferences(first: 10)"`
+		} `graphql:"... on PullRequest"`
 	} `graphql:"content"`
 }
 
+// ContentRepository represents the repository of an item's content
+type ContentRepository struct {
+	NameWithOwner string `graphql:"nameWithOwner"`
+}
+
+// ContentMilestone represents the milestone of an item's content
+type ContentMilestone struct {
+	Title string `graphql:"title"`
+}
+
+// ContentLabels represents the labels of an item's content
+type ContentLabels struct {
+	Nodes []struct {
+		Name string `graphql:"name"`
+	} `graphql:"nodes"`
+}
+
+// ContentAssignees represents the assignees of an item's content
+type ContentAssignees struct {
+	Nodes []struct {
+		Login string `graphql:"login"`
+	} `graphql:"nodes"`
+}
+
 // ProjectV2ItemFieldValue represents a field value for an item
 type ProjectV2ItemFieldValue struct {
 	TextValue         *string    `graphql:"... on ProjectV2ItemFieldTextValue { text }"`
@@ -93,6 +174,9 @@ type ProjectV2ItemFieldValue struct {
 		ID    string `graphql:"id"`
 		Title string `graphql:"title"`
 	} `graphql:"... on ProjectV2ItemFieldIterationValue { iteration }"`
+	Common struct {
+		UpdatedAt *time.Time `graphql:"updatedAt"`
+	} `graphql:"... on ProjectV2ItemFieldValueCommon"`
 	Field struct {
 		ID   string `graphql:"id"`
 		Name string `graphql:"name"`
@@ -135,6 +219,31 @@ type GetUserProjectQuery struct {
 	} `graphql:"user(login: $userLogin)"`
 }
 
+// ListProjectItemsQuery lists items in a project with pagination
+type ListProjectItemsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				PageInfo   PageInfo        `graphql:"pageInfo"`
+				Nodes      []ProjectV2Item `graphql:"nodes"`
+				TotalCount int             `graphql:"totalCount"`
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// ListItemFieldValuesQuery lists the field values of a project item with pagination
+type ListItemFieldValuesQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			FieldValues struct {
+				PageInfo PageInfo                  `graphql:"pageInfo"`
+				Nodes    []ProjectV2ItemFieldValue `graphql:"nodes"`
+			} `graphql:"fieldValues(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $itemId)"`
+}
+
 // PageInfo represents pagination information
 type PageInfo struct {
 	StartCursor     string `graphql:"startCursor"`
@@ -180,6 +289,13 @@ type UpdateItemFieldMutation struct {
 	} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
 }
 
+// ClearItemFieldMutation clears a field value of an item
+type ClearItemFieldMutation struct {
+	ClearProjectV2ItemFieldValue struct {
+		ProjectV2Item ProjectV2Item `graphql:"projectV2Item"`
+	} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+}
+
 // RemoveItemFromProjectMutation removes an item from a project
 type RemoveItemFromProjectMutation struct {
 	DeleteProjectV2Item struct {
@@ -187,6 +303,27 @@ type RemoveItemFromProjectMutation struct {
 	} `graphql:"deleteProjectV2Item(input: $input)"`
 }
 
+// ArchiveItemMutation archives an item in a project
+type ArchiveItemMutation struct {
+	ArchiveProjectV2Item struct {
+		Item ProjectV2Item `graphql:"item"`
+	} `graphql:"archiveProjectV2Item(input: $input)"`
+}
+
+// UnarchiveItemMutation restores an archived item in a project
+type UnarchiveItemMutation struct {
+	UnarchiveProjectV2Item struct {
+		Item ProjectV2Item `graphql:"item"`
+	} `graphql:"unarchiveProjectV2Item(input: $input)"`
+}
+
+// UpdateItemPositionMutation moves an item to a new position in a project
+type UpdateItemPositionMutation struct {
+	UpdateProjectV2ItemPosition struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+}
+
 // Input Types
 
 // CreateProjectInput represents input for creating a project
@@ -225,12 +362,40 @@ type UpdateItemFieldInput struct {
 	FieldID   string      `json:"fieldId"`
 }
 
+// ClearItemFieldInput represents input for clearing an item field
+type ClearItemFieldInput struct {
+	ProjectID string `json:"projectId"`
+	ItemID    string `json:"itemId"`
+	FieldID   string `json:"fieldId"`
+}
+
 // RemoveItemInput represents input for removing an item from a project
 type RemoveItemInput struct {
 	ProjectID string `json:"projectId"`
 	ItemID    string `json:"itemId"`
 }
 
+// ArchiveItemInput represents input for archiving or unarchiving a project item
+type ArchiveItemInput struct {
+	ProjectID string `json:"projectId"`
+	ItemID    string `json:"itemId"`
+}
+
+// UpdateItemPositionInput represents input for moving an item within a project.
+// A nil AfterID moves the item to the top of the project.
+type UpdateItemPositionInput struct {
+	AfterID   *string `json:"afterId,omitempty"`
+	ProjectID string  `json:"projectId"`
+	ItemID    string  `json:"itemId"`
+}
+
+// ListProjectItemsOptions represents options for listing project items
+type ListProjectItemsOptions struct {
+	After     *string
+	ProjectID string
+	First     int
+}
+
 // Variable Builders
 
 // BuildCreateProjectVariables builds variables for project creation
@@ -309,6 +474,17 @@ func BuildUpdateItemFieldVariables(input UpdateItemFieldInput) map[string]interf
 	}
 }
 
+// BuildClearItemFieldVariables builds variables for clearing an item field
+func BuildClearItemFieldVariables(input ClearItemFieldInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectId": input.ProjectID,
+			"itemId":    input.ItemID,
+			"fieldId":   input.FieldID,
+		},
+	}
+}
+
 // BuildRemoveItemVariables builds variables for removing an item
 func BuildRemoveItemVariables(input RemoveItemInput) map[string]interface{} {
 	return map[string]interface{}{
@@ -318,3 +494,65 @@ func BuildRemoveItemVariables(input RemoveItemInput) map[string]interface{} {
 		},
 	}
 }
+
+// BuildArchiveItemVariables builds variables for archiving or unarchiving an item
+func BuildArchiveItemVariables(input ArchiveItemInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"projectId": input.ProjectID,
+			"itemId":    input.ItemID,
+		},
+	}
+}
+
+// BuildUpdateItemPositionVariables builds variables for moving an item
+func BuildUpdateItemPositionVariables(input UpdateItemPositionInput) map[string]interface{} {
+	inputVars := map[string]interface{}{
+		"projectId": input.ProjectID,
+		"itemId":    input.ItemID,
+	}
+
+	if input.AfterID != nil {
+		inputVars["afterId"] = *input.AfterID
+	}
+
+	return map[string]interface{}{
+		"input": inputVars,
+	}
+}
+
+// BuildListProjectItemsVariables builds variables for listing project items
+func BuildListProjectItemsVariables(opts ListProjectItemsOptions) map[string]interface{} {
+	if opts.First <= 0 {
+		opts.First = 100
+	}
+
+	variables := map[string]interface{}{
+		"projectId": opts.ProjectID,
+		"first":     opts.First,
+	}
+
+	if opts.After != nil {
+		variables["after"] = *opts.After
+	}
+
+	return variables
+}
+
+// BuildListItemFieldValuesVariables builds variables for listing the field values of a project item
+func BuildListItemFieldValuesVariables(itemID string, first int, after *string) map[string]interface{} {
+	if first <= 0 {
+		first = 100
+	}
+
+	variables := map[string]interface{}{
+		"itemId": itemID,
+		"first":  first,
+	}
+
+	if after != nil {
+		variables["after"] = *after
+	}
+
+	return variables
+}
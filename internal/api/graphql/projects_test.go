@@ -60,11 +60,35 @@ func TestItemMutations(t *testing.T) {
 		assert.NotNil(t, mutation)
 	})
 
+	t.Run("ClearItemField mutation structure", func(t *testing.T) {
+		mutation := &ClearItemFieldMutation{}
+
+		assert.NotNil(t, mutation)
+	})
+
 	t.Run("RemoveItemFromProject mutation structure", func(t *testing.T) {
 		mutation := &RemoveItemFromProjectMutation{}
 
 		assert.NotNil(t, mutation)
 	})
+
+	t.Run("ArchiveItem mutation structure", func(t *testing.T) {
+		mutation := &ArchiveItemMutation{}
+
+		assert.NotNil(t, mutation)
+	})
+
+	t.Run("UnarchiveItem mutation structure", func(t *testing.T) {
+		mutation := &UnarchiveItemMutation{}
+
+		assert.NotNil(t, mutation)
+	})
+
+	t.Run("UpdateItemPosition mutation structure", func(t *testing.T) {
+		mutation := &UpdateItemPositionMutation{}
+
+		assert.NotNil(t, mutation)
+	})
 }
 
 func TestVariableBuilders(t *testing.T) {
@@ -99,6 +123,88 @@ func TestVariableBuilders(t *testing.T) {
 		assert.Equal(t, "project-id", inputVar["projectId"])
 		assert.Equal(t, "content-id", inputVar["contentId"])
 	})
+
+	t.Run("BuildArchiveItemVariables creates proper variables", func(t *testing.T) {
+		variables := BuildArchiveItemVariables(ArchiveItemInput{
+			ProjectID: "project-id",
+			ItemID:    "item-id",
+		})
+
+		assert.Contains(t, variables, "input")
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "project-id", inputVar["projectId"])
+		assert.Equal(t, "item-id", inputVar["itemId"])
+	})
+
+	t.Run("BuildClearItemFieldVariables creates proper variables", func(t *testing.T) {
+		variables := BuildClearItemFieldVariables(ClearItemFieldInput{
+			ProjectID: "project-id",
+			ItemID:    "item-id",
+			FieldID:   "field-id",
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "project-id", inputVar["projectId"])
+		assert.Equal(t, "item-id", inputVar["itemId"])
+		assert.Equal(t, "field-id", inputVar["fieldId"])
+	})
+
+	t.Run("BuildUpdateItemPositionVariables omits afterId for top", func(t *testing.T) {
+		variables := BuildUpdateItemPositionVariables(UpdateItemPositionInput{
+			ProjectID: "project-id",
+			ItemID:    "item-id",
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "project-id", inputVar["projectId"])
+		assert.Equal(t, "item-id", inputVar["itemId"])
+		assert.NotContains(t, inputVar, "afterId")
+	})
+
+	t.Run("BuildUpdateItemPositionVariables includes afterId", func(t *testing.T) {
+		afterID := "other-item"
+		variables := BuildUpdateItemPositionVariables(UpdateItemPositionInput{
+			ProjectID: "project-id",
+			ItemID:    "item-id",
+			AfterID:   &afterID,
+		})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "other-item", inputVar["afterId"])
+	})
+
+	t.Run("BuildListProjectItemsVariables applies default page size", func(t *testing.T) {
+		variables := BuildListProjectItemsVariables(ListProjectItemsOptions{ProjectID: "project-id"})
+
+		assert.Equal(t, "project-id", variables["projectId"])
+		assert.Equal(t, 100, variables["first"])
+		assert.NotContains(t, variables, "after")
+	})
+
+	t.Run("BuildListProjectItemsVariables with after cursor", func(t *testing.T) {
+		after := "cursor123"
+		variables := BuildListProjectItemsVariables(ListProjectItemsOptions{
+			ProjectID: "project-id",
+			First:     50,
+			After:     &after,
+		})
+
+		assert.Equal(t, 50, variables["first"])
+		assert.Equal(t, "cursor123", variables["after"])
+	})
+
+	t.Run("BuildListItemFieldValuesVariables pages field values of an item", func(t *testing.T) {
+		variables := BuildListItemFieldValuesVariables("item-id", 0, nil)
+
+		assert.Equal(t, "item-id", variables["itemId"])
+		assert.Equal(t, 100, variables["first"])
+		assert.NotContains(t, variables, "after")
+
+		after := "cursor123"
+		variables = BuildListItemFieldValuesVariables("item-id", 50, &after)
+		assert.Equal(t, "cursor123", variables["after"])
+	})
 }
 
 func TestResponseParsing(t *testing.T) {
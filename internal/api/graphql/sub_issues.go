@@ -0,0 +1,108 @@
+package graphql
+
+// Sub-issue hierarchy types and operations
+
+// SubIssuesSummary represents completion counts of an issue's direct sub-issues
+type SubIssuesSummary struct {
+	Total            int `graphql:"total"`
+	Completed        int `graphql:"completed"`
+	PercentCompleted int `graphql:"percentCompleted"`
+}
+
+// IssueNode represents an issue in a sub-issue hierarchy
+type IssueNode struct {
+	ID               string            `graphql:"id"`
+	Title            string            `graphql:"title"`
+	State            string            `graphql:"state"`
+	URL              string            `graphql:"url"`
+	Repository       ContentRepository `graphql:"repository"`
+	SubIssuesSummary SubIssuesSummary  `graphql:"subIssuesSummary"`
+	Number           int               `graphql:"number"`
+}
+
+// ContentIssueReference represents a reference to another issue, such as a parent issue
+type ContentIssueReference struct {
+	Repository ContentRepository `graphql:"repository"`
+	Number     int               `graphql:"number"`
+}
+
+// Queries
+
+// GetIssueHierarchyQuery gets an issue with its parent and direct sub-issues
+type GetIssueHierarchyQuery struct {
+	Node struct {
+		Issue struct {
+			Parent    *IssueNode `graphql:"parent"`
+			SubIssues struct {
+				Nodes []IssueNode `graphql:"nodes"`
+			} `graphql:"subIssues(first: 100)"`
+			IssueNode
+		} `graphql:"... on Issue"`
+	} `graphql:"node(id: $issueId)"`
+}
+
+// Mutations
+
+// AddSubIssueMutation adds a sub-issue to a parent issue
+type AddSubIssueMutation struct {
+	AddSubIssue struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"addSubIssue(input: $input)"`
+}
+
+// RemoveSubIssueMutation removes a sub-issue from its parent issue
+type RemoveSubIssueMutation struct {
+	RemoveSubIssue struct {
+		ClientMutationID string `graphql:"clientMutationId"`
+	} `graphql:"removeSubIssue(input: $input)"`
+}
+
+// Input Types
+
+// AddSubIssueInput represents input for adding a sub-issue
+type AddSubIssueInput struct {
+	IssueID       string `json:"issueId"`
+	SubIssueID    string `json:"subIssueId"`
+	ReplaceParent bool   `json:"replaceParent,omitempty"`
+}
+
+// RemoveSubIssueInput represents input for removing a sub-issue
+type RemoveSubIssueInput struct {
+	IssueID    string `json:"issueId"`
+	SubIssueID string `json:"subIssueId"`
+}
+
+// Variable Builders
+
+// BuildGetIssueHierarchyVariables builds variables for getting an issue hierarchy
+func BuildGetIssueHierarchyVariables(issueID string) map[string]interface{} {
+	return map[string]interface{}{
+		"issueId": issueID,
+	}
+}
+
+// BuildAddSubIssueVariables builds variables for adding a sub-issue
+func BuildAddSubIssueVariables(input AddSubIssueInput) map[string]interface{} {
+	inputMap := map[string]interface{}{
+		"issueId":    input.IssueID,
+		"subIssueId": input.SubIssueID,
+	}
+
+	if input.ReplaceParent {
+		inputMap["replaceParent"] = true
+	}
+
+	return map[string]interface{}{
+		"input": inputMap,
+	}
+}
+
+// BuildRemoveSubIssueVariables builds variables for removing a sub-issue
+func BuildRemoveSubIssueVariables(input RemoveSubIssueInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": map[string]interface{}{
+			"issueId":    input.IssueID,
+			"subIssueId": input.SubIssueID,
+		},
+	}
+}
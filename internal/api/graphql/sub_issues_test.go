@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubIssueOperations(t *testing.T) {
+	t.Run("GetIssueHierarchy query structure", func(t *testing.T) {
+		query := &GetIssueHierarchyQuery{}
+		assert.NotNil(t, query)
+	})
+
+	t.Run("AddSubIssue mutation structure", func(t *testing.T) {
+		mutation := &AddSubIssueMutation{}
+		assert.NotNil(t, mutation)
+	})
+
+	t.Run("RemoveSubIssue mutation structure", func(t *testing.T) {
+		mutation := &RemoveSubIssueMutation{}
+		assert.NotNil(t, mutation)
+	})
+}
+
+func TestSubIssueVariableBuilders(t *testing.T) {
+	t.Run("BuildGetIssueHierarchyVariables creates proper variables", func(t *testing.T) {
+		variables := BuildGetIssueHierarchyVariables("I_123")
+
+		assert.Equal(t, "I_123", variables["issueId"])
+	})
+
+	t.Run("BuildAddSubIssueVariables omits replaceParent by default", func(t *testing.T) {
+		variables := BuildAddSubIssueVariables(AddSubIssueInput{IssueID: "I_parent", SubIssueID: "I_child"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "I_parent", inputVar["issueId"])
+		assert.Equal(t, "I_child", inputVar["subIssueId"])
+		assert.NotContains(t, inputVar, "replaceParent")
+	})
+
+	t.Run("BuildAddSubIssueVariables includes replaceParent when set", func(t *testing.T) {
+		variables := BuildAddSubIssueVariables(AddSubIssueInput{IssueID: "I_parent", SubIssueID: "I_child", ReplaceParent: true})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, true, inputVar["replaceParent"])
+	})
+
+	t.Run("BuildRemoveSubIssueVariables creates proper variables", func(t *testing.T) {
+		variables := BuildRemoveSubIssueVariables(RemoveSubIssueInput{IssueID: "I_parent", SubIssueID: "I_child"})
+
+		inputVar := variables["input"].(map[string]interface{})
+		assert.Equal(t, "I_parent", inputVar["issueId"])
+		assert.Equal(t, "I_child", inputVar["subIssueId"])
+	})
+}
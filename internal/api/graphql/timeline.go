@@ -0,0 +1,109 @@
+package graphql
+
+import "time"
+
+// Timeline types for project-related issue and pull request events
+
+// TimelineActor represents the user who triggered a timeline event
+type TimelineActor struct {
+	Login string `graphql:"login"`
+}
+
+// TimelineProject represents the project a timeline event refers to
+type TimelineProject struct {
+	ID    string `graphql:"id"`
+	Title string `graphql:"title"`
+}
+
+// TimelineEvent represents a project-related event in an issue or pull request timeline
+type TimelineEvent struct {
+	AddedToProject struct {
+		CreatedAt time.Time        `graphql:"createdAt"`
+		Actor     *TimelineActor   `graphql:"actor"`
+		Project   *TimelineProject `graphql:"project"`
+	} `graphql:"... on AddedToProjectV2Event"`
+	RemovedFromProject struct {
+		CreatedAt time.Time        `graphql:"createdAt"`
+		Actor     *TimelineActor   `graphql:"actor"`
+		Project   *TimelineProject `graphql:"project"`
+	} `graphql:"... on RemovedFromProjectV2Event"`
+	StatusChanged struct {
+		CreatedAt      time.Time        `graphql:"createdAt"`
+		Actor          *TimelineActor   `graphql:"actor"`
+		Project        *TimelineProject `graphql:"project"`
+		PreviousStatus string           `graphql:"previousStatus"`
+		Status         string           `graphql:"status"`
+	} `graphql:"... on ProjectV2ItemStatusChangedEvent"`
+	ConvertedFromDraft struct {
+		CreatedAt time.Time        `graphql:"createdAt"`
+		Actor     *TimelineActor   `graphql:"actor"`
+		Project   *TimelineProject `graphql:"project"`
+	} `graphql:"... on ConvertedFromDraftEvent"`
+	Closed struct {
+		CreatedAt   time.Time      `graphql:"createdAt"`
+		Actor       *TimelineActor `graphql:"actor"`
+		StateReason *string        `graphql:"stateReason"`
+	} `graphql:"... on ClosedEvent"`
+	Reopened struct {
+		CreatedAt time.Time      `graphql:"createdAt"`
+		Actor     *TimelineActor `graphql:"actor"`
+	} `graphql:"... on ReopenedEvent"`
+	Merged struct {
+		CreatedAt time.Time      `graphql:"createdAt"`
+		Actor     *TimelineActor `graphql:"actor"`
+	} `graphql:"... on MergedEvent"`
+	TypeName string `graphql:"__typename"`
+}
+
+// TimelineItems represents a page of timeline events
+type TimelineItems struct {
+	Nodes    []TimelineEvent `graphql:"nodes"`
+	PageInfo PageInfo        `graphql:"pageInfo"`
+}
+
+// Queries
+
+// GetIssueTimelineQuery gets the project-related timeline events of an issue
+type GetIssueTimelineQuery struct {
+	Node struct {
+		Issue struct {
+			TimelineItems TimelineItems `graphql:"timelineItems(first: $first, after: $after, itemTypes: [ADDED_TO_PROJECT_V2_EVENT, REMOVED_FROM_PROJECT_V2_EVENT, PROJECT_V2_ITEM_STATUS_CHANGED_EVENT, CONVERTED_FROM_DRAFT_EVENT, CLOSED_EVENT, REOPENED_EVENT])"`
+		} `graphql:"... on Issue"`
+	} `graphql:"node(id: $contentId)"`
+}
+
+// GetPullRequestTimelineQuery gets the project-related timeline events of a pull request
+type GetPullRequestTimelineQuery struct {
+	Node struct {
+		PullRequest struct {
+			TimelineItems TimelineItems `graphql:"timelineItems(first: $first, after: $after, itemTypes: [ADDED_TO_PROJECT_V2_EVENT, REMOVED_FROM_PROJECT_V2_EVENT, PROJECT_V2_ITEM_STATUS_CHANGED_EVENT, CONVERTED_FROM_DRAFT_EVENT, CLOSED_EVENT, REOPENED_EVENT, MERGED_EVENT])"`
+		} `graphql:"... on PullRequest"`
+	} `graphql:"node(id: $contentId)"`
+}
+
+// TimelineOptions represents options for listing timeline events
+type TimelineOptions struct {
+	After     *string
+	ContentID string
+	First     int
+}
+
+// Variable Builders
+
+// BuildGetTimelineVariables builds variables for getting issue or pull request timeline events
+func BuildGetTimelineVariables(opts TimelineOptions) map[string]interface{} {
+	if opts.First <= 0 {
+		opts.First = 100
+	}
+
+	variables := map[string]interface{}{
+		"contentId": opts.ContentID,
+		"first":     opts.First,
+	}
+
+	if opts.After != nil {
+		variables["after"] = *opts.After
+	}
+
+	return variables
+}
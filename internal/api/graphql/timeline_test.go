@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineQueries(t *testing.T) {
+	t.Run("GetIssueTimeline query structure", func(t *testing.T) {
+		query := &GetIssueTimelineQuery{}
+		assert.NotNil(t, query)
+	})
+
+	t.Run("GetPullRequestTimeline query structure", func(t *testing.T) {
+		query := &GetPullRequestTimelineQuery{}
+		assert.NotNil(t, query)
+	})
+}
+
+func TestBuildGetTimelineVariables(t *testing.T) {
+	t.Run("Defaults page size and omits cursor", func(t *testing.T) {
+		variables := BuildGetTimelineVariables(TimelineOptions{ContentID: "I_123"})
+
+		assert.Equal(t, "I_123", variables["contentId"])
+		assert.Equal(t, 100, variables["first"])
+		assert.NotContains(t, variables, "after")
+	})
+
+	t.Run("Includes cursor when paginating", func(t *testing.T) {
+		cursor := "Y3Vyc29y"
+		variables := BuildGetTimelineVariables(TimelineOptions{ContentID: "I_123", First: 50, After: &cursor})
+
+		assert.Equal(t, 50, variables["first"])
+		assert.Equal(t, "Y3Vyc29y", variables["after"])
+	})
+}
@@ -0,0 +1,108 @@
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ErrNoBrowser is returned when no browser can be launched, e.g. in a headless session
+var ErrNoBrowser = errors.New("no browser available")
+
+// Launcher opens URLs in the user's web browser.
+//
+// The browser is chosen in this order:
+//  1. the "browser" config setting (browser: in ~/.ghp.yaml or GHP_BROWSER)
+//  2. the $BROWSER environment variable
+//  3. the platform default: open (macOS), start (Windows) or xdg-open (Linux and others)
+type Launcher struct {
+	getenv   func(string) string
+	lookPath func(string) (string, error)
+	run      func(name string, args ...string) error
+	browser  string
+	goos     string
+}
+
+// NewLauncher creates a launcher using the configured browser and the current platform
+func NewLauncher() *Launcher {
+	return &Launcher{
+		browser:  viper.GetString("browser"),
+		goos:     runtime.GOOS,
+		getenv:   os.Getenv,
+		lookPath: exec.LookPath,
+		run: func(name string, args ...string) error {
+			return exec.Command(name, args...).Start()
+		},
+	}
+}
+
+// Command returns the command and arguments used to open a URL
+func (l *Launcher) Command(url string) (string, []string, error) {
+	browser := l.browser
+	if browser == "" {
+		// $BROWSER may hold a colon-separated list of candidates
+		browser = strings.Split(l.getenv("BROWSER"), ":")[0]
+	}
+
+	if browser = strings.TrimSpace(browser); browser != "" {
+		parts := strings.Fields(browser)
+		return parts[0], append(parts[1:], url), nil
+	}
+
+	switch l.goos {
+	case "darwin":
+		return "open", []string{url}, nil
+	case "windows":
+		// The empty argument is the window title expected by start
+		return "cmd", []string{"/c", "start", "", strings.ReplaceAll(url, "&", "^&")}, nil
+	default:
+		if l.getenv("DISPLAY") == "" && l.getenv("WAYLAND_DISPLAY") == "" {
+			return "", nil, ErrNoBrowser
+		}
+		return "xdg-open", []string{url}, nil
+	}
+}
+
+// Open opens a URL in the browser
+func (l *Launcher) Open(url string) error {
+	name, args, err := l.Command(url)
+	if err != nil {
+		return err
+	}
+
+	if _, err := l.lookPath(name); err != nil {
+		return fmt.Errorf("%w: %s not found", ErrNoBrowser, name)
+	}
+
+	if err := l.run(name, args...); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	return nil
+}
+
+// OpenURL opens a URL in the browser, or prints it when printOnly is set.
+// When no browser is available the URL is printed instead so it can be opened manually.
+func OpenURL(url string, printOnly bool) error {
+	if printOnly {
+		fmt.Println(url)
+		return nil
+	}
+
+	err := NewLauncher().Open(url)
+	if errors.Is(err, ErrNoBrowser) {
+		fmt.Printf("⚠️  Could not open a browser (%v). Open this URL instead:\n%s\n", err, url)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Opening %s in your browser.\n", url)
+	return nil
+}
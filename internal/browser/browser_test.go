@@ -0,0 +1,96 @@
+package browser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLauncher(goos, browser string, env map[string]string) *Launcher {
+	return &Launcher{
+		browser:  browser,
+		goos:     goos,
+		getenv:   func(key string) string { return env[key] },
+		lookPath: func(name string) (string, error) { return "/usr/bin/" + name, nil },
+		run:      func(string, ...string) error { return nil },
+	}
+}
+
+func TestLauncherCommand(t *testing.T) {
+	const url = "https://github.com/orgs/myorg/projects/1"
+
+	t.Run("Config override takes precedence over $BROWSER", func(t *testing.T) {
+		launcher := newTestLauncher("linux", "firefox --new-tab", map[string]string{"BROWSER": "chromium"})
+
+		name, args, err := launcher.Command(url)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "firefox", name)
+		assert.Equal(t, []string{"--new-tab", url}, args)
+	})
+
+	t.Run("$BROWSER uses the first candidate", func(t *testing.T) {
+		launcher := newTestLauncher("linux", "", map[string]string{"BROWSER": "w3m:lynx"})
+
+		name, args, err := launcher.Command(url)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "w3m", name)
+		assert.Equal(t, []string{url}, args)
+	})
+
+	t.Run("macOS uses open", func(t *testing.T) {
+		name, args, err := newTestLauncher("darwin", "", nil).Command(url)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "open", name)
+		assert.Equal(t, []string{url}, args)
+	})
+
+	t.Run("Windows uses start and escapes ampersands", func(t *testing.T) {
+		name, args, err := newTestLauncher("windows", "", nil).Command("https://example.com/?a=1&b=2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cmd", name)
+		assert.Equal(t, []string{"/c", "start", "", "https://example.com/?a=1^&b=2"}, args)
+	})
+
+	t.Run("Linux with a display uses xdg-open", func(t *testing.T) {
+		name, _, err := newTestLauncher("linux", "", map[string]string{"DISPLAY": ":0"}).Command(url)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "xdg-open", name)
+	})
+
+	t.Run("Headless Linux has no browser", func(t *testing.T) {
+		_, _, err := newTestLauncher("linux", "", nil).Command(url)
+
+		assert.ErrorIs(t, err, ErrNoBrowser)
+	})
+}
+
+func TestLauncherOpen(t *testing.T) {
+	t.Run("Runs the browser command", func(t *testing.T) {
+		var ran []string
+		launcher := newTestLauncher("darwin", "", nil)
+		launcher.run = func(name string, args ...string) error {
+			ran = append([]string{name}, args...)
+			return nil
+		}
+
+		err := launcher.Open("https://github.com")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"open", "https://github.com"}, ran)
+	})
+
+	t.Run("Missing browser executable returns ErrNoBrowser", func(t *testing.T) {
+		launcher := newTestLauncher("darwin", "", nil)
+		launcher.lookPath = func(string) (string, error) { return "", errors.New("not found") }
+
+		err := launcher.Open("https://github.com")
+
+		assert.ErrorIs(t, err, ErrNoBrowser)
+	})
+}
@@ -0,0 +1,162 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// BulkArchiveOptions holds options for the bulk-archive command
+type BulkArchiveOptions struct {
+	ProjectRef string
+	Format     string
+	ItemIDs    []string
+	Unarchive  bool
+	Org        bool
+}
+
+// NewBulkArchiveCmd creates the bulk-archive command
+func NewBulkArchiveCmd() *cobra.Command {
+	opts := &BulkArchiveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "bulk-archive <owner/project-number>",
+		Short: "Bulk archive project items",
+		Long: `Perform bulk archiving of multiple project items.
+
+This command allows you to archive multiple project items at once.
+Archived items are hidden from normal views but remain accessible
+and can be unarchived if needed with --unarchive or 'ghp item unarchive'.
+
+Examples:
+  ghp analytics bulk-archive octocat/123 --items item1,item2,item3
+  ghp analytics bulk-archive octocat/123 --items item1,item2 --format json
+  ghp analytics bulk-archive octocat/123 --items item1,item2 --unarchive
+  ghp analytics bulk-archive --org myorg/456 --items item1,item2,item3`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+
+			if itemsStr, _ := cmd.Flags().GetString("items"); itemsStr != "" {
+				for _, id := range strings.Split(itemsStr, ",") {
+					if id = strings.TrimSpace(id); id != "" {
+						opts.ItemIDs = append(opts.ItemIDs, id)
+					}
+				}
+			}
+
+			return runBulkArchive(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().String("items", "", "Comma-separated list of item IDs")
+	cmd.Flags().BoolVar(&opts.Unarchive, "unarchive", false, "Restore the items instead of archiving them")
+	cmd.Flags().BoolVar(&opts.Org, "org", false, "Target organization project")
+
+	// Make items flag required
+	_ = cmd.MarkFlagRequired("items")
+
+	return cmd
+}
+
+func runBulkArchive(ctx context.Context, opts *BulkArchiveOptions) error {
+	// Validate input
+	if len(opts.ItemIDs) == 0 {
+		return fmt.Errorf("no items specified for archiving")
+	}
+
+	// Parse project reference
+	parts := strings.Split(opts.ProjectRef, "/")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid project reference format. Use: owner/project-number")
+	}
+
+	owner := parts[0]
+	projectNumber, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid project number: %s", parts[1])
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	projectService := service.NewProjectService(client)
+	itemService := service.NewItemService(client)
+
+	// Get project to validate access and get project ID, detecting the owner type unless --org is given
+	var project *graphql.ProjectV2
+	if opts.Org {
+		project, err = projectService.GetProject(ctx, owner, projectNumber, true)
+	} else {
+		project, err = projectService.GetProjectWithOwnerDetection(ctx, owner, projectNumber)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	result := itemService.BulkArchiveItems(ctx, project.ID, opts.ItemIDs, !opts.Unarchive)
+
+	return outputBulkArchiveResult(result, opts)
+}
+
+func outputBulkArchiveResult(result *service.BulkArchiveResult, opts *BulkArchiveOptions) error {
+	action := "archive"
+	if opts.Unarchive {
+		action = "unarchive"
+	}
+
+	switch opts.Format {
+	case FormatJSON:
+		fmt.Printf("{\n")
+		fmt.Printf("  \"success\": %t,\n", result.Failed == 0)
+		fmt.Printf("  \"operation\": \"%s\",\n", action)
+		fmt.Printf("  \"totalItems\": %d,\n", len(opts.ItemIDs))
+		fmt.Printf("  \"processedItems\": %d,\n", result.Processed)
+		fmt.Printf("  \"failedItems\": %d,\n", result.Failed)
+		fmt.Printf("  \"errors\": [")
+		for i, errMsg := range result.Errors {
+			if i > 0 {
+				fmt.Printf(",")
+			}
+			fmt.Printf("\n    %q", errMsg)
+		}
+		if len(result.Errors) > 0 {
+			fmt.Printf("\n  ")
+		}
+		fmt.Printf("]\n}\n")
+	case FormatTable:
+		fmt.Printf("✅ Bulk %s completed\n\n", action)
+		fmt.Printf("  Total Items: %d\n", len(opts.ItemIDs))
+		fmt.Printf("  Processed Items: %d\n", result.Processed)
+		if result.Failed > 0 {
+			fmt.Printf("  Failed Items: %d\n", result.Failed)
+			for _, errMsg := range result.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d items", action, result.Failed, len(opts.ItemIDs))
+	}
+
+	return nil
+}
@@ -2,13 +2,17 @@ package analytics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
@@ -25,6 +29,30 @@ type BulkUpdateOptions struct {
 	ItemIDs    []string
 }
 
+// bulkOperationJSON is the JSON representation of a started bulk operation
+type bulkOperationJSON struct {
+	IssueEdit      *issueEditJSON `json:"issueEdit,omitempty"`
+	OperationID    string         `json:"operationId"`
+	Type           string         `json:"type"`
+	Status         string         `json:"status"`
+	CreatedAt      string         `json:"createdAt"`
+	CompletedAt    string         `json:"completedAt,omitempty"`
+	ErrorMessage   string         `json:"errorMessage,omitempty"`
+	Progress       float64        `json:"progress"`
+	TotalItems     int            `json:"totalItems"`
+	ProcessedItems int            `json:"processedItems"`
+	FailedItems    int            `json:"failedItems"`
+	Success        bool           `json:"success"`
+}
+
+// issueEditJSON is the JSON representation of the assignee, label and milestone updates
+type issueEditJSON struct {
+	Errors         []string `json:"errors"`
+	ProcessedItems int      `json:"processedItems"`
+	FailedItems    int      `json:"failedItems"`
+	Success        bool     `json:"success"`
+}
+
 // NewBulkUpdateCmd creates the bulk-update command
 func NewBulkUpdateCmd() *cobra.Command {
 	opts := &BulkUpdateOptions{
@@ -40,16 +68,27 @@ This command allows you to update multiple project items at once, which is
 much more efficient than updating items individually. You can update any
 field values including status, assignees, labels, milestones, and custom fields.
 
-The bulk update operation runs asynchronously, so you can check the status
+Assignees, labels and milestones belong to the underlying issue or pull
+request rather than the project, so they are applied to each item's content
+directly. Labels and assignees are added; existing ones are kept. Draft
+issues are skipped for these updates. With --format json, their results are
+included under "issueEdit", and the command exits with an error when any
+item could not be updated.
+
+--status and --priority are checked against the project's field rules (see
+'ghp field lint') first; nothing is updated when they would make an item
+break a rule.
+
+Project field updates run asynchronously, so you can check the status
 using the 'operation-status' command with the operation ID returned.
 
 Update Options:
   --items              Comma-separated list of item IDs to update
   --field-<name>       Set field value (e.g., --field-status Done, --field-priority High)
   --status             Set status field value
-  --assignee           Set assignee field value
-  --labels             Set labels field value (comma-separated)
-  --milestone          Set milestone field value
+  --assignee           Add assignees (comma-separated)
+  --labels             Add labels (comma-separated)
+  --milestone          Set milestone by title or number
 
 Examples:
   ghp analytics bulk-update octocat/123 --items item1,item2,item3 --status Done
@@ -75,14 +114,10 @@ Examples:
 				opts.Updates["status"] = status
 			}
 			if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
-				opts.Updates["assignee"] = assignee
+				opts.Updates["assignee"] = splitList(assignee)
 			}
 			if labels, _ := cmd.Flags().GetString("labels"); labels != "" {
-				labelList := strings.Split(labels, ",")
-				for i, label := range labelList {
-					labelList[i] = strings.TrimSpace(label)
-				}
-				opts.Updates["labels"] = labelList
+				opts.Updates["labels"] = splitList(labels)
 			}
 			if milestone, _ := cmd.Flags().GetString("milestone"); milestone != "" {
 				opts.Updates["milestone"] = milestone
@@ -97,9 +132,9 @@ Examples:
 
 	cmd.Flags().String("items", "", "Comma-separated list of item IDs")
 	cmd.Flags().String("status", "", "Status field value")
-	cmd.Flags().String("assignee", "", "Assignee field value")
-	cmd.Flags().String("labels", "", "Labels field value (comma-separated)")
-	cmd.Flags().String("milestone", "", "Milestone field value")
+	cmd.Flags().String("assignee", "", "Assignees to add (comma-separated)")
+	cmd.Flags().String("labels", "", "Labels to add (comma-separated)")
+	cmd.Flags().String("milestone", "", "Milestone title or number to set")
 	cmd.Flags().String("priority", "", "Priority field value")
 	cmd.Flags().Bool("org", false, "Target organization project")
 
@@ -142,6 +177,8 @@ func runBulkUpdate(ctx context.Context, opts *BulkUpdateOptions) error {
 	client := api.NewClient(token)
 	projectService := service.NewProjectService(client)
 	analyticsService := service.NewAnalyticsService(client)
+	itemService := service.NewItemService(client)
+	issueService := service.NewIssueService(client)
 
 	// Get project to validate access and get project ID (with automatic owner detection)
 	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, projectNumber)
@@ -149,6 +186,35 @@ func runBulkUpdate(ctx context.Context, opts *BulkUpdateOptions) error {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	// Check the field rules before changing anything
+	if err := checkBulkUpdateRules(ctx, itemService, project.ID, project.Fields.Nodes, opts); err != nil {
+		return err
+	}
+
+	// Repository-level properties cannot be set through the project API
+	var editResult *service.IssueEditResult
+	edit := extractIssueEdit(opts.Updates)
+	if !edit.IsEmpty() {
+		editResult, err = applyIssueEdit(ctx, itemService, issueService, project.ID, opts.ItemIDs, edit)
+		if err != nil {
+			return err
+		}
+
+		if len(opts.Updates) == 0 {
+			if err := outputIssueEditResult(editResult, opts.Format); err != nil {
+				return err
+			}
+			return issueEditError(editResult, len(opts.ItemIDs))
+		}
+
+		if opts.Format == FormatTable {
+			if err := outputIssueEditResult(editResult, opts.Format); err != nil {
+				return err
+			}
+			fmt.Println()
+		}
+	}
+
 	// Prepare bulk update input
 	input := service.BulkUpdateItemsInput{
 		ProjectID: project.ID,
@@ -163,13 +229,168 @@ func runBulkUpdate(ctx context.Context, opts *BulkUpdateOptions) error {
 	}
 
 	// Output operation result
-	return outputBulkOperation(operation, "update", opts.Format)
+	if err := outputBulkOperation(operation, editResult, "update", opts.Format); err != nil {
+		return err
+	}
+	return issueEditError(editResult, len(opts.ItemIDs))
+}
+
+// checkBulkUpdateRules refuses --status and --priority updates that would make any of
+// the items break the project's field rules (see 'ghp field lint')
+func checkBulkUpdateRules(ctx context.Context, itemService *service.ItemService, projectID string,
+	fields []graphql.ProjectV2Field, opts *BulkUpdateOptions) error {
+	changes := make(map[string]string)
+	for _, name := range []string{"status", "priority"} {
+		if value, ok := opts.Updates[name].(string); ok {
+			changes[name] = value
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	rules, err := service.LoadProjectFieldRules(viper.GetString("rules"), opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	if err := service.ValidateFieldRules(rules, fields); err != nil {
+		return fmt.Errorf("invalid field rules for %s: %w", opts.ProjectRef, err)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, projectID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	now := time.Now()
+	var problems []string
+	for _, itemID := range opts.ItemIDs {
+		item, findErr := service.FindProjectItem(items, itemID)
+		if findErr != nil {
+			return findErr
+		}
+		if violations := service.CheckFieldChange(rules, item, changes, now); len(violations) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s", itemID, service.FormatRuleViolations(violations)))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	fmt.Println("❌ The update breaks field rules:")
+	for _, problem := range problems {
+		fmt.Printf("  • %s\n", problem)
+	}
+	return fmt.Errorf("the update breaks field rules on %d of %d items", len(problems), len(opts.ItemIDs))
+}
+
+// issueEditError returns an error when assignees, labels or milestones could not be updated
+func issueEditError(result *service.IssueEditResult, items int) error {
+	if result == nil || result.Failed == 0 {
+		return nil
+	}
+	return fmt.Errorf("failed to update assignees, labels or milestone on %d of %d items", result.Failed, items)
+}
+
+// extractIssueEdit removes repository-level updates from the project field updates
+func extractIssueEdit(updates map[string]interface{}) *service.IssueEdit {
+	edit := &service.IssueEdit{}
+
+	if assignees, ok := updates["assignee"].([]string); ok {
+		edit.AddAssignees = assignees
+		delete(updates, "assignee")
+	}
+
+	if labels, ok := updates["labels"].([]string); ok {
+		edit.AddLabels = labels
+		delete(updates, "labels")
+	}
+
+	if milestone, ok := updates["milestone"].(string); ok {
+		edit.Milestone = &milestone
+		delete(updates, "milestone")
+	}
+
+	return edit
+}
+
+// applyIssueEdit applies a repository-level edit to the content of the given project items
+func applyIssueEdit(ctx context.Context, itemService *service.ItemService, issueService *service.IssueService,
+	projectID string, itemIDs []string, edit *service.IssueEdit) (*service.IssueEditResult, error) {
+	items, err := itemService.ListProjectItems(ctx, projectID, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	selected := make([]service.ProjectItemInfo, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		item, findErr := service.FindProjectItem(items, itemID)
+		if findErr != nil {
+			return nil, findErr
+		}
+		selected = append(selected, *item)
+	}
+
+	targets, drafts := service.IssueTargetsFromProjectItems(selected)
+	result := issueService.ApplyEdits(ctx, targets, edit)
+	for _, title := range drafts {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: draft issues have no labels, assignees or milestone", title))
+		result.Failed++
+	}
+
+	return result, nil
+}
+
+func outputIssueEditResult(result *service.IssueEditResult, format string) error {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(newIssueEditJSON(result), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case FormatTable:
+		fmt.Printf("✅ Updated assignees, labels or milestone on %d items\n", result.Processed)
+		if result.Failed > 0 {
+			fmt.Printf("❌ Failed to update %d items\n", result.Failed)
+			for _, errMsg := range result.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+	return nil
+}
+
+func newIssueEditJSON(result *service.IssueEditResult) *issueEditJSON {
+	errors := result.Errors
+	if errors == nil {
+		errors = []string{}
+	}
+	return &issueEditJSON{
+		Errors:         errors,
+		ProcessedItems: result.Processed,
+		FailedItems:    result.Failed,
+		Success:        result.Failed == 0,
+	}
 }
 
-func outputBulkOperation(operation *service.BulkOperation, operationType, format string) error {
+func splitList(value string) []string {
+	parts := strings.Split(value, ",")
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+	return parts
+}
+
+func outputBulkOperation(operation *service.BulkOperation, editResult *service.IssueEditResult, operationType, format string) error {
 	switch format {
 	case FormatJSON:
-		return outputBulkOperationJSON(operation)
+		return outputBulkOperationJSON(operation, editResult)
 	case FormatTable:
 		return outputBulkOperationTable(operation, operationType)
 	default:
@@ -205,26 +426,35 @@ func outputBulkOperationTable(operation *service.BulkOperation, operationType st
 	return nil
 }
 
-func outputBulkOperationJSON(operation *service.BulkOperation) error {
-	fmt.Printf("{\n")
-	fmt.Printf("  \"success\": true,\n")
-	fmt.Printf("  \"operationId\": \"%s\",\n", operation.ID)
-	fmt.Printf("  \"type\": \"%s\",\n", operation.Type)
-	fmt.Printf("  \"status\": \"%s\",\n", operation.Status)
-	fmt.Printf("  \"progress\": %.3f,\n", operation.Progress)
-	fmt.Printf("  \"totalItems\": %d,\n", operation.TotalItems)
-	fmt.Printf("  \"processedItems\": %d,\n", operation.ProcessedItems)
-	fmt.Printf("  \"failedItems\": %d,\n", operation.FailedItems)
-	fmt.Printf("  \"createdAt\": \"%s\"", operation.CreatedAt.Format("2006-01-02T15:04:05Z"))
-
+// outputBulkOperationJSON prints a bulk operation, including the assignee, label and
+// milestone updates applied alongside it, as a single JSON document
+func outputBulkOperationJSON(operation *service.BulkOperation, editResult *service.IssueEditResult) error {
+	output := bulkOperationJSON{
+		OperationID:    operation.ID,
+		Type:           string(operation.Type),
+		Status:         string(operation.Status),
+		CreatedAt:      operation.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		Progress:       operation.Progress,
+		TotalItems:     operation.TotalItems,
+		ProcessedItems: operation.ProcessedItems,
+		FailedItems:    operation.FailedItems,
+		Success:        true,
+	}
 	if operation.CompletedAt != nil {
-		fmt.Printf(",\n  \"completedAt\": \"%s\"", operation.CompletedAt.Format("2006-01-02T15:04:05Z"))
+		output.CompletedAt = operation.CompletedAt.Format("2006-01-02T15:04:05Z")
 	}
-
 	if operation.ErrorMessage != nil {
-		fmt.Printf(",\n  \"errorMessage\": \"%s\"", *operation.ErrorMessage)
+		output.ErrorMessage = *operation.ErrorMessage
+	}
+	if editResult != nil {
+		output.IssueEdit = newIssueEditJSON(editResult)
+		output.Success = editResult.Failed == 0
 	}
 
-	fmt.Printf("\n}\n")
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
 	return nil
 }
@@ -173,36 +173,6 @@ Examples:
 	return cmd
 }
 
-// NewBulkArchiveCmd creates the bulk-archive command (placeholder)
-func NewBulkArchiveCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "bulk-archive <owner/project-number>",
-		Short: "Bulk archive project items",
-		Long: `Perform bulk archiving of multiple project items.
-
-This command allows you to archive multiple project items at once.
-Archived items are hidden from normal views but remain accessible
-and can be unarchived if needed.
-
-Examples:
-  ghp analytics bulk-archive octocat/123 --items item1,item2,item3
-  ghp analytics bulk-archive octocat/123 --items item1,item2 --format json
-  ghp analytics bulk-archive --org myorg/456 --items item1,item2,item3`,
-
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return fmt.Errorf("bulk archive functionality not yet implemented - coming in future release")
-		},
-	}
-
-	cmd.Flags().String("items", "", "Comma-separated list of item IDs")
-	cmd.Flags().Bool("org", false, "Target organization project")
-
-	// Make items flag required
-	_ = cmd.MarkFlagRequired("items")
-
-	return cmd
-}
-
 // NewOperationStatusCmd creates the operation-status command (placeholder)
 func NewOperationStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
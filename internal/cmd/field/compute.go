@@ -0,0 +1,315 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// ComputeOptions holds options for the compute command
+type ComputeOptions struct {
+	ProjectRef string
+	File       string
+	Format     string
+	DryRun     bool
+}
+
+// fieldComputeJSON is the JSON representation of a compute run
+type fieldComputeJSON struct {
+	Project   string               `json:"project"`
+	Changes   []computedChangeJSON `json:"changes"`
+	Problems  []computedChangeJSON `json:"problems"`
+	Summaries []computeSummaryJSON `json:"summaries"`
+	Errors    []string             `json:"errors,omitempty"`
+	Applied   int                  `json:"applied"`
+	Failed    int                  `json:"failed"`
+	DryRun    bool                 `json:"dry_run"`
+}
+
+type computedChangeJSON struct {
+	ItemID  string `json:"item_id"`
+	Item    string `json:"item"`
+	Field   string `json:"field"`
+	Current string `json:"current,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+type computeSummaryJSON struct {
+	Field   string `json:"field"`
+	Items   int    `json:"items"`
+	Changed int    `json:"changed"`
+	Failed  int    `json:"failed"`
+}
+
+// NewComputeCmd creates the compute command
+func NewComputeCmd() *cobra.Command {
+	opts := &ComputeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "compute <owner>/<number> -f <file>",
+		Short: "Update computed fields from expressions",
+		Long: `Compute field values from expressions over other field values and item
+metadata, and write back the values that changed. Computed fields must be
+text, number, date or single select fields of the project.
+
+A computed field file (YAML or JSON) lists the fields in the order they are
+computed; an expression can use the fields computed before it. A filter
+limits the items a field is computed for.
+
+  fields:
+    - field: Days in status
+      expression: days_since(changed(Status))
+    - field: Age
+      expression: days_since(@created)
+      filter: is:open
+    - field: Score
+      expression: round(Impact * Confidence / Effort, 1)
+
+Expressions:
+  Impact, [Due Date]      field values (brackets for names with spaces)
+  @created, @updated, @today, @number, @title, @state, @type,
+  @repository, @milestone item metadata
+  + - * /  == != < <= > >=  && || !  operators
+  "text", 3.5, true       literals
+
+Functions:
+  days_since(date), days_between(from, to), add_days(date, n),
+  changed(field)          when the field's value last changed
+  round(x[, digits]), floor(x), ceil(x), abs(x), min(...), max(...)
+  if(condition, then[, else]), coalesce(...),
+  switch(value, case, result, ...[, default]), concat(...), has_label(name)
+
+Empty values stay empty through arithmetic, and division by zero is empty;
+an empty result clears the field. Archived items are skipped. Only values
+that differ are written, so compute can safely run on a schedule, e.g. from
+a cron job. Avoid @updated in expressions, since writing a computed value
+changes it. Changes that break the project's field rules (see 'ghp field
+lint') are not written.
+
+Examples:
+  ghp field compute octocat/123 -f computed.yaml --dry-run
+  ghp field compute octocat/123 -f computed.yaml
+  ghp field compute octocat/123 -f computed.yaml --format json`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runCompute(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Computed field file (YAML or JSON)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runCompute(ctx context.Context, opts *ComputeOptions) error {
+	config, err := service.LoadComputeConfig(opts.File)
+	if err != nil {
+		return err
+	}
+
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	rules, err := service.LoadProjectFieldRules(viper.GetString("rules"), opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if err := service.ValidateComputeConfig(config, project.Fields.Nodes); err != nil {
+		return fmt.Errorf("invalid computed field file: %w", err)
+	}
+	if err := service.ValidateFieldRules(rules, project.Fields.Nodes); err != nil {
+		return fmt.Errorf("invalid field rules for %s: %w", opts.ProjectRef, err)
+	}
+
+	projectItems, err := projectService.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	now := time.Now()
+	plan, err := service.PlanFieldCompute(config, project.Fields.Nodes, service.ConvertProjectItems(projectItems), now)
+	if err != nil {
+		return err
+	}
+	excludeRuleViolations(plan, rules, now)
+
+	report := newFieldComputeReport(project.Title, plan)
+	report.DryRun = opts.DryRun
+
+	if !opts.DryRun && len(plan.Changes) > 0 {
+		result := itemService.ApplyFieldCompute(ctx, project.ID, project.Fields.Nodes, plan)
+		report.Applied = result.Updated
+		report.Failed = result.Failed
+		report.Errors = result.Errors
+	}
+
+	if err := outputFieldCompute(report, opts.Format); err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("failed to write %d computed values", report.Failed)
+	}
+	if len(report.Problems) > 0 {
+		return fmt.Errorf("%d computed values could not be computed or break field rules", len(report.Problems))
+	}
+	return nil
+}
+
+// excludeRuleViolations moves changes that break field rules from the plan's changes to its errors
+func excludeRuleViolations(plan *service.ComputePlan, rules []service.FieldRule, now time.Time) {
+	if len(rules) == 0 {
+		return
+	}
+
+	changes := plan.Changes[:0]
+	for i := range plan.Changes {
+		change := plan.Changes[i]
+		violations := service.CheckFieldChange(rules, &change.Item, map[string]string{change.Field: change.Value}, now)
+		if len(violations) == 0 {
+			changes = append(changes, change)
+			continue
+		}
+
+		plan.Errors = append(plan.Errors, service.ComputeError{
+			Field:   change.Field,
+			Message: "breaks field rules: " + service.FormatRuleViolations(violations),
+			Item:    change.Item,
+		})
+		for j := range plan.Summaries {
+			if strings.EqualFold(plan.Summaries[j].Field, change.Field) {
+				plan.Summaries[j].Changed--
+				plan.Summaries[j].Failed++
+			}
+		}
+	}
+	plan.Changes = changes
+}
+
+func newFieldComputeReport(projectName string, plan *service.ComputePlan) *fieldComputeJSON {
+	report := &fieldComputeJSON{
+		Project:   projectName,
+		Changes:   make([]computedChangeJSON, len(plan.Changes)),
+		Problems:  make([]computedChangeJSON, len(plan.Errors)),
+		Summaries: make([]computeSummaryJSON, len(plan.Summaries)),
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+		report.Changes[i] = computedChangeJSON{
+			ItemID:  change.Item.ItemID,
+			Item:    projectItemLabel(&change.Item),
+			Field:   change.Field,
+			Current: change.Current,
+			Value:   change.Value,
+		}
+	}
+	for i := range plan.Errors {
+		problem := &plan.Errors[i]
+		report.Problems[i] = computedChangeJSON{
+			ItemID: problem.Item.ItemID,
+			Item:   projectItemLabel(&problem.Item),
+			Field:  problem.Field,
+			Error:  problem.Message,
+		}
+	}
+	for i, summary := range plan.Summaries {
+		report.Summaries[i] = computeSummaryJSON(summary)
+	}
+
+	return report
+}
+
+func outputFieldCompute(report *fieldComputeJSON, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if len(report.Changes) == 0 {
+			fmt.Printf("✅ Computed fields in %s are up to date\n", report.Project)
+		} else {
+			fmt.Printf("Computed values that changed in %s:\n\n", report.Project)
+			for _, change := range report.Changes {
+				fmt.Printf("  ~ %s: %s %s → %s\n", change.Item, change.Field, emptyValue(change.Current), emptyValue(change.Value))
+			}
+		}
+
+		if len(report.Problems) > 0 {
+			fmt.Printf("\n⚠️  Values that were not computed:\n")
+			for _, problem := range report.Problems {
+				fmt.Printf("  • %s: %s: %s\n", problem.Item, problem.Field, problem.Error)
+			}
+		}
+
+		fmt.Printf("\n%-24s %7s %8s %7s\n", "FIELD", "ITEMS", "CHANGED", "FAILED")
+		for _, summary := range report.Summaries {
+			fmt.Printf("%-24s %7d %8d %7d\n", summary.Field, summary.Items, summary.Changed, summary.Failed)
+		}
+		fmt.Println()
+
+		switch {
+		case report.DryRun:
+			fmt.Printf("Dry run: %s was not changed.\n", report.Project)
+		case report.Applied > 0:
+			fmt.Printf("✅ Updated %d computed values\n", report.Applied)
+		}
+		if report.Failed > 0 {
+			fmt.Printf("❌ Failed to write %d computed values\n", report.Failed)
+			for _, errMsg := range report.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func emptyValue(value string) string {
+	if value == "" {
+		return "(empty)"
+	}
+	return value
+}
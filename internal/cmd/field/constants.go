@@ -2,6 +2,7 @@ package field
 
 const (
 	// Format constants
-	formatJSON  = "json"
-	formatTable = "table"
+	formatJSON       = "json"
+	formatTable      = "table"
+	formatJSONSchema = "json-schema"
 )
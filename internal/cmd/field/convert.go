@@ -0,0 +1,368 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// ConvertOptions holds options for the convert command
+type ConvertOptions struct {
+	ProjectRef string
+	Field      string
+	To         string
+	Name       string
+	Format     string
+	Replace    bool
+	DryRun     bool
+	Force      bool
+}
+
+// conversionReportJSON is the JSON representation of a field conversion
+type conversionReportJSON struct {
+	Field         string              `json:"field"`
+	From          string              `json:"from"`
+	To            string              `json:"to"`
+	NewFieldID    string              `json:"new_field_id,omitempty"`
+	NewFieldName  string              `json:"new_field_name,omitempty"`
+	Options       []string            `json:"options,omitempty"`
+	Unconvertible []unconvertibleJSON `json:"unconvertible"`
+	Errors        []string            `json:"errors,omitempty"`
+	Converted     int                 `json:"converted"`
+	Migrated      int                 `json:"migrated"`
+	Failed        int                 `json:"failed"`
+	DryRun        bool                `json:"dry_run"`
+	Replaced      bool                `json:"replaced"`
+}
+
+type unconvertibleJSON struct {
+	ItemID string `json:"item_id"`
+	Item   string `json:"item"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// NewConvertCmd creates the convert command
+func NewConvertCmd() *cobra.Command {
+	opts := &ConvertOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "convert <owner>/<number> <field>",
+		Short: "Convert a field to another type",
+		Long: `Convert a project field to another data type, migrating the values
+of every item including archived ones.
+
+GitHub does not allow changing the type of a field, so a new field of the
+target type is created next to the original and each item's value is
+converted and copied to it. Values that cannot be converted (for example
+"large" to a number) are listed in the report and left out.
+
+For single select targets the options are derived from the existing values.
+Dates are recognized in common formats such as 2025-03-07, 2025/03/07,
+03/07/2025 (month first) and "Mar 7, 2025".
+
+The new field is named "<field> (<type>)" unless --name is given. With
+--replace the original field is deleted afterwards and the new field takes
+its name. Replacing asks for confirmation and refuses to run when values
+would be lost; --force skips both checks.
+
+Target types: text, number, date, single_select
+
+Examples:
+  ghp field convert octocat/123 Estimate --to number --dry-run
+  ghp field convert octocat/123 Priority --to single_select
+  ghp field convert octocat/123 "Due" --to date --replace
+  ghp field convert octocat/123 Size --to text --name "Size notes"`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Field = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runConvert(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.To, "to", "", "Target field type (text, number, date, single_select)")
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Name of the new field")
+	cmd.Flags().BoolVar(&opts.Replace, "replace", false, "Delete the original field and give the new field its name")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the conversion report without creating the field")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Replace without confirmation, even when values cannot be converted")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func runConvert(ctx context.Context, opts *ConvertOptions) error {
+	target, err := service.ValidateFieldType(opts.To)
+	if err != nil {
+		return err
+	}
+
+	if opts.Name != "" {
+		if validateErr := service.ValidateFieldName(opts.Name); validateErr != nil {
+			return validateErr
+		}
+	}
+
+	if opts.Replace && !opts.Force && opts.Format == formatJSON {
+		return fmt.Errorf("--replace with JSON output requires --force")
+	}
+
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	field, err := findField(project.Fields.Nodes, opts.Field)
+	if err != nil {
+		return err
+	}
+
+	// Archived items keep their values, so convert them too
+	projectItems, err := projectService.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	// Values that were not loaded would be missing from the plan and lost with the original field
+	if opts.Replace {
+		for i := range projectItems {
+			if projectItems[i].FieldValues.PageInfo.HasNextPage {
+				return fmt.Errorf("not every field value of item %s was loaded; refusing to replace '%s'", projectItems[i].ID, field.Name)
+			}
+		}
+	}
+
+	plan, err := service.PlanFieldConversion(service.ConvertProjectItems(projectItems), field, target)
+	if err != nil {
+		return err
+	}
+
+	newName := opts.Name
+	if newName == "" {
+		newName = fmt.Sprintf("%s (%s)", field.Name, strings.ToLower(service.FormatFieldDataType(target)))
+	}
+	if existing, findErr := service.FindProjectField(project.Fields.Nodes, newName); findErr == nil {
+		return fmt.Errorf("field '%s' already exists (use --name to choose another name)", existing.Name)
+	}
+
+	report := &conversionReportJSON{
+		Field:         field.Name,
+		From:          string(field.DataType),
+		To:            string(target),
+		NewFieldName:  newName,
+		Options:       plan.Options,
+		Converted:     len(plan.Conversions),
+		Unconvertible: make([]unconvertibleJSON, len(plan.Unconvertible)),
+		DryRun:        opts.DryRun,
+	}
+	for i := range plan.Unconvertible {
+		conversion := &plan.Unconvertible[i]
+		report.Unconvertible[i] = unconvertibleJSON{
+			ItemID: conversion.Item.ItemID,
+			Item:   conversionItemLabel(&conversion.Item),
+			Value:  conversion.From,
+			Reason: conversion.Error,
+		}
+	}
+
+	if opts.Format != formatJSON {
+		outputConversionPlan(report, plan)
+	}
+
+	if opts.DryRun {
+		return outputConversionResult(report, opts.Format)
+	}
+
+	if opts.Replace && !opts.Force && len(plan.Unconvertible) > 0 {
+		return fmt.Errorf("%d values cannot be converted and would be lost; fix them or use --force to replace the field anyway", len(plan.Unconvertible))
+	}
+
+	// Create the new field
+	created, err := fieldService.CreateField(ctx, service.CreateFieldInput{
+		ProjectID:           project.ID,
+		Name:                newName,
+		DataType:            target,
+		SingleSelectOptions: plan.Options,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create field: %w", err)
+	}
+	report.NewFieldID = created.ID
+
+	// Reload the new field to resolve its options. The project only lists the first
+	// options of each field, so single select fields are fetched on their own.
+	var newField *graphql.ProjectV2Field
+	if target == graphql.ProjectV2FieldDataTypeSingleSelect {
+		newField, err = fieldService.GetSingleSelectField(ctx, created.ID)
+		if err != nil {
+			return fmt.Errorf("created field '%s' but failed to reload it: %w", newName, err)
+		}
+	} else {
+		project, err = projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+		if err != nil {
+			return fmt.Errorf("created field '%s' but failed to reload the project: %w", newName, err)
+		}
+		newField, err = findField(project.Fields.Nodes, created.ID)
+		if err != nil {
+			return fmt.Errorf("created field '%s' but could not find it in the project: %w", newName, err)
+		}
+	}
+
+	result := itemService.ApplyFieldConversion(ctx, project.ID, newField, plan)
+	report.Migrated = result.Updated
+	report.Failed = result.Failed
+	report.Errors = result.Errors
+
+	if opts.Format != formatJSON {
+		fmt.Printf("✅ Created field '%s' and migrated %d values\n", newName, result.Updated)
+		if result.Failed > 0 {
+			fmt.Printf("❌ Failed to migrate %d values\n", result.Failed)
+			for _, errMsg := range result.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+	}
+
+	if opts.Replace {
+		if result.Failed > 0 && !opts.Force {
+			return fmt.Errorf("failed to migrate %d values; the original field '%s' was kept", result.Failed, field.Name)
+		}
+
+		if !opts.Force && !confirmReplace(field.Name, newName) {
+			fmt.Println("❌ Replacement canceled. Both fields were kept.")
+			return nil
+		}
+
+		if err := replaceField(ctx, fieldService, field, newField.ID); err != nil {
+			return err
+		}
+		report.Replaced = true
+		report.NewFieldName = field.Name
+
+		if opts.Format != formatJSON {
+			fmt.Printf("✅ Replaced field '%s' with the new %s field\n", field.Name, strings.ToLower(service.FormatFieldDataType(target)))
+		}
+	}
+
+	if err := outputConversionResult(report, opts.Format); err != nil {
+		return err
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to migrate %d of %d values", result.Failed, len(plan.Conversions))
+	}
+
+	return nil
+}
+
+// replaceField deletes the original field and renames the new field after it
+func replaceField(ctx context.Context, fieldService *service.FieldService, original *graphql.ProjectV2Field, newFieldID string) error {
+	if err := fieldService.DeleteField(ctx, service.DeleteFieldInput{FieldID: original.ID}); err != nil {
+		return fmt.Errorf("failed to delete the original field: %w", err)
+	}
+
+	name := original.Name
+	if _, err := fieldService.UpdateField(ctx, service.UpdateFieldInput{FieldID: newFieldID, Name: &name}); err != nil {
+		return fmt.Errorf("deleted the original field but failed to rename the new field: %w", err)
+	}
+
+	return nil
+}
+
+func confirmReplace(original, newName string) bool {
+	fmt.Printf("⚠️  You are about to delete field '%s' and rename '%s' to '%s'.\n", original, newName, original)
+	fmt.Printf("\nThis action cannot be undone. Views and workflows using the original field must be updated.\n")
+	fmt.Printf("Type 'REPLACE' to confirm: ")
+
+	var confirmation string
+	if _, err := fmt.Scanln(&confirmation); err != nil {
+		return false
+	}
+
+	return confirmation == "REPLACE"
+}
+
+func conversionItemLabel(item *service.ProjectItemInfo) string {
+	if item.Repository != nil && item.Number != nil {
+		return fmt.Sprintf("%s#%d", *item.Repository, *item.Number)
+	}
+	return item.Title
+}
+
+func outputConversionPlan(report *conversionReportJSON, plan *service.FieldConversionPlan) {
+	fmt.Printf("Converting field '%s' from %s to %s\n\n", report.Field,
+		service.FormatFieldDataType(graphql.ProjectV2FieldDataType(report.From)),
+		service.FormatFieldDataType(graphql.ProjectV2FieldDataType(report.To)))
+
+	fmt.Printf("  Values to migrate: %d\n", report.Converted)
+	fmt.Printf("  Unconvertible:     %d\n", len(report.Unconvertible))
+	if len(report.Options) > 0 {
+		fmt.Printf("  Options:           %s\n", strings.Join(report.Options, ", "))
+	}
+
+	changed := 0
+	for i := range plan.Conversions {
+		if plan.Conversions[i].From != plan.Conversions[i].To {
+			changed++
+		}
+	}
+	if changed > 0 {
+		fmt.Printf("  Reformatted:       %d\n", changed)
+	}
+
+	if len(report.Unconvertible) > 0 {
+		fmt.Printf("\n⚠️  These values cannot be converted and will not be migrated:\n")
+		for _, entry := range report.Unconvertible {
+			fmt.Printf("  %-40s %-20s %s\n", entry.Item, entry.Value, entry.Reason)
+		}
+	}
+	fmt.Println()
+}
+
+func outputConversionResult(report *conversionReportJSON, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if report.DryRun {
+			fmt.Printf("Dry run: field '%s' was not created.\n", report.NewFieldName)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
@@ -0,0 +1,288 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// CopyOptions holds options for the copy command
+type CopyOptions struct {
+	Source      string
+	Destination string
+	Format      string
+	Fields      []string
+	Merge       bool
+	DryRun      bool
+}
+
+// fieldCopyJSON is the JSON representation of a field copy
+type fieldCopyJSON struct {
+	Source      string                `json:"source"`
+	Destination string                `json:"destination"`
+	Operations  []schemaOperationJSON `json:"operations"`
+	Skipped     []skippedFieldJSON    `json:"skipped"`
+	Errors      []string              `json:"errors,omitempty"`
+	Applied     int                   `json:"applied"`
+	Failed      int                   `json:"failed"`
+	DryRun      bool                  `json:"dry_run"`
+}
+
+type skippedFieldJSON struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// NewCopyCmd creates the copy command
+func NewCopyCmd() *cobra.Command {
+	opts := &CopyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy <src-owner>/<number> <dst-owner>/<number>",
+		Short: "Copy field definitions to another project",
+		Long: `Copy field definitions from one project to another. Single select
+options keep their order, colors and descriptions; iteration fields keep
+their duration and start at the source's current iteration. Item values are
+not copied.
+
+All custom fields and the Status field are copied unless --fields names the
+fields to copy. Fields that already exist in the destination are skipped.
+With --merge, missing options are added to them, option colors and
+descriptions are updated and the options are put in the source's order;
+options and fields that only exist in the destination are kept. Fields whose
+type differs in the destination are always skipped.
+
+Examples:
+  ghp field copy octocat/1 octocat/2
+  ghp field copy octocat/1 my-org/7 --fields Status,Priority --merge
+  ghp field copy octocat/1 octocat/2 --dry-run`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Source = args[0]
+			opts.Destination = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runCopy(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Fields, "fields", nil, "Fields to copy (comma-separated)")
+	cmd.Flags().BoolVar(&opts.Merge, "merge", false, "Update fields that already exist in the destination")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them")
+
+	return cmd
+}
+
+func runCopy(ctx context.Context, opts *CopyOptions) error {
+	// Parse project references
+	srcOwner, srcNumber, err := service.ParseProjectReference(opts.Source)
+	if err != nil {
+		return fmt.Errorf("invalid source project reference: %w", err)
+	}
+	dstOwner, dstNumber, err := service.ParseProjectReference(opts.Destination)
+	if err != nil {
+		return fmt.Errorf("invalid destination project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+	projectService := service.NewProjectService(client)
+
+	source, err := projectService.GetProjectWithOwnerDetection(ctx, srcOwner, srcNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get source project: %w", err)
+	}
+	destination, err := projectService.GetProjectWithOwnerDetection(ctx, dstOwner, dstNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get destination project: %w", err)
+	}
+	if source.ID == destination.ID {
+		return fmt.Errorf("source and destination are the same project")
+	}
+
+	if err := loadSelectOptions(ctx, fieldService, source, opts.Fields); err != nil {
+		return err
+	}
+	sourceFields := service.ConvertProjectFields(source)
+	schedules, err := iterationSchedules(ctx, fieldService, sourceFields)
+	if err != nil {
+		return err
+	}
+
+	schema, err := service.BuildCopySchema(sourceFields, schedules, opts.Fields)
+	if err != nil {
+		return err
+	}
+
+	copied := make([]string, len(schema.Fields))
+	for i := range schema.Fields {
+		copied[i] = schema.Fields[i].Name
+	}
+	if err := loadSelectOptions(ctx, fieldService, destination, copied); err != nil {
+		return err
+	}
+	destinationFields := service.ConvertProjectFields(destination)
+	durations, err := iterationDurations(ctx, fieldService, schema, destinationFields)
+	if err != nil {
+		return err
+	}
+
+	plan, err := service.PlanFieldCopy(schema, destinationFields, durations, opts.Merge)
+	if err != nil {
+		return err
+	}
+
+	report := newFieldCopyReport(source.Title, destination.Title, plan)
+	report.DryRun = opts.DryRun
+
+	if opts.Format != formatJSON {
+		outputFieldCopyPlan(report)
+	}
+
+	if opts.DryRun {
+		return outputFieldCopyResult(report, opts.Format)
+	}
+
+	if len(plan.Plan.Operations) > 0 {
+		result := fieldService.ApplyFieldSchema(ctx, destination.ID, plan.Plan)
+		report.Applied = result.Applied
+		report.Failed = result.Failed
+		report.Errors = result.Errors
+	}
+
+	if err := outputFieldCopyResult(report, opts.Format); err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("failed to apply %d changes", report.Failed)
+	}
+
+	return nil
+}
+
+// loadSelectOptions loads every option of a project's single select fields, which the
+// project only lists the first options of. When names are given only those fields are loaded.
+func loadSelectOptions(ctx context.Context, fieldService *service.FieldService, project *graphql.ProjectV2, names []string) error {
+	for i := range project.Fields.Nodes {
+		field := &project.Fields.Nodes[i]
+		if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+			continue
+		}
+		if len(names) > 0 && !containsName(names, field.Name) {
+			continue
+		}
+
+		loaded, err := fieldService.GetSingleSelectField(ctx, field.ID)
+		if err != nil {
+			return err
+		}
+		field.Options = loaded.Options
+	}
+
+	return nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func newFieldCopyReport(source, destination string, plan *service.FieldCopyPlan) *fieldCopyJSON {
+	report := &fieldCopyJSON{
+		Source:      source,
+		Destination: destination,
+		Operations:  make([]schemaOperationJSON, len(plan.Plan.Operations)),
+		Skipped:     make([]skippedFieldJSON, len(plan.Skipped)),
+	}
+
+	for i := range plan.Plan.Operations {
+		op := &plan.Plan.Operations[i]
+		report.Operations[i] = schemaOperationJSON{
+			Kind:        op.Kind,
+			Field:       op.Field,
+			Description: service.DescribeSchemaOperation(op),
+		}
+		if op.Option != nil {
+			report.Operations[i].Option = op.Option.Name
+		}
+	}
+	for i, skipped := range plan.Skipped {
+		report.Skipped[i] = skippedFieldJSON{Field: skipped.Name, Reason: skipped.Reason}
+	}
+
+	return report
+}
+
+func outputFieldCopyPlan(report *fieldCopyJSON) {
+	if len(report.Operations) == 0 {
+		fmt.Printf("✅ Nothing to copy from %s to %s\n", report.Source, report.Destination)
+	} else {
+		fmt.Printf("Copying fields from %s to %s:\n\n", report.Source, report.Destination)
+		for _, op := range report.Operations {
+			symbol := "~"
+			switch op.Kind {
+			case service.SchemaCreateField, service.SchemaAddOption:
+				symbol = "+"
+			}
+			fmt.Printf("  %s %s\n", symbol, op.Description)
+		}
+	}
+
+	if len(report.Skipped) > 0 {
+		fmt.Printf("\nSkipped:\n")
+		for _, skipped := range report.Skipped {
+			fmt.Printf("  • %s %s\n", skipped.Field, skipped.Reason)
+		}
+	}
+	fmt.Println()
+}
+
+func outputFieldCopyResult(report *fieldCopyJSON, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if report.DryRun {
+			fmt.Printf("Dry run: %s was not changed.\n", report.Destination)
+			return nil
+		}
+		if report.Applied > 0 {
+			fmt.Printf("✅ Applied %d changes to %s\n", report.Applied, report.Destination)
+		}
+		if report.Failed > 0 {
+			fmt.Printf("❌ Failed to apply %d changes\n", report.Failed)
+			for _, errMsg := range report.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
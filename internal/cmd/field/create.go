@@ -23,6 +23,7 @@ type CreateOptions struct {
 	Format     string
 	Options    []string
 	Duration   string
+	StartDate  string
 	Number     int
 	Org        bool
 }
@@ -47,7 +48,8 @@ Field Types:
   iteration    - Iteration field for sprint/cycle planning
 
 For single select fields, you can provide initial options using --options.
-For iteration fields, you can specify duration using --duration.
+For iteration fields, you can specify the iteration length using --duration
+and the start of the first iteration using --start-date (defaults to today).
 
 Examples:
   # Traditional syntax
@@ -56,6 +58,7 @@ Examples:
   # New syntax with flags (Issue #18)
   ghp field create --project-id PROJECT_ID --name "Priority" --type single-select --options "Critical,High,Medium,Low"
   ghp field create --project-id PROJECT_ID --name "Sprint" --type iteration --duration 2w
+  ghp field create octocat/123 "Sprint" iteration --duration 1w --start-date 2025-03-03
   ghp field create octocat/123 "Story Points" number
   ghp field create octocat/123 "Due Date" date
   ghp field create octocat/123 "Status" single_select --options "Todo,In Progress,Done"
@@ -82,6 +85,7 @@ Examples:
 	cmd.Flags().StringVar(&opts.Name, "name", "", "Field name")
 	cmd.Flags().StringVar(&opts.FieldType, "type", "", "Field type (text, number, date, single_select, iteration)")
 	cmd.Flags().StringVar(&opts.Duration, "duration", "", "Duration for iteration field (e.g., 2w, 1m)")
+	cmd.Flags().StringVar(&opts.StartDate, "start-date", "", "Start date of the first iteration (YYYY-MM-DD)")
 
 	return cmd
 }
@@ -125,6 +129,10 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		return err
 	}
 
+	if iterationErr := validateIterationSettings(dataType, opts.Duration, opts.StartDate); iterationErr != nil {
+		return iterationErr
+	}
+
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
 	token, err := authManager.GetValidatedToken()
@@ -162,6 +170,7 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		DataType:            dataType,
 		SingleSelectOptions: opts.Options,
 		Duration:            opts.Duration,
+		StartDate:           opts.StartDate,
 	}
 
 	field, err := fieldService.CreateField(ctx, input)
@@ -173,6 +182,27 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 	return outputCreatedField(field, project.Title, opts.Format)
 }
 
+// validateIterationSettings checks the iteration flags of a new field
+func validateIterationSettings(dataType graphql.ProjectV2FieldDataType, duration, startDate string) error {
+	if duration == "" && startDate == "" {
+		return nil
+	}
+	if dataType != graphql.ProjectV2FieldDataTypeIteration {
+		return fmt.Errorf("--duration and --start-date can only be used with iteration fields")
+	}
+	if duration != "" {
+		if _, err := graphql.ParseDurationDays(duration); err != nil {
+			return err
+		}
+	}
+	if startDate != "" {
+		if _, err := parseIterationDate(startDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func outputCreatedField(field *graphql.ProjectV2Field, projectName, format string) error {
 	switch format {
 	case formatJSON:
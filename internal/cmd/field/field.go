@@ -19,9 +19,19 @@ This command group provides comprehensive field management capabilities:
 
 • Create new custom fields with various data types
 • List and view existing fields in projects
+• Export a JSON Schema of the values items accept
 • Update field names and properties
+• Plan sprints with iteration cadence, start dates and breaks
 • Delete fields from projects
+• Convert fields to another type, migrating their values
 • Manage single select field options (add, update, delete)
+• Reorder, rename and merge options in your editor
+• Sync options from repository labels or milestones
+• Find fields and options nobody uses
+• Check items against local field rules
+• Keep computed fields up to date from expressions
+• Copy field definitions between projects
+• Keep field definitions in version control with plan and apply
 
 Field Types:
   text         - Text field for arbitrary text input
@@ -34,21 +44,43 @@ For more information about GitHub Projects fields, visit:
 https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 
 		Example: `  ghp field list octocat/123                    # List fields in project
+  ghp field list octocat/123 --detail           # Show options, iterations and views
+  ghp field list octocat/123 --format json-schema  # JSON Schema for item values
   ghp field create octocat/123 "Priority" text     # Create text field
   ghp field create octocat/123 "Status" single_select --options "Todo,In Progress,Done"
   ghp field update field-id --name "New Priority"  # Rename field
+  ghp field iterations octocat/123 Sprint          # List iterations
+  ghp field update field-id --add-iteration "Sprint 7"  # Add an iteration
   ghp field delete field-id --force                # Delete field
-  ghp field add-option field-id "Critical" --color red  # Add select option`,
+  ghp field convert octocat/123 Estimate --to number  # Change field type
+  ghp field add-option field-id "Critical" --color red  # Add select option
+  ghp field options octocat/123 Priority --edit    # Edit options in $EDITOR
+  ghp field sync-options octocat/123 Area --from-labels octocat/app --prefix area/
+  ghp field apply octocat/123 -f fields.yaml       # Apply a field schema
+  ghp field stats octocat/123 --unused             # Find unused fields
+  ghp field lint octocat/123                       # Find items breaking field rules
+  ghp field compute octocat/123 -f computed.yaml   # Update computed fields
+  ghp field copy octocat/1 octocat/2 --merge       # Copy fields to another project`,
 	}
 
 	// Add subcommands
 	cmd.AddCommand(NewListCmd())
 	cmd.AddCommand(NewCreateCmd())
 	cmd.AddCommand(NewUpdateCmd())
+	cmd.AddCommand(NewIterationsCmd())
 	cmd.AddCommand(NewDeleteCmd())
+	cmd.AddCommand(NewConvertCmd())
 	cmd.AddCommand(NewAddOptionCmd())
 	cmd.AddCommand(NewUpdateOptionCmd())
 	cmd.AddCommand(NewDeleteOptionCmd())
+	cmd.AddCommand(NewOptionsCmd())
+	cmd.AddCommand(NewSyncOptionsCmd())
+	cmd.AddCommand(NewPlanCmd())
+	cmd.AddCommand(NewApplyCmd())
+	cmd.AddCommand(NewStatsCmd())
+	cmd.AddCommand(NewLintCmd())
+	cmd.AddCommand(NewComputeCmd())
+	cmd.AddCommand(NewCopyCmd())
 
 	return cmd
 }
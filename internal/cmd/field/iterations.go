@@ -0,0 +1,242 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const iterationDateLayout = "2006-01-02"
+
+// IterationsOptions holds options for the iterations command
+type IterationsOptions struct {
+	ProjectRef string
+	Field      string
+	Format     string
+}
+
+// iterationScheduleJSON is the JSON representation of an iteration field
+type iterationScheduleJSON struct {
+	FieldID    string               `json:"field_id"`
+	Name       string               `json:"name"`
+	Iterations []iterationJSON      `json:"iterations"`
+	Breaks     []iterationBreakJSON `json:"breaks"`
+	Duration   int                  `json:"duration"`
+}
+
+type iterationJSON struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	State     string `json:"state"`
+	Duration  int    `json:"duration"`
+	Completed bool   `json:"completed"`
+}
+
+type iterationBreakJSON struct {
+	After     string `json:"after"`
+	StartDate string `json:"start_date"`
+	Days      int    `json:"days"`
+}
+
+// NewIterationsCmd creates the iterations command
+func NewIterationsCmd() *cobra.Command {
+	opts := &IterationsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "iterations <owner>/<number> <field>",
+		Short: "List the iterations of an iteration field",
+		Long: `List the iterations of an iteration field with their start dates,
+durations and whether they are completed, current or upcoming. Breaks
+between iterations are shown in the table.
+
+The field can be given by name or ID. Use 'ghp field update' to change
+the iterations.
+
+Examples:
+  ghp field iterations octocat/123 Sprint
+  ghp field iterations octocat/123 Sprint --format json`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Field = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runIterations(cmd.Context(), opts)
+		},
+	}
+
+	return cmd
+}
+
+func runIterations(ctx context.Context, opts *IterationsOptions) error {
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	field, err := findIterationField(project.Fields.Nodes, opts.Field)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := fieldService.GetIterationSchedule(ctx, field.ID)
+	if err != nil {
+		return err
+	}
+
+	return outputIterationSchedule(schedule, opts.Format)
+}
+
+// findField finds a project field by ID or name
+func findField(fields []graphql.ProjectV2Field, nameOrID string) (*graphql.ProjectV2Field, error) {
+	for i := range fields {
+		if fields[i].ID == nameOrID {
+			return &fields[i], nil
+		}
+	}
+
+	return service.FindProjectField(fields, nameOrID)
+}
+
+// findIterationField finds an iteration field by name or ID
+func findIterationField(fields []graphql.ProjectV2Field, nameOrID string) (*graphql.ProjectV2Field, error) {
+	field, err := findField(fields, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	if field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+		return nil, fmt.Errorf("field '%s' is a %s field, not an iteration field", field.Name, service.FormatFieldDataType(field.DataType))
+	}
+
+	return field, nil
+}
+
+// parseIterationDate parses a YYYY-MM-DD date
+func parseIterationDate(value string) (time.Time, error) {
+	date, err := time.Parse(iterationDateLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %s (expected YYYY-MM-DD)", value)
+	}
+	return date, nil
+}
+
+func outputIterationSchedule(schedule *service.IterationSchedule, format string) error {
+	switch format {
+	case formatJSON:
+		return outputIterationScheduleJSON(schedule)
+	case formatTable:
+		return outputIterationScheduleTable(schedule)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func outputIterationScheduleTable(schedule *service.IterationSchedule) error {
+	fmt.Printf("Iterations of %s (%d-day cadence)\n\n", schedule.FieldName, schedule.Duration)
+
+	if len(schedule.Iterations) == 0 {
+		fmt.Println("No iterations found.")
+		return nil
+	}
+
+	breaks := make(map[string]service.IterationBreak)
+	for _, b := range schedule.Breaks() {
+		breaks[b.After] = b
+	}
+
+	today := time.Now()
+	fmt.Printf("%-24s %-11s %-11s %-9s %s\n", "TITLE", "START", "END", "DURATION", "STATE")
+	for i := range schedule.Iterations {
+		iteration := &schedule.Iterations[i]
+		fmt.Printf("%-24s %-11s %-11s %-9s %s\n",
+			iteration.Title,
+			iteration.StartDate.Format(iterationDateLayout),
+			iteration.EndDate().Format(iterationDateLayout),
+			fmt.Sprintf("%dd", iteration.Duration),
+			service.IterationState(iteration, today))
+
+		if b, ok := breaks[iteration.Title]; ok {
+			fmt.Printf("%-24s %-11s %-11s %-9s\n",
+				"  (break)",
+				b.StartDate.Format(iterationDateLayout),
+				b.StartDate.AddDate(0, 0, b.Days-1).Format(iterationDateLayout),
+				fmt.Sprintf("%dd", b.Days))
+		}
+	}
+
+	return nil
+}
+
+func outputIterationScheduleJSON(schedule *service.IterationSchedule) error {
+	data, err := json.MarshalIndent(newIterationScheduleJSON(schedule), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func newIterationScheduleJSON(schedule *service.IterationSchedule) iterationScheduleJSON {
+	result := iterationScheduleJSON{
+		FieldID:    schedule.FieldID,
+		Name:       schedule.FieldName,
+		Duration:   schedule.Duration,
+		Iterations: make([]iterationJSON, len(schedule.Iterations)),
+		Breaks:     []iterationBreakJSON{},
+	}
+
+	today := time.Now()
+	for i := range schedule.Iterations {
+		iteration := &schedule.Iterations[i]
+		state := service.IterationState(iteration, today)
+		result.Iterations[i] = iterationJSON{
+			ID:        iteration.ID,
+			Title:     iteration.Title,
+			StartDate: iteration.StartDate.Format(iterationDateLayout),
+			EndDate:   iteration.EndDate().Format(iterationDateLayout),
+			Duration:  iteration.Duration,
+			State:     state,
+			Completed: state == service.IterationStateCompleted,
+		}
+	}
+
+	for _, b := range schedule.Breaks() {
+		result.Breaks = append(result.Breaks, iterationBreakJSON{
+			After:     b.After,
+			StartDate: b.StartDate.Format(iterationDateLayout),
+			Days:      b.Days,
+		})
+	}
+
+	return result
+}
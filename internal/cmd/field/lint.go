@@ -0,0 +1,192 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// LintOptions holds options for the lint command
+type LintOptions struct {
+	ProjectRef string
+	Format     string
+}
+
+// itemViolationsJSON is the JSON representation of an item that breaks field rules
+type itemViolationsJSON struct {
+	Number     *int                `json:"number,omitempty"`
+	Repository *string             `json:"repository,omitempty"`
+	ItemID     string              `json:"item_id"`
+	Title      string              `json:"title"`
+	Violations []ruleViolationJSON `json:"violations"`
+}
+
+type ruleViolationJSON struct {
+	Field   string `json:"field"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
+
+// NewLintCmd creates the lint command
+func NewLintCmd() *cobra.Command {
+	opts := &LintOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "lint <owner>/<number>",
+		Short: "Report items that break the project's field rules",
+		Long: `Report the items of a project whose field values break the project's
+field rules. Archived items are not checked.
+
+GitHub has no required fields or value constraints, so they are declared in
+a local rule file keyed by project. The rule file is ~/.ghp-rules.yaml unless
+the "rules" config setting (or GHP_RULES) points elsewhere. 'ghp item edit',
+'ghp item create', 'ghp item add-bulk' and 'ghp item update-bulk' refuse
+changes that break the rules.
+
+A rule names a field and any of these constraints:
+  required  the field must have a value
+  values    the allowed values (numbers compare numerically)
+  min, max  inclusive bounds: numbers, YYYY-MM-DD dates or @today±N(d|w|m|y)
+  pattern   a regular expression the value must match
+A rule can be limited to items matching an item filter with 'when', and can
+replace the default error with 'message'.
+
+Example rule file:
+  projects:
+    octocat/123:
+      - field: Estimate
+        values: [1, 2, 3, 5, 8]
+      - field: Due
+        min: "@today+1d"
+        message: Due must be in the future
+      - field: Priority
+        required: true
+        when: status:"In Progress"
+
+The command exits with an error when items break rules.
+
+Examples:
+  ghp field lint octocat/123
+  ghp field lint octocat/123 --format json`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runLint(cmd.Context(), opts)
+		},
+	}
+
+	return cmd
+}
+
+func runLint(ctx context.Context, opts *LintOptions) error {
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	rules, err := service.LoadProjectFieldRules(viper.GetString("rules"), opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return fmt.Errorf("no field rules for %s in %s", opts.ProjectRef, service.FieldRulesPath(viper.GetString("rules")))
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and service
+	client := api.NewClient(token)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if err := service.ValidateFieldRules(rules, project.Fields.Nodes); err != nil {
+		return fmt.Errorf("invalid field rules for %s: %w", opts.ProjectRef, err)
+	}
+
+	projectItems, err := projectService.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	results := service.LintProjectItems(rules, service.ConvertProjectItems(projectItems), time.Now())
+	if err := outputLintResults(results, project.Title, len(rules), opts.Format); err != nil {
+		return err
+	}
+
+	if len(results) > 0 {
+		return fmt.Errorf("%d items break field rules", len(results))
+	}
+	return nil
+}
+
+func outputLintResults(results []service.ItemViolations, projectName string, rules int, format string) error {
+	switch format {
+	case formatJSON:
+		entries := make([]itemViolationsJSON, len(results))
+		for i := range results {
+			item := &results[i].Item
+			entries[i] = itemViolationsJSON{
+				Number:     item.Number,
+				Repository: item.Repository,
+				ItemID:     item.ItemID,
+				Title:      item.Title,
+				Violations: make([]ruleViolationJSON, len(results[i].Violations)),
+			}
+			for j, violation := range results[i].Violations {
+				entries[i].Violations[j] = ruleViolationJSON{Field: violation.Field, Value: violation.Value, Message: violation.Message}
+			}
+		}
+
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if len(results) == 0 {
+			fmt.Printf("✅ Every item in %s follows the %d field rules\n", projectName, rules)
+			return nil
+		}
+
+		fmt.Printf("❌ %d items in %s break field rules:\n\n", len(results), projectName)
+		for i := range results {
+			item := &results[i].Item
+			fmt.Printf("%s (%s)\n", projectItemLabel(item), item.ItemID)
+			for _, violation := range results[i].Violations {
+				fmt.Printf("  • %s\n", violation.Message)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// projectItemLabel describes an item as owner/repo#number and title, or its title for drafts
+func projectItemLabel(item *service.ProjectItemInfo) string {
+	if item.Repository != nil && item.Number != nil {
+		return fmt.Sprintf("%s#%d %s", *item.Repository, *item.Number, item.Title)
+	}
+	return item.Title
+}
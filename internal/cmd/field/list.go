@@ -2,13 +2,17 @@ package field
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/browser"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
@@ -24,6 +28,34 @@ type ListOptions struct {
 	Format     string
 	Number     int
 	Org        bool
+	Web        bool
+	PrintURL   bool
+	Detail     bool
+}
+
+// fieldDetailJSON is the JSON representation of a field listed with --detail
+type fieldDetailJSON struct {
+	Iteration   *iterationScheduleJSON  `json:"iteration,omitempty"`
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	DataType    string                  `json:"dataType"`
+	ProjectID   string                  `json:"projectId"`
+	ProjectName string                  `json:"projectName"`
+	Options     []fieldDetailOptionJSON `json:"options"`
+	Views       []fieldViewUsageJSON    `json:"views"`
+}
+
+type fieldDetailOptionJSON struct {
+	Description *string `json:"description,omitempty"`
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Color       string  `json:"color"`
+}
+
+type fieldViewUsageJSON struct {
+	View      string `json:"view"`
+	Usage     string `json:"usage"`
+	Direction string `json:"direction,omitempty"`
 }
 
 // NewListCmd creates the list command
@@ -39,10 +71,25 @@ This command shows all fields defined in the project along with their
 data types and configuration. For single select fields, it also shows
 the available options.
 
+With --detail, each field is listed with its option descriptions, its
+iteration configuration and the views that group, lay out board columns
+or sort by it.
+
+With --format json-schema, the command prints a JSON Schema describing
+the field values the project's items accept: an object keyed by field
+name, with the options of single select fields and the iterations of
+iteration fields. Field rules without a 'when' condition (see 'ghp field
+lint') narrow the schema with allowed values, numeric bounds, patterns
+and required fields. Use it to validate data before passing it to
+'ghp item' commands.
+
 Examples:
   ghp field list octocat/123        # List fields in project 123
   ghp field list --org myorg/456    # List fields in org project 456
-  ghp field list octocat/123 --format json  # JSON output`,
+  ghp field list octocat/123 --format json  # JSON output
+  ghp field list octocat/123 --detail       # Options, iterations and views
+  ghp field list octocat/123 --format json-schema > items.schema.json
+  ghp field list octocat/123 --web          # Open field settings in browser`,
 
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -53,6 +100,9 @@ Examples:
 	}
 
 	cmd.Flags().BoolVar(&opts.Org, "org", false, "Project belongs to an organization")
+	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open the project's field settings in web browser")
+	cmd.Flags().BoolVar(&opts.PrintURL, "print-url", false, "Print the field settings URL instead of opening a browser")
+	cmd.Flags().BoolVar(&opts.Detail, "detail", false, "Show option descriptions, iteration configuration and the views using each field")
 
 	return cmd
 }
@@ -80,16 +130,175 @@ func runList(ctx context.Context, opts *ListOptions) error {
 	client := api.NewClient(token)
 	fieldService := service.NewFieldService(client)
 
+	if opts.Web || opts.PrintURL {
+		projectService := service.NewProjectService(client)
+		project, projectErr := projectService.GetProjectWithOwnerDetection(ctx, opts.Owner, opts.Number)
+		if projectErr != nil {
+			return fmt.Errorf("failed to get project: %w", projectErr)
+		}
+		return browser.OpenURL(service.ProjectSettingsURL(project.URL), opts.PrintURL)
+	}
+
 	// Get project fields
 	fields, err := fieldService.GetProjectFields(ctx, opts.Owner, opts.Number, opts.Org)
 	if err != nil {
 		return fmt.Errorf("failed to get project fields: %w", err)
 	}
 
+	if opts.Format == formatJSONSchema {
+		return outputFieldsJSONSchema(ctx, fieldService, fields, opts.ProjectRef)
+	}
+
+	if opts.Detail {
+		return outputFieldDetails(ctx, client, fieldService, fields, opts.Format)
+	}
+
 	// Output fields
 	return outputFields(fields, opts.Format)
 }
 
+func outputFieldsJSONSchema(ctx context.Context, fieldService *service.FieldService, fields []service.FieldInfo, projectRef string) error {
+	rules, err := service.LoadProjectFieldRules(viper.GetString("rules"), projectRef)
+	if err != nil {
+		return err
+	}
+
+	schedules, err := iterationSchedules(ctx, fieldService, fields)
+	if err != nil {
+		return err
+	}
+
+	title := projectRef
+	if len(fields) > 0 && fields[0].ProjectName != "" {
+		title = fields[0].ProjectName
+	}
+
+	data, err := json.MarshalIndent(service.BuildItemValueSchema(title, fields, schedules, rules), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func outputFieldDetails(ctx context.Context, client *api.Client, fieldService *service.FieldService, fields []service.FieldInfo, format string) error {
+	if format != formatJSON && format != formatTable {
+		return fmt.Errorf("unknown format: %s", format)
+	}
+
+	schedules, err := iterationSchedules(ctx, fieldService, fields)
+	if err != nil {
+		return err
+	}
+
+	var views []service.ViewInfo
+	if len(fields) > 0 {
+		views, err = service.NewViewService(client).GetProjectViews(ctx, fields[0].ProjectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project views: %w", err)
+		}
+	}
+
+	details := service.BuildFieldDetails(fields, schedules, views)
+	if format == formatJSON {
+		return outputFieldDetailsJSON(details)
+	}
+	return outputFieldDetailsTable(details)
+}
+
+func outputFieldDetailsTable(details []service.FieldDetail) error {
+	if len(details) == 0 {
+		fmt.Println("No custom fields found")
+		return nil
+	}
+
+	fmt.Printf("Fields in project '%s':\n", details[0].Field.ProjectName)
+
+	for i := range details {
+		detail := &details[i]
+		field := &detail.Field
+
+		fmt.Printf("\n%s (%s) %s\n", field.Name, service.FormatFieldDataType(field.DataType), field.ID)
+
+		if len(field.Options) > 0 {
+			fmt.Printf("  Options:\n")
+			for _, option := range field.Options {
+				line := fmt.Sprintf("%s (%s)", option.Name, service.FormatColor(option.Color))
+				if option.Description != nil && *option.Description != "" {
+					line += ": " + *option.Description
+				}
+				fmt.Printf("    • %s\n", line)
+			}
+		}
+
+		if schedule := detail.Iterations; schedule != nil {
+			fmt.Printf("  Iterations: %d-day cadence, %d iterations\n", schedule.Duration, len(schedule.Iterations))
+			today := time.Now()
+			for j := range schedule.Iterations {
+				iteration := &schedule.Iterations[j]
+				fmt.Printf("    • %s %s → %s (%s)\n",
+					iteration.Title,
+					iteration.StartDate.Format(iterationDateLayout),
+					iteration.EndDate().Format(iterationDateLayout),
+					service.IterationState(iteration, today))
+			}
+		}
+
+		if len(detail.Views) > 0 {
+			usages := make([]string, len(detail.Views))
+			for j, usage := range detail.Views {
+				usages[j] = fmt.Sprintf("%s (%s)", usage.View, usage.Usage)
+				if usage.Direction != "" {
+					usages[j] = fmt.Sprintf("%s (%s, %s)", usage.View, usage.Usage, strings.ToLower(string(usage.Direction)))
+				}
+			}
+			fmt.Printf("  Views: %s\n", strings.Join(usages, ", "))
+		}
+	}
+
+	return nil
+}
+
+func outputFieldDetailsJSON(details []service.FieldDetail) error {
+	entries := make([]fieldDetailJSON, len(details))
+	for i := range details {
+		detail := &details[i]
+		field := &detail.Field
+
+		entries[i] = fieldDetailJSON{
+			ID:          field.ID,
+			Name:        field.Name,
+			DataType:    string(field.DataType),
+			ProjectID:   field.ProjectID,
+			ProjectName: field.ProjectName,
+			Options:     make([]fieldDetailOptionJSON, len(field.Options)),
+			Views:       make([]fieldViewUsageJSON, len(detail.Views)),
+		}
+		for j, option := range field.Options {
+			entries[i].Options[j] = fieldDetailOptionJSON{
+				Description: option.Description,
+				ID:          option.ID,
+				Name:        option.Name,
+				Color:       option.Color,
+			}
+		}
+		if detail.Iterations != nil {
+			schedule := newIterationScheduleJSON(detail.Iterations)
+			entries[i].Iteration = &schedule
+		}
+		for j, usage := range detail.Views {
+			entries[i].Views[j] = fieldViewUsageJSON{View: usage.View, Usage: usage.Usage, Direction: string(usage.Direction)}
+		}
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
 func outputFields(fields []service.FieldInfo, format string) error {
 	switch format {
 	case formatJSON:
@@ -0,0 +1,267 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/editor"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// OptionsOptions holds options for the options command
+type OptionsOptions struct {
+	ProjectRef string
+	Field      string
+	Format     string
+	Edit       bool
+	DryRun     bool
+	Force      bool
+}
+
+// fieldOptionJSON is the JSON representation of a single select option
+type fieldOptionJSON struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description,omitempty"`
+	Items       int    `json:"items"`
+}
+
+// NewOptionsCmd creates the options command
+func NewOptionsCmd() *cobra.Command {
+	opts := &OptionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "options <owner>/<number> <field>",
+		Short: "List or bulk-edit the options of a single select field",
+		Long: `List the options of a single select field with the number of items
+using each option.
+
+With --edit, the options open in your editor as a YAML list where you can
+rename, recolor, describe, reorder, add and remove options in one go.
+Renamed options keep their items. Set merge_into on an option to move its
+items to another option before it is deleted; options that are removed
+without merge_into are deleted and their items lose their value.
+
+The changes are shown before they are applied. Deleting options asks for
+confirmation unless --force is given.
+
+The editor is taken from the "editor" config setting, $VISUAL or $EDITOR.
+
+Examples:
+  ghp field options octocat/123 Priority
+  ghp field options octocat/123 Priority --edit
+  ghp field options octocat/123 Priority --edit --dry-run`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Field = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runOptions(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Edit, "edit", "e", false, "Edit the options in your editor")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt for deletions")
+
+	return cmd
+}
+
+func runOptions(ctx context.Context, opts *OptionsOptions) error {
+	if opts.DryRun && !opts.Edit {
+		return fmt.Errorf("--dry-run can only be used with --edit")
+	}
+
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	field, err := findField(project.Fields.Nodes, opts.Field)
+	if err != nil {
+		return err
+	}
+	if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return fmt.Errorf("field '%s' is a %s field, not a single select field", field.Name, service.FormatFieldDataType(field.DataType))
+	}
+
+	// The project only lists the first options of each field; a reorder sends the full
+	// list of options, so every option must be loaded
+	field, err = fieldService.GetSingleSelectField(ctx, field.ID)
+	if err != nil {
+		return err
+	}
+
+	// Archived items keep their values, so count and move them too
+	projectItems, err := projectService.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+	items := service.ConvertProjectItems(projectItems)
+
+	if !opts.Edit {
+		return outputFieldOptions(field, items, opts.Format)
+	}
+
+	plan, err := editFieldOptions(field)
+	if err != nil {
+		return err
+	}
+
+	if plan.IsEmpty() {
+		fmt.Printf("✅ No changes to the options of %s\n", field.Name)
+		return nil
+	}
+
+	fmt.Printf("Changes to the options of %s:\n", field.Name)
+	for _, description := range service.DescribeOptionEditPlan(plan) {
+		fmt.Printf("  • %s\n", description)
+	}
+	fmt.Println()
+
+	if opts.DryRun {
+		return nil
+	}
+
+	if len(plan.Deletes) > 0 && !opts.Force && !confirmOptionDeletes(plan, field.Name, items) {
+		fmt.Println("❌ Edit canceled.")
+		return nil
+	}
+
+	result := fieldService.ApplyOptionEdits(ctx, project.ID, field, items, plan)
+	if result.Applied > 0 {
+		fmt.Printf("✅ Applied %d changes to the options of %s", result.Applied, field.Name)
+		if result.Moved > 0 {
+			fmt.Printf(" and moved %d items", result.Moved)
+		}
+		fmt.Println()
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to apply %d changes\n", result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		return fmt.Errorf("failed to apply %d changes", result.Failed)
+	}
+
+	return nil
+}
+
+// editFieldOptions opens the field's options in the editor and plans the changes
+func editFieldOptions(field *graphql.ProjectV2Field) (*service.OptionEditPlan, error) {
+	document, err := service.RenderOptionsDocument(field)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := editor.New().Edit(document, "ghp-options-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := service.ParseOptionsDocument(edited)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.PlanOptionEdits(field.Options.Nodes, entries)
+}
+
+func confirmOptionDeletes(plan *service.OptionEditPlan, fieldName string, items []service.ProjectItemInfo) bool {
+	counts := optionItemCounts(fieldName, items)
+
+	fmt.Printf("⚠️  You are about to delete options of %s:\n", fieldName)
+	for _, option := range plan.Deletes {
+		fmt.Printf("  • %s (%d items will lose their value)\n", option.Name, counts[option.Name])
+	}
+	fmt.Printf("\nUse merge_into to keep the items. Type 'DELETE' to confirm: ")
+
+	var confirmation string
+	if _, err := fmt.Scanln(&confirmation); err != nil {
+		return false
+	}
+
+	return confirmation == "DELETE"
+}
+
+// optionItemCounts counts the items using each option of a field
+func optionItemCounts(fieldName string, items []service.ProjectItemInfo) map[string]int {
+	counts := make(map[string]int)
+	for i := range items {
+		if value, ok := items[i].FieldValues[fieldName]; ok {
+			counts[value]++
+		}
+	}
+	return counts
+}
+
+func outputFieldOptions(field *graphql.ProjectV2Field, items []service.ProjectItemInfo, format string) error {
+	counts := optionItemCounts(field.Name, items)
+
+	switch format {
+	case formatJSON:
+		options := make([]fieldOptionJSON, len(field.Options.Nodes))
+		for i, option := range field.Options.Nodes {
+			options[i] = fieldOptionJSON{
+				ID:    option.ID,
+				Name:  option.Name,
+				Color: option.Color,
+				Items: counts[option.Name],
+			}
+			if option.Description != nil {
+				options[i].Description = *option.Description
+			}
+		}
+
+		data, err := json.MarshalIndent(options, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if len(field.Options.Nodes) == 0 {
+			fmt.Printf("Field %s has no options.\n", field.Name)
+			return nil
+		}
+
+		fmt.Printf("Options of %s:\n\n", field.Name)
+		fmt.Printf("%-24s %-8s %-6s %s\n", "NAME", "COLOR", "ITEMS", "DESCRIPTION")
+		for _, option := range field.Options.Nodes {
+			description := ""
+			if option.Description != nil {
+				description = *option.Description
+			}
+			fmt.Printf("%-24s %-8s %-6d %s\n", option.Name, service.FormatColor(option.Color), counts[option.Name], description)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
@@ -0,0 +1,363 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// schemaHelp describes the schema file format shared by plan and apply
+const schemaHelp = `The schema file lists the project's custom fields in YAML or JSON:
+
+  fields:
+    - name: Priority
+      type: single_select
+      options:               # new options are gray by default
+        - name: P0
+          color: red
+          description: Drop everything
+        - name: P1
+          color: orange
+          renamed_from: High # rename an option, keeping item values
+    - name: Severity
+      type: single_select
+      renamed_from: Sev      # rename a field, keeping item values
+    - name: Estimate
+      type: number
+    - name: Sprint
+      type: iteration
+      iteration:
+        duration: 2w
+        start_date: 2025-03-03   # only used when the field is created
+
+Options are put in the order of the schema, followed by the options it does
+not list. Omitted option colors and descriptions are left alone. Fields and
+options that are not in the schema are kept unless --prune is given; the
+built-in fields, including Status, are never deleted. Field types cannot be
+changed; use 'ghp field convert' instead.`
+
+// SchemaOptions holds options for the plan and apply commands
+type SchemaOptions struct {
+	ProjectRef string
+	File       string
+	Format     string
+	Prune      bool
+	Org        bool
+	Force      bool
+}
+
+// schemaPlanJSON is the JSON representation of a schema plan
+type schemaPlanJSON struct {
+	Operations []schemaOperationJSON `json:"operations"`
+	Unmanaged  []string              `json:"unmanaged"`
+	Errors     []string              `json:"errors,omitempty"`
+	Applied    int                   `json:"applied"`
+	Failed     int                   `json:"failed"`
+}
+
+type schemaOperationJSON struct {
+	Kind        string `json:"kind"`
+	Field       string `json:"field"`
+	Option      string `json:"option,omitempty"`
+	Description string `json:"description"`
+}
+
+// NewPlanCmd creates the plan command
+func NewPlanCmd() *cobra.Command {
+	opts := &SchemaOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "plan <owner>/<number>",
+		Short: "Show the changes needed to match a field schema",
+		Long: `Compare a declarative field schema with a project's fields and show the
+fields and options that 'ghp field apply' would create, rename, update or
+delete. Nothing is changed.
+
+` + schemaHelp + `
+
+Examples:
+  ghp field plan octocat/123 -f fields.yaml
+  ghp field plan octocat/123 -f fields.yaml --prune --format json`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runSchema(cmd.Context(), opts, false)
+		},
+	}
+
+	addSchemaFlags(cmd, opts)
+	return cmd
+}
+
+// NewApplyCmd creates the apply command
+func NewApplyCmd() *cobra.Command {
+	opts := &SchemaOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply <owner>/<number>",
+		Short: "Apply a field schema to a project",
+		Long: `Create, rename, update and (with --prune) delete fields and options so a
+project matches a declarative field schema. The plan is shown before it is
+applied; plans that delete fields or options ask for confirmation unless
+--force is given. Applying the same schema again makes no changes.
+
+` + schemaHelp + `
+
+Examples:
+  ghp field apply octocat/123 -f fields.yaml
+  ghp field apply octocat/123 -f fields.yaml --prune --force`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runSchema(cmd.Context(), opts, true)
+		},
+	}
+
+	addSchemaFlags(cmd, opts)
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt for deletions")
+	return cmd
+}
+
+func addSchemaFlags(cmd *cobra.Command, opts *SchemaOptions) {
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Field schema file (YAML or JSON)")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete custom fields and options that are not in the schema")
+	cmd.Flags().BoolVar(&opts.Org, "org", false, "Project belongs to an organization")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func runSchema(ctx context.Context, opts *SchemaOptions, apply bool) error {
+	schema, err := service.LoadFieldSchema(opts.File)
+	if err != nil {
+		return err
+	}
+
+	if apply && opts.Prune && !opts.Force && opts.Format == formatJSON {
+		return fmt.Errorf("--prune with JSON output requires --force")
+	}
+
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and service
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+
+	fields, err := fieldService.GetProjectFields(ctx, owner, number, opts.Org)
+	if err != nil {
+		return fmt.Errorf("failed to get project fields: %w", err)
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("project %s has no fields", opts.ProjectRef)
+	}
+
+	durations, err := iterationDurations(ctx, fieldService, schema, fields)
+	if err != nil {
+		return err
+	}
+
+	plan, err := service.PlanFieldSchema(schema, fields, durations, opts.Prune)
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == formatJSON {
+		if apply {
+			return outputSchemaApplyJSON(ctx, fieldService, fields[0].ProjectID, plan)
+		}
+		return outputSchemaPlanJSON(plan, nil)
+	}
+
+	outputSchemaPlanTable(plan, fields[0].ProjectName)
+	if !apply || len(plan.Operations) == 0 {
+		return nil
+	}
+
+	if hasSchemaDeletions(plan) && !opts.Force && !confirmSchemaApply() {
+		fmt.Println("❌ Apply canceled.")
+		return nil
+	}
+
+	result := fieldService.ApplyFieldSchema(ctx, fields[0].ProjectID, plan)
+	if result.Applied > 0 {
+		fmt.Printf("✅ Applied %d changes to project %s\n", result.Applied, fields[0].ProjectName)
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to apply %d changes\n", result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		return fmt.Errorf("failed to apply %d of %d changes", result.Failed, len(plan.Operations))
+	}
+
+	return nil
+}
+
+// iterationDurations fetches the current duration of iteration fields whose duration is declared
+func iterationDurations(ctx context.Context, fieldService *service.FieldService, schema *service.FieldSchema, fields []service.FieldInfo) (map[string]int, error) {
+	durations := make(map[string]int)
+
+	for i := range schema.Fields {
+		spec := &schema.Fields[i]
+		if spec.Iteration == nil || spec.Iteration.Duration == "" {
+			continue
+		}
+
+		for j := range fields {
+			field := &fields[j]
+			if field.DataType != graphql.ProjectV2FieldDataTypeIteration ||
+				(!strings.EqualFold(field.Name, spec.Name) && !strings.EqualFold(field.Name, spec.RenamedFrom)) {
+				continue
+			}
+
+			schedule, err := fieldService.GetIterationSchedule(ctx, field.ID)
+			if err != nil {
+				return nil, err
+			}
+			durations[field.ID] = schedule.Duration
+		}
+	}
+
+	return durations, nil
+}
+
+// iterationSchedules fetches the schedules of a project's iteration fields, keyed by field ID
+func iterationSchedules(ctx context.Context, fieldService *service.FieldService, fields []service.FieldInfo) (map[string]*service.IterationSchedule, error) {
+	schedules := make(map[string]*service.IterationSchedule)
+
+	for i := range fields {
+		if fields[i].DataType != graphql.ProjectV2FieldDataTypeIteration {
+			continue
+		}
+		schedule, err := fieldService.GetIterationSchedule(ctx, fields[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		schedules[fields[i].ID] = schedule
+	}
+
+	return schedules, nil
+}
+
+func hasSchemaDeletions(plan *service.SchemaPlan) bool {
+	for i := range plan.Operations {
+		switch plan.Operations[i].Kind {
+		case service.SchemaDeleteField, service.SchemaDeleteOption:
+			return true
+		}
+	}
+	return false
+}
+
+func confirmSchemaApply() bool {
+	fmt.Printf("⚠️  This plan deletes fields or options. Item values stored in them will be permanently lost.\n")
+	fmt.Printf("Type 'APPLY' to confirm: ")
+
+	var confirmation string
+	if _, err := fmt.Scanln(&confirmation); err != nil {
+		return false
+	}
+
+	return confirmation == "APPLY"
+}
+
+func outputSchemaPlanTable(plan *service.SchemaPlan, projectName string) {
+	if len(plan.Operations) == 0 {
+		fmt.Printf("✅ Project %s matches the schema\n", projectName)
+	} else {
+		fmt.Printf("Changes for project %s:\n\n", projectName)
+
+		creates, changes, deletes := 0, 0, 0
+		for i := range plan.Operations {
+			op := &plan.Operations[i]
+			symbol := "~"
+			switch op.Kind {
+			case service.SchemaCreateField, service.SchemaAddOption:
+				symbol = "+"
+				creates++
+			case service.SchemaDeleteField, service.SchemaDeleteOption:
+				symbol = "-"
+				deletes++
+			default:
+				changes++
+			}
+			fmt.Printf("  %s %s\n", symbol, service.DescribeSchemaOperation(op))
+		}
+
+		fmt.Printf("\nPlan: %d to create, %d to change, %d to delete\n", creates, changes, deletes)
+	}
+
+	if len(plan.Unmanaged) > 0 {
+		fmt.Printf("\nNot in the schema (use --prune to delete):\n")
+		for _, name := range plan.Unmanaged {
+			fmt.Printf("  • %s\n", name)
+		}
+	}
+}
+
+func outputSchemaApplyJSON(ctx context.Context, fieldService *service.FieldService, projectID string, plan *service.SchemaPlan) error {
+	result := fieldService.ApplyFieldSchema(ctx, projectID, plan)
+	if err := outputSchemaPlanJSON(plan, result); err != nil {
+		return err
+	}
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d changes", result.Failed, len(plan.Operations))
+	}
+	return nil
+}
+
+func outputSchemaPlanJSON(plan *service.SchemaPlan, result *service.SchemaApplyResult) error {
+	output := schemaPlanJSON{
+		Operations: make([]schemaOperationJSON, len(plan.Operations)),
+		Unmanaged:  plan.Unmanaged,
+	}
+	if output.Unmanaged == nil {
+		output.Unmanaged = []string{}
+	}
+
+	for i := range plan.Operations {
+		op := &plan.Operations[i]
+		output.Operations[i] = schemaOperationJSON{
+			Kind:        op.Kind,
+			Field:       op.Field,
+			Description: service.DescribeSchemaOperation(op),
+		}
+		if op.Option != nil {
+			output.Operations[i].Option = op.Option.Name
+		}
+	}
+
+	if result != nil {
+		output.Applied = result.Applied
+		output.Failed = result.Failed
+		output.Errors = result.Errors
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,240 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// StatsOptions holds options for the stats command
+type StatsOptions struct {
+	ProjectRef string
+	Format     string
+	Unused     bool
+}
+
+// fieldStatsJSON is the JSON representation of a field's usage
+type fieldStatsJSON struct {
+	LastUpdated *time.Time        `json:"last_updated,omitempty"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	Options     []optionUsageJSON `json:"options,omitempty"`
+	Items       int               `json:"items"`
+	Filled      int               `json:"filled"`
+	Distinct    int               `json:"distinct"`
+	FillRate    float64           `json:"fill_rate"`
+	Custom      bool              `json:"custom"`
+}
+
+type optionUsageJSON struct {
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+	Field       string     `json:"field,omitempty"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Items       int        `json:"items"`
+}
+
+// unusedFieldsJSON is the JSON representation of unused fields and options
+type unusedFieldsJSON struct {
+	Fields  []fieldStatsJSON  `json:"fields"`
+	Options []optionUsageJSON `json:"options"`
+}
+
+// NewStatsCmd creates the stats command
+func NewStatsCmd() *cobra.Command {
+	opts := &StatsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "stats <owner>/<number>",
+		Short: "Show how the fields of a project are used",
+		Long: `Show, for every field that holds item values, how many items have a
+value (fill rate), the number of distinct values, how many items use each
+single select option (including options no item uses) and when a value was
+last changed. All items are counted, including archived ones.
+
+With --unused, only the custom fields no item has a value for and the
+options no item uses are listed. These can be deleted without losing data.
+
+Examples:
+  ghp field stats octocat/123
+  ghp field stats octocat/123 --unused
+  ghp field stats octocat/123 --format json`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runStats(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Unused, "unused", false, "Only list fields and options that are safe to delete")
+
+	return cmd
+}
+
+func runStats(ctx context.Context, opts *StatsOptions) error {
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and service
+	client := api.NewClient(token)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	projectItems, err := projectService.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	stats := service.ComputeFieldStats(service.ConvertProjectFields(project), service.ConvertProjectItems(projectItems))
+
+	if opts.Unused {
+		return outputUnusedFields(service.FindUnusedFields(stats), project.Title, opts.Format)
+	}
+	return outputFieldStats(stats, project.Title, len(projectItems), opts.Format)
+}
+
+func fieldStatsEntry(stat *service.FieldStats) fieldStatsJSON {
+	entry := fieldStatsJSON{
+		LastUpdated: stat.LastUpdated,
+		ID:          stat.ID,
+		Name:        stat.Name,
+		Type:        string(stat.DataType),
+		Items:       stat.Items,
+		Filled:      stat.Filled,
+		Distinct:    stat.Distinct,
+		FillRate:    stat.FillRate(),
+		Custom:      stat.Custom,
+	}
+	for _, option := range stat.Options {
+		entry.Options = append(entry.Options, optionUsageJSON{
+			LastUpdated: option.LastUpdated,
+			ID:          option.ID,
+			Name:        option.Name,
+			Items:       option.Items,
+		})
+	}
+	return entry
+}
+
+func formatLastUpdated(updatedAt *time.Time) string {
+	if updatedAt == nil {
+		return "-"
+	}
+	return updatedAt.Format("2006-01-02")
+}
+
+func outputFieldStats(stats []service.FieldStats, projectName string, items int, format string) error {
+	switch format {
+	case formatJSON:
+		entries := make([]fieldStatsJSON, len(stats))
+		for i := range stats {
+			entries[i] = fieldStatsEntry(&stats[i])
+		}
+
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		fmt.Printf("Field usage in %s (%d items):\n\n", projectName, items)
+		fmt.Printf("%-24s %-14s %-16s %-9s %s\n", "FIELD", "TYPE", "FILLED", "DISTINCT", "LAST UPDATED")
+
+		for i := range stats {
+			stat := &stats[i]
+			filled := fmt.Sprintf("%3.0f%% (%d/%d)", stat.FillRate()*100, stat.Filled, stat.Items)
+			fmt.Printf("%-24s %-14s %-16s %-9d %s\n", stat.Name, service.FormatFieldDataType(stat.DataType),
+				filled, stat.Distinct, formatLastUpdated(stat.LastUpdated))
+
+			for _, option := range stat.Options {
+				marker := ""
+				if option.Items == 0 {
+					marker = "  ⚠️  unused"
+				}
+				fmt.Printf("  %-22s %-14s %-16d %-9s %s%s\n", option.Name, "", option.Items, "",
+					formatLastUpdated(option.LastUpdated), marker)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func outputUnusedFields(report *service.UnusedFieldsReport, projectName, format string) error {
+	switch format {
+	case formatJSON:
+		output := unusedFieldsJSON{
+			Fields:  make([]fieldStatsJSON, len(report.Fields)),
+			Options: make([]optionUsageJSON, len(report.Options)),
+		}
+		for i := range report.Fields {
+			output.Fields[i] = fieldStatsEntry(&report.Fields[i])
+		}
+		for i, unused := range report.Options {
+			output.Options[i] = optionUsageJSON{Field: unused.Field, ID: unused.Option.ID, Name: unused.Option.Name}
+		}
+
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if len(report.Fields) == 0 && len(report.Options) == 0 {
+			fmt.Printf("✅ Every field and option in %s is used\n", projectName)
+			return nil
+		}
+
+		if len(report.Fields) > 0 {
+			fmt.Printf("Fields without values in %s:\n\n", projectName)
+			fmt.Printf("%-24s %-14s %s\n", "FIELD", "TYPE", "ID")
+			for i := range report.Fields {
+				field := &report.Fields[i]
+				fmt.Printf("%-24s %-14s %s\n", field.Name, service.FormatFieldDataType(field.DataType), field.ID)
+			}
+			fmt.Printf("\nDelete with: ghp field delete <field-id>\n")
+		}
+
+		if len(report.Options) > 0 {
+			if len(report.Fields) > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("Options without items in %s:\n\n", projectName)
+			fmt.Printf("%-24s %-24s %s\n", "FIELD", "OPTION", "ID")
+			for _, unused := range report.Options {
+				fmt.Printf("%-24s %-24s %s\n", unused.Field, unused.Option.Name, unused.Option.ID)
+			}
+			fmt.Printf("\nDelete with: ghp field delete-option <option-id>\n")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
@@ -0,0 +1,388 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// SyncOptionsOptions holds options for the sync-options command
+type SyncOptionsOptions struct {
+	ProjectRef     string
+	Field          string
+	FromLabels     string
+	FromMilestones string
+	Prefix         string
+	Format         string
+	Prune          bool
+	Backfill       bool
+	Overwrite      bool
+	DryRun         bool
+	Force          bool
+}
+
+// syncOptionsJSON is the JSON representation of an option sync
+type syncOptionsJSON struct {
+	Field      string               `json:"field"`
+	Repository string               `json:"repository"`
+	Changes    []string             `json:"changes"`
+	Stale      []string             `json:"stale"`
+	Backfill   []optionBackfillJSON `json:"backfill,omitempty"`
+	Conflicts  []optionBackfillJSON `json:"conflicts,omitempty"`
+	Ambiguous  []optionBackfillJSON `json:"ambiguous,omitempty"`
+	Errors     []string             `json:"errors,omitempty"`
+	Applied    int                  `json:"applied"`
+	Updated    int                  `json:"updated"`
+	Failed     int                  `json:"failed"`
+	DryRun     bool                 `json:"dry_run"`
+}
+
+type optionBackfillJSON struct {
+	ItemID  string `json:"item_id"`
+	Item    string `json:"item"`
+	Current string `json:"current,omitempty"`
+	Value   string `json:"value"`
+}
+
+// NewSyncOptionsCmd creates the sync-options command
+func NewSyncOptionsCmd() *cobra.Command {
+	opts := &SyncOptionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync-options <owner>/<number> <field>",
+		Short: "Sync single select options from repository labels or milestones",
+		Long: `Keep the options of a single select field in sync with the labels or
+milestones of a repository.
+
+With --from-labels, every label starting with --prefix becomes an option
+named after the rest of the label ("area/frontend" becomes "frontend"),
+colored with the closest option color and described by the label's
+description. With --from-milestones, every open milestone becomes an option;
+options of closed milestones are kept, so shipped items keep their values.
+Options are matched by name (case-insensitive); missing options are added
+and existing ones keep their order.
+
+Options without a matching label or milestone are listed as stale and only
+deleted with --prune, which asks for confirmation unless --force is given.
+
+With --backfill, items of the repository whose field is empty are set from
+their labels or milestone. Items that already have a different value are
+reported and only changed with --overwrite; items with several matching
+labels are reported and left alone.
+
+Examples:
+  ghp field sync-options octocat/123 Area --from-labels octocat/app --prefix area/
+  ghp field sync-options octocat/123 Area --from-labels octocat/app --prefix area/ --backfill
+  ghp field sync-options octocat/123 Release --from-milestones octocat/app --prune --dry-run`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Field = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runSyncOptions(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromLabels, "from-labels", "", "Repository (owner/repo) whose labels become options")
+	cmd.Flags().StringVar(&opts.FromMilestones, "from-milestones", "", "Repository (owner/repo) whose open milestones become options")
+	cmd.Flags().StringVar(&opts.Prefix, "prefix", "", "Only sync labels starting with this prefix, which is left out of option names")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete options without a matching label or milestone")
+	cmd.Flags().BoolVar(&opts.Backfill, "backfill", false, "Set empty item values from their labels or milestone")
+	cmd.Flags().BoolVar(&opts.Overwrite, "overwrite", false, "With --backfill, also replace values that differ")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without applying them")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt for deletions")
+
+	return cmd
+}
+
+func runSyncOptions(ctx context.Context, opts *SyncOptionsOptions) error {
+	if (opts.FromLabels == "") == (opts.FromMilestones == "") {
+		return fmt.Errorf("specify either --from-labels or --from-milestones")
+	}
+	if opts.Prefix != "" && opts.FromLabels == "" {
+		return fmt.Errorf("--prefix can only be used with --from-labels")
+	}
+	if opts.Overwrite && !opts.Backfill {
+		return fmt.Errorf("--overwrite can only be used with --backfill")
+	}
+	if opts.Prune && !opts.Force && !opts.DryRun && opts.Format == formatJSON {
+		return fmt.Errorf("--prune with JSON output requires --force")
+	}
+
+	repository := opts.FromLabels
+	if repository == "" {
+		repository = opts.FromMilestones
+	}
+
+	// Parse project reference
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	fieldService := service.NewFieldService(client)
+	issueService := service.NewIssueService(client)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	field, err := findField(project.Fields.Nodes, opts.Field)
+	if err != nil {
+		return err
+	}
+	if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return fmt.Errorf("field '%s' is a %s field, not a single select field", field.Name, service.FormatFieldDataType(field.DataType))
+	}
+
+	// The project only lists the first options of each field; planning needs all of them
+	// so existing options are not recreated or pruned
+	field, err = fieldService.GetSingleSelectField(ctx, field.ID)
+	if err != nil {
+		return err
+	}
+
+	details, err := issueService.GetRepositoryByName(ctx, repository)
+	if err != nil {
+		return err
+	}
+
+	var sources []service.OptionSource
+	if opts.FromLabels != "" {
+		labels, labelsErr := issueService.ListRepositoryLabels(ctx, details.NameWithOwner)
+		if labelsErr != nil {
+			return labelsErr
+		}
+		sources = service.LabelOptionSources(labels, opts.Prefix)
+		if len(sources) == 0 {
+			return fmt.Errorf("no labels starting with '%s' found in %s", opts.Prefix, details.NameWithOwner)
+		}
+	} else {
+		milestones, milestonesErr := issueService.ListRepositoryMilestones(ctx, details.NameWithOwner)
+		if milestonesErr != nil {
+			return milestonesErr
+		}
+		sources = service.MilestoneOptionSources(milestones)
+		if len(sources) == 0 {
+			return fmt.Errorf("no milestones found in %s", details.NameWithOwner)
+		}
+	}
+
+	plan, err := service.PlanOptionSync(field.Options.Nodes, sources, opts.Prune)
+	if err != nil {
+		return err
+	}
+
+	var backfill *service.OptionBackfillPlan
+	if opts.Backfill {
+		// Archived items keep their values, so fill them too
+		projectItems, listErr := projectService.ListProjectItems(ctx, project.ID)
+		if listErr != nil {
+			return fmt.Errorf("failed to list project items: %w", listErr)
+		}
+		backfill = service.PlanOptionBackfill(service.OptionBackfillInput{
+			Field:      field.Name,
+			Repository: details.NameWithOwner,
+			Items:      service.ConvertProjectItems(projectItems),
+			Sources:    plan.Sources,
+			Deleted:    plan.Edits.Deletes,
+			Milestones: opts.FromMilestones != "",
+			Overwrite:  opts.Overwrite,
+		})
+	}
+
+	report := newSyncOptionsReport(field.Name, details.NameWithOwner, plan, backfill)
+	report.DryRun = opts.DryRun
+
+	if opts.Format != formatJSON {
+		outputSyncOptionsPlan(report)
+	}
+
+	if opts.DryRun || (plan.Edits.IsEmpty() && len(report.Backfill) == 0) {
+		return outputSyncOptionsResult(report, opts.Format)
+	}
+
+	if len(plan.Edits.Deletes) > 0 && !opts.Force && !confirmSyncDeletes(plan.Edits.Deletes, field.Name) {
+		fmt.Println("❌ Sync canceled.")
+		return nil
+	}
+
+	if !plan.Edits.IsEmpty() {
+		result := fieldService.ApplyOptionEdits(ctx, project.ID, field, nil, plan.Edits)
+		report.Applied = result.Applied
+		report.Failed = result.Failed
+		report.Errors = result.Errors
+
+		if report.Failed > 0 {
+			if err := outputSyncOptionsResult(report, opts.Format); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed to apply %d option changes", report.Failed)
+		}
+	}
+
+	if backfill != nil && len(backfill.Assignments) > 0 {
+		// Reload the field to resolve the IDs of new options
+		field, err = fieldService.GetSingleSelectField(ctx, field.ID)
+		if err != nil {
+			return fmt.Errorf("synced options but failed to reload the field: %w", err)
+		}
+
+		result := itemService.ApplyOptionBackfill(ctx, project.ID, field, backfill)
+		report.Updated = result.Updated
+		report.Failed += result.Failed
+		report.Errors = append(report.Errors, result.Errors...)
+	}
+
+	if err := outputSyncOptionsResult(report, opts.Format); err != nil {
+		return err
+	}
+
+	if report.Failed > 0 {
+		return fmt.Errorf("failed to update %d items", report.Failed)
+	}
+
+	return nil
+}
+
+func newSyncOptionsReport(fieldName, repository string, plan *service.OptionSyncPlan, backfill *service.OptionBackfillPlan) *syncOptionsJSON {
+	report := &syncOptionsJSON{
+		Field:      fieldName,
+		Repository: repository,
+		Changes:    service.DescribeOptionEditPlan(plan.Edits),
+		Stale:      make([]string, len(plan.Stale)),
+	}
+	if report.Changes == nil {
+		report.Changes = []string{}
+	}
+	for i, option := range plan.Stale {
+		report.Stale[i] = option.Name
+	}
+
+	if backfill != nil {
+		report.Backfill = backfillEntries(backfill.Assignments)
+		report.Conflicts = backfillEntries(backfill.Conflicts)
+		report.Ambiguous = backfillEntries(backfill.Ambiguous)
+	}
+
+	return report
+}
+
+func backfillEntries(backfills []service.OptionBackfill) []optionBackfillJSON {
+	entries := make([]optionBackfillJSON, len(backfills))
+	for i := range backfills {
+		backfill := &backfills[i]
+		entries[i] = optionBackfillJSON{
+			ItemID:  backfill.Item.ItemID,
+			Item:    conversionItemLabel(&backfill.Item),
+			Current: backfill.Current,
+			Value:   backfill.Value,
+		}
+	}
+	return entries
+}
+
+func confirmSyncDeletes(options []graphql.ProjectV2SingleSelectFieldOption, fieldName string) bool {
+	fmt.Printf("⚠️  You are about to delete %d options of %s:\n", len(options), fieldName)
+	for _, option := range options {
+		fmt.Printf("  • %s\n", option.Name)
+	}
+	fmt.Printf("\nItems using these options will lose their value. Type 'DELETE' to confirm: ")
+
+	var confirmation string
+	if _, err := fmt.Scanln(&confirmation); err != nil {
+		return false
+	}
+
+	return confirmation == "DELETE"
+}
+
+func outputSyncOptionsPlan(report *syncOptionsJSON) {
+	if len(report.Changes) == 0 {
+		fmt.Printf("✅ Options of %s match %s\n", report.Field, report.Repository)
+	} else {
+		fmt.Printf("Changes to the options of %s from %s:\n", report.Field, report.Repository)
+		for _, change := range report.Changes {
+			fmt.Printf("  • %s\n", change)
+		}
+	}
+
+	if len(report.Stale) > 0 {
+		fmt.Printf("\nNo matching label or milestone (use --prune to delete):\n")
+		for _, name := range report.Stale {
+			fmt.Printf("  • %s\n", name)
+		}
+	}
+
+	if len(report.Backfill) > 0 {
+		fmt.Printf("\nItems to fill:\n")
+		for _, entry := range report.Backfill {
+			fmt.Printf("  %-40s → %s\n", entry.Item, entry.Value)
+		}
+	}
+	if len(report.Conflicts) > 0 {
+		fmt.Printf("\n⚠️  Items whose value differs (use --overwrite to replace):\n")
+		for _, entry := range report.Conflicts {
+			fmt.Printf("  %-40s %s → %s\n", entry.Item, entry.Current, entry.Value)
+		}
+	}
+	if len(report.Ambiguous) > 0 {
+		fmt.Printf("\n⚠️  Items with several matching labels (left alone):\n")
+		for _, entry := range report.Ambiguous {
+			fmt.Printf("  %-40s %s\n", entry.Item, entry.Value)
+		}
+	}
+	fmt.Println()
+}
+
+func outputSyncOptionsResult(report *syncOptionsJSON, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if report.DryRun {
+			fmt.Println("Dry run: nothing was changed.")
+			return nil
+		}
+		if report.Applied > 0 {
+			fmt.Printf("✅ Applied %d changes to the options of %s\n", report.Applied, report.Field)
+		}
+		if report.Updated > 0 {
+			fmt.Printf("✅ Filled %s on %d items\n", report.Field, report.Updated)
+		}
+		if report.Failed > 0 {
+			fmt.Printf("❌ Failed to apply %d changes\n", report.Failed)
+			for _, errMsg := range report.Errors {
+				fmt.Printf("  Error: %s\n", errMsg)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
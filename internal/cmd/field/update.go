@@ -3,6 +3,7 @@ package field
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -14,9 +15,16 @@ import (
 
 // UpdateOptions holds options for the update command
 type UpdateOptions struct {
-	FieldID string
-	Name    string
-	Format  string
+	FieldID          string
+	Name             string
+	Format           string
+	StartDate        string
+	Duration         string
+	AddIterations    []string
+	RemoveIterations []string
+	AddBreaks        []string
+	RemoveBreaks     []string
+	Force            bool
 }
 
 // NewUpdateCmd creates the update command
@@ -28,12 +36,34 @@ func NewUpdateCmd() *cobra.Command {
 		Short: "Update a project field",
 		Long: `Update properties of an existing project field.
 
-Currently, you can update the field name. Other field properties like
-data type cannot be changed after creation.
+You can update the field name and, for iteration fields, the iterations.
+The data type of a field cannot be changed after creation.
+
+Iteration fields:
+  --start-date         Move the iterations that are not completed so the
+                       first of them starts on this date
+  --duration           Set the length of new iterations (e.g. 10d, 2w)
+  --add-iteration      Append an iteration after the last one
+  --remove-iteration   Remove an iteration, leaving the others in place
+  --add-break          Insert a break after an iteration as Title=Length,
+                       moving the later iterations
+  --remove-break       Close the break after an iteration
+
+Completed iterations are never moved. Use 'ghp field iterations' to see
+the current schedule.
+
+GitHub does not keep iterations when they are edited: every iteration,
+completed ones included, is recreated, and items can lose their values in
+the field. Iteration changes therefore ask for confirmation unless --force
+is given. A new --name is applied in the same update, so nothing changes
+when the iteration flags are invalid.
 
 Examples:
   ghp field update field-id --name "New Priority"
-  ghp field update field-id --name "Status Category" --format json`,
+  ghp field update field-id --name "Status Category" --format json
+  ghp field update sprint-field-id --duration 1w --add-iteration "Sprint 7" --add-iteration "Sprint 8"
+  ghp field update sprint-field-id --add-break "Sprint 4=1w" --force
+  ghp field update sprint-field-id --start-date 2025-04-07 --remove-iteration "Sprint 3"`,
 
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -44,15 +74,30 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&opts.Name, "name", "", "New name for the field")
-	_ = cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVar(&opts.StartDate, "start-date", "", "Start date of the first iteration that is not completed (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.Duration, "duration", "", "Length of new iterations (e.g., 10d, 2w)")
+	cmd.Flags().StringArrayVar(&opts.AddIterations, "add-iteration", nil, "Append an iteration with this title (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.RemoveIterations, "remove-iteration", nil, "Remove the iteration with this title (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.AddBreaks, "add-break", nil, "Insert a break after an iteration as Title=Length (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.RemoveBreaks, "remove-break", nil, "Close the break after the iteration with this title (can be used multiple times)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt for iteration changes")
 
 	return cmd
 }
 
 func runUpdate(ctx context.Context, opts *UpdateOptions) error {
+	if opts.Name == "" && !opts.hasIterationChanges() {
+		return fmt.Errorf("nothing to update (use --name or the iteration flags)")
+	}
+
 	// Validate field name
-	if err := service.ValidateFieldName(opts.Name); err != nil {
-		return err
+	if opts.Name != "" {
+		if err := service.ValidateFieldName(opts.Name); err != nil {
+			return err
+		}
+	}
+	if opts.hasIterationChanges() && !opts.Force && opts.Format == formatJSON {
+		return fmt.Errorf("iteration changes with JSON output require --force")
 	}
 
 	// Initialize authentication
@@ -67,18 +112,127 @@ func runUpdate(ctx context.Context, opts *UpdateOptions) error {
 	fieldService := service.NewFieldService(client)
 
 	// Update field
-	input := service.UpdateFieldInput{
-		FieldID: opts.FieldID,
-		Name:    &opts.Name,
+	if !opts.hasIterationChanges() {
+		input := service.UpdateFieldInput{
+			FieldID: opts.FieldID,
+			Name:    &opts.Name,
+		}
+
+		field, updateErr := fieldService.UpdateField(ctx, input)
+		if updateErr != nil {
+			return fmt.Errorf("failed to update field: %w", updateErr)
+		}
+
+		// Output updated field
+		return outputUpdatedField(field, opts.Format)
+	}
+
+	// Edit the iterations before changing anything, so invalid edits leave the field alone
+	schedule, err := fieldService.GetIterationSchedule(ctx, opts.FieldID)
+	if err != nil {
+		return err
+	}
+
+	if err := applyIterationChanges(schedule, opts); err != nil {
+		return err
 	}
 
-	field, err := fieldService.UpdateField(ctx, input)
+	if !opts.Force && !confirmIterationUpdate(schedule.FieldName) {
+		fmt.Println("❌ Update canceled.")
+		return nil
+	}
+
+	var name *string
+	if opts.Name != "" {
+		name = &opts.Name
+	}
+
+	schedule, err = fieldService.UpdateIterationField(ctx, schedule, name)
 	if err != nil {
-		return fmt.Errorf("failed to update field: %w", err)
+		return err
+	}
+
+	if opts.Format == formatTable {
+		fmt.Printf("✅ Iterations of field '%s' updated successfully\n\n", schedule.FieldName)
+	}
+	return outputIterationSchedule(schedule, opts.Format)
+}
+
+func confirmIterationUpdate(fieldName string) bool {
+	fmt.Printf("⚠️  GitHub recreates every iteration of '%s' when its iterations change, completed ones included.\n", fieldName)
+	fmt.Printf("Items can lose their '%s' values.\n", fieldName)
+	fmt.Printf("Type 'UPDATE' to confirm: ")
+
+	var confirmation string
+	if _, err := fmt.Scanln(&confirmation); err != nil {
+		return false
+	}
+
+	return confirmation == "UPDATE"
+}
+
+func (opts *UpdateOptions) hasIterationChanges() bool {
+	return opts.StartDate != "" || opts.Duration != "" ||
+		len(opts.AddIterations) > 0 || len(opts.RemoveIterations) > 0 ||
+		len(opts.AddBreaks) > 0 || len(opts.RemoveBreaks) > 0
+}
+
+// applyIterationChanges edits the schedule in a fixed order: start date, duration,
+// removed iterations, breaks and finally new iterations
+func applyIterationChanges(schedule *service.IterationSchedule, opts *UpdateOptions) error {
+	if opts.StartDate != "" {
+		date, err := parseIterationDate(opts.StartDate)
+		if err != nil {
+			return err
+		}
+		if err := schedule.SetStartDate(date); err != nil {
+			return err
+		}
 	}
 
-	// Output updated field
-	return outputUpdatedField(field, opts.Format)
+	if opts.Duration != "" {
+		days, err := graphql.ParseDurationDays(opts.Duration)
+		if err != nil {
+			return err
+		}
+		if err := schedule.SetDuration(days); err != nil {
+			return err
+		}
+	}
+
+	for _, title := range opts.RemoveIterations {
+		if err := schedule.RemoveIteration(title); err != nil {
+			return err
+		}
+	}
+
+	for _, title := range opts.RemoveBreaks {
+		if err := schedule.RemoveBreak(title); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range opts.AddBreaks {
+		title, length, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf("invalid break: %s (expected Title=Length, e.g. \"Sprint 4=1w\")", entry)
+		}
+		days, err := graphql.ParseDurationDays(length)
+		if err != nil {
+			return err
+		}
+		if err := schedule.AddBreak(title, days); err != nil {
+			return err
+		}
+	}
+
+	for _, title := range opts.AddIterations {
+		if err := schedule.AddIteration(title); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func outputUpdatedField(field *graphql.ProjectV2Field, format string) error {
@@ -3,6 +3,7 @@ package item
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 
@@ -14,10 +15,12 @@ import (
 const (
 	maxDisplayTitleLength  = 80
 	addTitleTruncateLength = 77
+	stdinItemRef           = "-"
 )
 
 // AddOptions holds options for the add command
 type AddOptions struct {
+	In         io.Reader
 	ProjectRef string
 	ItemRef    string
 	Title      string
@@ -39,19 +42,29 @@ Item references can be in the following formats:
 • owner/repo#123 (issue or PR reference)
 • https://github.com/owner/repo/issues/123 (GitHub issue URL)
 • https://github.com/owner/repo/pull/456 (GitHub PR URL)
+• I_kwDOABC123 or PR_kwDOABC123 (issue or PR node ID)
+
+Use "-" as the item to read references from standard input, one per line.
+Lines may also be JSON objects, and a JSON array is accepted as well, so the
+JSON output of 'ghp item list' can be piped in directly. Duplicates and items
+already in the project are skipped, and unreadable lines are reported with
+their line number without stopping the rest.
 
 Project references should be in owner/number format (e.g., octocat/1).
 
 Examples:
   ghp item add octocat/1 octocat/Hello-World#123     # Add issue to project
   ghp item add myorg/2 myorg/repo#456 --format json  # Add PR with JSON output
-  ghp item add octocat/1 --draft --title "New task"  # Create draft issue`,
+  ghp item add octocat/1 --draft --title "New task"  # Create draft issue
+  ghp item list myorg/api --label bug --format json | ghp item add myorg/5 -
+  cat issues.txt | ghp item add myorg/5 -`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			if len(args) > 1 {
 				opts.ItemRef = args[1]
 			}
+			opts.In = cmd.InOrStdin()
 			return runAdd(cmd.Context(), opts)
 		},
 	}
@@ -103,36 +116,18 @@ func addDraftIssue(ctx context.Context, itemService *service.ItemService, projec
 }
 
 func addExistingItem(ctx context.Context, itemService *service.ItemService, projectID, itemRef, format string) error {
-	itemOwner, itemRepo, itemNumber, err := service.ParseItemReference(itemRef)
+	content, err := itemService.ResolveItem(ctx, itemRef)
 	if err != nil {
 		return fmt.Errorf("invalid item reference: %w", err)
 	}
 
-	var contentID, itemType, itemTitle string
-
-	// Try to get as issue first, then as PR
-	issue, err := itemService.GetIssue(ctx, itemOwner, itemRepo, itemNumber)
-	if err == nil {
-		contentID = issue.ID
-		itemType = "Issue"
-		itemTitle = issue.Title
-	} else {
-		pr, prErr := itemService.GetPullRequest(ctx, itemOwner, itemRepo, itemNumber)
-		if prErr != nil {
-			return fmt.Errorf("failed to find issue or pull request: %w", prErr)
-		}
-		contentID = pr.ID
-		itemType = "PullRequest"
-		itemTitle = pr.Title
-	}
-
-	item, err := itemService.AddItemToProject(ctx, projectID, contentID)
+	item, err := itemService.AddItemToProject(ctx, projectID, content.ID)
 	if err != nil {
 		return fmt.Errorf("failed to add item to project: %w", err)
 	}
 
-	fmt.Printf("✅ %s added to project!\n\n", itemType)
-	return outputAddedItem(item, format, itemType, itemTitle)
+	fmt.Printf("✅ %s added to project!\n\n", content.Type)
+	return outputAddedItem(item, format, content.Type, content.Title)
 }
 
 func runAdd(ctx context.Context, opts *AddOptions) error {
@@ -166,9 +161,73 @@ func runAdd(ctx context.Context, opts *AddOptions) error {
 		return addDraftIssue(ctx, itemService, project.ID, opts.Title, body, opts.Format)
 	}
 
+	if opts.ItemRef == stdinItemRef {
+		return addItemsFromStream(ctx, itemService, project.ID, opts.In)
+	}
+
 	return addExistingItem(ctx, itemService, project.ID, opts.ItemRef, opts.Format)
 }
 
+// addItemsFromStream adds every item read from a stream, reporting failures per line
+func addItemsFromStream(ctx context.Context, itemService *service.ItemService, projectID string, in io.Reader) error {
+	entries, failures, err := service.ParseItemStream(in)
+	if err != nil {
+		return err
+	}
+
+	var items []service.CreateItemInput
+	seenRefs := make(map[string]bool)
+	seenContent := make(map[string]bool)
+
+	for _, entry := range entries {
+		if seenRefs[entry.Ref] {
+			continue
+		}
+		seenRefs[entry.Ref] = true
+
+		info, resolveErr := itemService.ResolveItem(ctx, entry.Ref)
+		if resolveErr != nil {
+			failures = append(failures, fmt.Sprintf("line %d: %s: %v", entry.Line, entry.Ref, resolveErr))
+			continue
+		}
+
+		if seenContent[info.ID] {
+			continue
+		}
+		seenContent[info.ID] = true
+
+		contentID := info.ID
+		items = append(items, service.CreateItemInput{
+			Title:       info.Title,
+			ContentType: info.Type,
+			ContentID:   &contentID,
+		})
+	}
+
+	if len(items) == 0 && len(failures) == 0 {
+		fmt.Println("No items found to add")
+		return nil
+	}
+
+	result, err := itemService.BulkAddItems(ctx, service.BulkAddInput{
+		ProjectID: projectID,
+		Items:     items,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add items: %w", err)
+	}
+
+	result.Failed += len(failures)
+	result.Errors = append(failures, result.Errors...)
+
+	outputBulkAddResult(result)
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to add %d items", result.Failed)
+	}
+	return nil
+}
+
 func outputAddedItem(item interface{}, format, itemType, title string) error {
 	switch format {
 	case formatJSON:
@@ -2,21 +2,34 @@ package item
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
+// AddBulkOptions holds options for the add-bulk command
+type AddBulkOptions struct {
+	ProjectRef string
+	Issues     string
+	Repo       string
+	Label      string
+	Search     string
+	FromFile   string
+	Set        []string
+	Limit      int
+}
+
 // NewAddBulkCmd creates the add-bulk command
 func NewAddBulkCmd() *cobra.Command {
-	var (
-		issues   string
-		label    string
-		fromFile string
-	)
+	opts := &AddBulkOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "add-bulk PROJECT_ID",
@@ -24,84 +37,325 @@ func NewAddBulkCmd() *cobra.Command {
 		Long: `Add multiple issues or pull requests to a GitHub Project in bulk.
 
 This command allows you to add multiple items at once using various methods:
-• Number range (e.g., 34-46)
-• By label
-• From a file containing issue URLs or numbers
+• Number range (e.g., 34-46) in a repository given by --repo
+• By label in a repository given by --repo
+• By GitHub search query
+• From a file containing issue URLs or owner/repo#number references
+
+Items that are already in the project are skipped. Initial field values can
+be set on every added item with --set. Each item is checked against the
+project's field rules (see 'ghp field lint') with those values before
+anything is added; items that would break a rule are not added and are
+reported as failures.
 
 Examples:
   # Add issues by number range
-  ghp item add-bulk myorg/123 --issues 34-46
-  
+  ghp item add-bulk myorg/123 --repo myorg/api --issues 34-46
+
   # Add all issues with a specific label
-  ghp item add-bulk myorg/123 --label epic
-  
-  # Add issues from a file (one per line)
-  ghp item add-bulk myorg/123 --from-file issue-list.txt`,
+  ghp item add-bulk myorg/123 --repo myorg/api --label epic
+
+  # Add everything matching a search query
+  ghp item add-bulk myorg/123 --search "org:myorg is:open label:bug"
+
+  # Add issues from a file (one per line) and set their status
+  ghp item add-bulk myorg/123 --from-file issue-list.txt --set Status=Todo`,
 		Args: cobra.ExactArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
-			projectID := args[0]
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runAddBulk(cmd.Context(), opts)
+		},
+	}
 
-			// Validate that at least one input method is specified
-			if issues == "" && label == "" && fromFile == "" {
-				return fmt.Errorf("at least one of --issues, --label, or --from-file must be specified")
-			}
+	cmd.Flags().StringVar(&opts.Issues, "issues", "", "Issue number range (e.g., 34-46), requires --repo")
+	cmd.Flags().StringVar(&opts.Repo, "repo", "", "Repository for --issues and --label (owner/repo)")
+	cmd.Flags().StringVar(&opts.Label, "label", "", "Add all issues and pull requests with this label, requires --repo")
+	cmd.Flags().StringVar(&opts.Search, "search", "", "Add all issues and pull requests matching a search query")
+	cmd.Flags().StringVar(&opts.FromFile, "from-file", "", "File containing issue URLs or references (one per line)")
+	cmd.Flags().StringArrayVar(&opts.Set, "set", nil, "Initial field value as Name=Value (can be used multiple times)")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 0, "Maximum number of items to add from --label and --search together (0 for no limit)")
+
+	return cmd
+}
+
+func validateAddBulkOptions(opts *AddBulkOptions) error {
+	if opts.Issues == "" && opts.Label == "" && opts.Search == "" && opts.FromFile == "" {
+		return fmt.Errorf("at least one of --issues, --label, --search, or --from-file must be specified")
+	}
+
+	if (opts.Issues != "" || opts.Label != "") && opts.Repo == "" {
+		return fmt.Errorf("--repo is required when using --issues or --label")
+	}
+
+	if opts.Repo != "" && len(strings.Split(opts.Repo, "/")) != 2 {
+		return fmt.Errorf("invalid repository format: %s (expected owner/repo)", opts.Repo)
+	}
+
+	return nil
+}
+
+func runAddBulk(ctx context.Context, opts *AddBulkOptions) error {
+	if err := validateAddBulkOptions(opts); err != nil {
+		return err
+	}
+
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
 
-			var itemsToAdd []string
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	fieldValues, err := service.ResolveFieldValues(project.Fields.Nodes, opts.Set)
+	if err != nil {
+		return err
+	}
+
+	rules, err := loadFieldRules(opts.ProjectRef, project.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+	assignments, err := service.ParseFieldAssignments(opts.Set)
+	if err != nil {
+		return err
+	}
+
+	found, failures := collectBulkItems(ctx, itemService, opts)
+	if len(found) == 0 && len(failures) == 0 {
+		fmt.Println("No items found to add")
+		return nil
+	}
+
+	items, ruleErrors, err := checkBulkAddRules(ctx, projectService, project.ID, rules, found, assignments)
+	if err != nil {
+		return err
+	}
+	failures = append(failures, ruleErrors...)
 
-			// Handle number range
-			if issues != "" {
-				items, err := parseNumberRange(issues)
-				if err != nil {
-					return fmt.Errorf("invalid issue range: %w", err)
-				}
-				itemsToAdd = append(itemsToAdd, items...)
+	fmt.Printf("Adding %d items to project %s...\n", len(items), opts.ProjectRef)
+
+	input := service.BulkAddInput{
+		ProjectID:   project.ID,
+		Items:       items,
+		FieldValues: fieldValues,
+	}
+
+	result, err := itemService.BulkAddItems(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to add items: %w", err)
+	}
+
+	result.Failed += len(failures)
+	result.Errors = append(failures, result.Errors...)
+
+	outputBulkAddResult(result)
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to add %d items", result.Failed)
+	}
+	if result.FieldsFailed > 0 {
+		return fmt.Errorf("failed to set field values on %d added items", result.FieldsFailed)
+	}
+	return nil
+}
+
+// checkBulkAddRules turns the found content into items to add, leaving out content the
+// field values would make break the project's field rules. Content that is already in
+// the project is skipped when adding, so it is not checked.
+func checkBulkAddRules(ctx context.Context, projectService *service.ProjectService, projectID string,
+	rules []service.FieldRule, found []service.ItemInfo, values map[string]string) ([]service.CreateItemInput, []string, error) {
+	existing := make(map[string]bool)
+	if len(rules) > 0 {
+		projectItems, err := projectItemsByID(ctx, projectService, projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, item := range projectItems {
+			existing[item.ContentID] = true
+		}
+	}
+
+	items := make([]service.CreateItemInput, 0, len(found))
+	var errors []string
+	for i := range found {
+		content := &found[i]
+		if !existing[content.ID] {
+			if violations := checkNewItemRules(rules, content, values); len(violations) > 0 {
+				errors = append(errors, fmt.Sprintf("%s: %s", content.Title, service.FormatRuleViolations(violations)))
+				continue
 			}
+		}
+
+		contentID := content.ID
+		items = append(items, service.CreateItemInput{
+			Title:       content.Title,
+			ContentType: content.Type,
+			ContentID:   &contentID,
+		})
+	}
+
+	return items, errors, nil
+}
+
+// collectBulkItems resolves every input source into issues and pull requests, returning per-reference failures
+func collectBulkItems(ctx context.Context, itemService *service.ItemService, opts *AddBulkOptions) ([]service.ItemInfo, []string) {
+	var refs []string
+	var failures []string
+
+	if opts.Issues != "" {
+		numbers, err := parseNumberRange(opts.Issues)
+		if err != nil {
+			return nil, []string{fmt.Sprintf("invalid issue range: %v", err)}
+		}
+		for _, number := range numbers {
+			refs = append(refs, fmt.Sprintf("%s#%d", opts.Repo, number))
+		}
+	}
+
+	if opts.FromFile != "" {
+		lines, err := readIssuesFromFile(opts.FromFile)
+		if err != nil {
+			return nil, []string{fmt.Sprintf("failed to read issues from file: %v", err)}
+		}
+		for _, line := range lines {
+			refs = append(refs, normalizeBulkReference(line, opts.Repo))
+		}
+	}
 
-			// Handle label
-			if label != "" {
-				items, err := getIssuesByLabel(projectID, label)
-				if err != nil {
-					return fmt.Errorf("failed to get issues by label: %w", err)
-				}
-				itemsToAdd = append(itemsToAdd, items...)
+	var items []service.ItemInfo
+	seen := make(map[string]bool)
+
+	addItem := func(info *service.ItemInfo) bool {
+		if seen[info.ID] {
+			return false
+		}
+		seen[info.ID] = true
+		items = append(items, *info)
+		return true
+	}
+
+	for _, ref := range removeDuplicates(refs) {
+		info, err := itemService.ResolveItemReference(ctx, ref)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", ref, err))
+			continue
+		}
+		addItem(info)
+	}
+
+	// --limit is shared by all searches
+	searched := 0
+	for _, query := range bulkSearchQueries(opts) {
+		limit := 0
+		if opts.Limit > 0 {
+			limit = opts.Limit - searched
+			if limit <= 0 {
+				break
 			}
+		}
 
-			// Handle file input
-			if fromFile != "" {
-				items, err := readIssuesFromFile(fromFile)
-				if err != nil {
-					return fmt.Errorf("failed to read issues from file: %w", err)
-				}
-				itemsToAdd = append(itemsToAdd, items...)
+		found, err := searchBulkItems(ctx, itemService, query, limit)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("search %q: %v", query, err))
+			continue
+		}
+		for i := range found {
+			if addItem(&found[i]) {
+				searched++
 			}
+		}
+	}
 
-			// Remove duplicates
-			itemsToAdd = removeDuplicates(itemsToAdd)
+	return items, failures
+}
 
-			fmt.Printf("Adding %d items to project %s...\n", len(itemsToAdd), projectID)
+// bulkSearchQueries builds the search queries for --label and --search
+func bulkSearchQueries(opts *AddBulkOptions) []string {
+	var queries []string
 
-			// Add items to project
-			successCount := 0
-			for _, item := range itemsToAdd {
-				// TODO: Implement actual GraphQL API call to add item
-				fmt.Printf("Adding item: %s\n", item)
-				successCount++
-			}
+	if opts.Label != "" {
+		queries = append(queries, service.BuildSearchQuery(&service.SearchFilters{
+			Repository: opts.Repo,
+			Labels:     []string{opts.Label},
+		}))
+	}
 
-			fmt.Printf("\n✓ Successfully added %d items to project\n", successCount)
-			return nil
-		},
+	if opts.Search != "" {
+		queries = append(queries, opts.Search)
 	}
 
-	cmd.Flags().StringVar(&issues, "issues", "", "Issue number range (e.g., 34-46)")
-	cmd.Flags().StringVar(&label, "label", "", "Add all issues with this label")
-	cmd.Flags().StringVar(&fromFile, "from-file", "", "File containing issue URLs or numbers (one per line)")
+	return queries
+}
 
-	return cmd
+// searchBulkItems searches issues and pull requests matching a query, following pagination
+// until limit results are found (0 means no limit)
+func searchBulkItems(ctx context.Context, itemService *service.ItemService, query string, limit int) ([]service.ItemInfo, error) {
+	issues, err := itemService.SearchAllIssues(ctx, query+" is:issue", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit > 0 {
+		if len(issues) >= limit {
+			return issues, nil
+		}
+		limit -= len(issues)
+	}
+
+	prs, err := itemService.SearchAllPullRequests(ctx, query+" is:pr", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(issues, prs...), nil
 }
 
-// parseNumberRange parses a number range like "34-46" into a slice of strings
-func parseNumberRange(rangeStr string) ([]string, error) {
+// normalizeBulkReference turns bare issue numbers into references using the given repository
+func normalizeBulkReference(ref, repo string) string {
+	if repo == "" {
+		return ref
+	}
+
+	if _, err := strconv.Atoi(ref); err == nil {
+		return fmt.Sprintf("%s#%s", repo, ref)
+	}
+
+	return ref
+}
+
+func outputBulkAddResult(result *service.BulkAddResult) {
+	fmt.Printf("\n✅ Added %d items to project\n", result.Added)
+	if result.Skipped > 0 {
+		fmt.Printf("⏭️  Skipped %d items already in project\n", result.Skipped)
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to add %d items\n", result.Failed)
+	}
+	if result.FieldsFailed > 0 {
+		fmt.Printf("❌ Failed to set field values on %d added items\n", result.FieldsFailed)
+	}
+	for _, errMsg := range result.Errors {
+		fmt.Printf("  Error: %s\n", errMsg)
+	}
+}
+
+// parseNumberRange parses a number range like "34-46" into a slice of numbers
+func parseNumberRange(rangeStr string) ([]int, error) {
 	parts := strings.Split(rangeStr, "-")
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid range format, expected 'start-end'")
@@ -121,21 +375,14 @@ func parseNumberRange(rangeStr string) ([]string, error) {
 		return nil, fmt.Errorf("start number must be less than or equal to end number")
 	}
 
-	var result []string
+	result := make([]int, 0, end-start+1)
 	for i := start; i <= end; i++ {
-		result = append(result, fmt.Sprintf("#%d", i))
+		result = append(result, i)
 	}
 
 	return result, nil
 }
 
-// getIssuesByLabel retrieves issues with a specific label
-func getIssuesByLabel(_, _ string) ([]string, error) {
-	// TODO: Implement GraphQL query to get issues by label
-	// This is a placeholder implementation
-	return []string{}, nil
-}
-
 // readIssuesFromFile reads issue URLs or numbers from a file
 func readIssuesFromFile(filename string) ([]string, error) {
 	file, err := os.Open(filename)
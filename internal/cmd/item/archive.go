@@ -0,0 +1,193 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// ArchiveOptions holds options for the archive and unarchive commands
+type ArchiveOptions struct {
+	ProjectRef string
+	Filter     string
+	ItemIDs    []string
+	Archive    bool
+	DryRun     bool
+}
+
+// NewArchiveCmd creates the archive command
+func NewArchiveCmd() *cobra.Command {
+	opts := &ArchiveOptions{Archive: true}
+
+	cmd := &cobra.Command{
+		Use:   "archive <project> [item-id...]",
+		Short: "Archive items in a project",
+		Long: `Archive one or more items in a project.
+
+Archived items are hidden from project views but keep their field values
+and can be restored at any time with 'ghp item unarchive'. Use this instead
+of 'ghp item remove' when cleaning up a board should be reversible.
+
+Items can be given by project item ID or selected with --filter, which uses
+GitHub Projects filter syntax (e.g. status:Done, label:bug, -assignee:octocat).
+
+Examples:
+  ghp item archive octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY      # Archive a single item
+  ghp item archive octocat/1 item-1 item-2 item-3              # Archive several items
+  ghp item archive myorg/2 --filter "status:Done is:closed"    # Archive all finished items
+  ghp item archive myorg/2 --filter status:Done --dry-run      # Preview what would be archived`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemIDs = args[1:]
+			return runArchive(cmd.Context(), opts)
+		},
+	}
+
+	addArchiveFlags(cmd, opts)
+
+	return cmd
+}
+
+// NewUnarchiveCmd creates the unarchive command
+func NewUnarchiveCmd() *cobra.Command {
+	opts := &ArchiveOptions{Archive: false}
+
+	cmd := &cobra.Command{
+		Use:   "unarchive <project> [item-id...]",
+		Short: "Restore archived items in a project",
+		Long: `Restore one or more archived items in a project.
+
+Items can be given by project item ID or selected with --filter. The filter
+is applied to archived items only, so "is:archived" is implied.
+
+Use 'ghp item list --project <project> --archived' to browse archived items.
+
+Examples:
+  ghp item unarchive octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY    # Restore a single item
+  ghp item unarchive myorg/2 --filter label:bug                # Restore archived bugs
+  ghp item unarchive myorg/2 --filter status:Done --dry-run    # Preview what would be restored`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemIDs = args[1:]
+			return runArchive(cmd.Context(), opts)
+		},
+	}
+
+	addArchiveFlags(cmd, opts)
+
+	return cmd
+}
+
+func addArchiveFlags(cmd *cobra.Command, opts *ArchiveOptions) {
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Select items using GitHub Projects filter syntax")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which items would be affected without making changes")
+}
+
+func runArchive(ctx context.Context, opts *ArchiveOptions) error {
+	if len(opts.ItemIDs) == 0 && opts.Filter == "" {
+		return fmt.Errorf("specify item IDs or --filter")
+	}
+
+	if len(opts.ItemIDs) > 0 && opts.Filter != "" {
+		return fmt.Errorf("item IDs and --filter cannot be used together")
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	// Get project details
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	action := archiveAction(opts.Archive)
+
+	itemIDs := opts.ItemIDs
+	if opts.Filter != "" {
+		items, listErr := itemService.ListProjectItems(ctx, project.ID, archiveFilter(opts))
+		if listErr != nil {
+			return fmt.Errorf("failed to list project items: %w", listErr)
+		}
+
+		if len(items) == 0 {
+			fmt.Printf("No items match filter: %s\n", opts.Filter)
+			return nil
+		}
+
+		itemIDs = projectItemIDs(items)
+
+		if opts.DryRun {
+			fmt.Printf("Would %s %d items:\n", action, len(items))
+			for i := range items {
+				fmt.Printf("  %s  %s\n", items[i].ItemID, items[i].Title)
+			}
+			return nil
+		}
+	} else if opts.DryRun {
+		fmt.Printf("Would %s %d items:\n", action, len(itemIDs))
+		for _, itemID := range itemIDs {
+			fmt.Printf("  %s\n", itemID)
+		}
+		return nil
+	}
+
+	result := itemService.BulkArchiveItems(ctx, project.ID, itemIDs, opts.Archive)
+
+	if result.Processed > 0 {
+		fmt.Printf("✅ %s %d items in project %s\n", archivePastTense(opts.Archive), result.Processed, project.Title)
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to %s %d items\n", action, result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		return fmt.Errorf("failed to %s %d of %d items", action, result.Failed, len(itemIDs))
+	}
+
+	return nil
+}
+
+// archiveFilter returns the filter used to select items, restricting unarchive to archived items
+func archiveFilter(opts *ArchiveOptions) string {
+	if opts.Archive {
+		return opts.Filter
+	}
+	return opts.Filter + " is:archived"
+}
+
+func archiveAction(archive bool) string {
+	if archive {
+		return "archive"
+	}
+	return "unarchive"
+}
+
+func archivePastTense(archive bool) string {
+	if archive {
+		return "Archived"
+	}
+	return "Unarchived"
+}
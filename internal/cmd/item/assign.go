@@ -0,0 +1,35 @@
+package item
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewAssignCmd creates the assign command
+func NewAssignCmd() *cobra.Command {
+	opts := &IssueEditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign [item...]",
+		Short: "Add or remove assignees on issues and pull requests",
+		Long: `Add or remove assignees on issues and pull requests.
+
+Items can be given as owner/repo#number references or URLs, or selected
+from a project with --project and --filter. When --project is given, items
+may also be project item IDs. Draft issues are skipped.
+
+Examples:
+  ghp item assign octocat/api#12 --add octocat
+  ghp item assign --project myorg/2 --filter "status:Ready no:assignee" --add hubot
+  ghp item assign --project myorg/2 --filter assignee:octocat --remove octocat --add monalisa`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Refs = args
+			return runIssueEdit(cmd.Context(), opts, "assign")
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Edit.AddAssignees, "add", nil, "User to assign (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&opts.Edit.RemoveAssignees, "remove", nil, "User to unassign (can be used multiple times)")
+	addIssueEditFlags(cmd, opts)
+
+	return cmd
+}
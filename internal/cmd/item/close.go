@@ -0,0 +1,87 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// NewCloseCmd creates the close command
+func NewCloseCmd() *cobra.Command {
+	opts := &IssueEditOptions{}
+	var reason string
+
+	cmd := &cobra.Command{
+		Use:   "close [item...]",
+		Short: "Close issues and pull requests",
+		Long: `Close issues and pull requests.
+
+Items can be given as owner/repo#number references or URLs, or selected
+from a project with --project and --filter. The close reason applies to
+issues only. Draft issues are skipped.
+
+Examples:
+  ghp item close octocat/api#12
+  ghp item close octocat/api#13 --reason not_planned
+  ghp item close --project myorg/2 --filter "status:Done is:open" --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Refs = args
+			stateReason, err := parseCloseReason(reason)
+			if err != nil {
+				return err
+			}
+			opts.Edit.State = service.IssueStateClosed
+			opts.Edit.StateReason = stateReason
+			return runIssueEdit(cmd.Context(), opts, "close")
+		},
+	}
+
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason for closing issues: {completed|not_planned}")
+	addIssueEditFlags(cmd, opts)
+
+	return cmd
+}
+
+// NewReopenCmd creates the reopen command
+func NewReopenCmd() *cobra.Command {
+	opts := &IssueEditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reopen [item...]",
+		Short: "Reopen closed issues and pull requests",
+		Long: `Reopen closed issues and pull requests.
+
+Items can be given as owner/repo#number references or URLs, or selected
+from a project with --project and --filter. Draft issues are skipped.
+
+Examples:
+  ghp item reopen octocat/api#12
+  ghp item reopen --project myorg/2 --filter "is:closed -status:Done"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Refs = args
+			opts.Edit.State = service.IssueStateOpen
+			return runIssueEdit(cmd.Context(), opts, "reopen")
+		},
+	}
+
+	addIssueEditFlags(cmd, opts)
+
+	return cmd
+}
+
+// parseCloseReason converts a --reason value to an issue state reason
+func parseCloseReason(reason string) (string, error) {
+	switch strings.ToLower(reason) {
+	case "":
+		return "", nil
+	case "completed":
+		return "COMPLETED", nil
+	case "not_planned", "not-planned":
+		return "NOT_PLANNED", nil
+	default:
+		return "", fmt.Errorf("invalid close reason: %s (expected completed or not_planned)", reason)
+	}
+}
@@ -0,0 +1,199 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// ConvertOptions holds options for the convert command
+type ConvertOptions struct {
+	ProjectRef string
+	Repo       string
+	Filter     string
+	ItemIDs    []string
+	Labels     []string
+	Assignees  []string
+	DryRun     bool
+}
+
+// NewConvertCmd creates the convert command
+func NewConvertCmd() *cobra.Command {
+	opts := &ConvertOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "convert <project> [draft-item-id...]",
+		Short: "Convert draft issues into repository issues",
+		Long: `Convert draft issues in a project into real issues in a repository.
+
+The project item is kept during conversion, so the new issue stays in the
+project with all of its field values. Labels and assignees can optionally be
+applied to the new issues.
+
+Drafts can be given by project item ID or selected with --filter, which is
+applied to draft items only.
+
+Examples:
+  ghp item convert octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY --repo octocat/api
+  ghp item convert octocat/1 --filter status:Ready --repo octocat/api --label triage
+  ghp item convert myorg/2 --filter -status:Backlog --repo myorg/web --assignee octocat --dry-run`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemIDs = args[1:]
+			if len(opts.ItemIDs) == 0 && !cmd.Flags().Changed("filter") {
+				return fmt.Errorf("specify draft item IDs or --filter")
+			}
+			return runConvert(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Repo, "repo", "", "Repository to create the issues in (owner/repo)")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Select drafts using GitHub Projects filter syntax")
+	cmd.Flags().StringSliceVar(&opts.Labels, "label", nil, "Label to add to the new issues (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&opts.Assignees, "assignee", nil, "User to assign to the new issues (can be used multiple times)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which drafts would be converted without making changes")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func runConvert(ctx context.Context, opts *ConvertOptions) error {
+	if len(opts.ItemIDs) > 0 && opts.Filter != "" {
+		return fmt.Errorf("item IDs and --filter cannot be used together")
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	issueService := service.NewIssueService(client)
+	projectService := service.NewProjectService(client)
+
+	// Get project details
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	repository, err := issueService.GetRepositoryByName(ctx, opts.Repo)
+	if err != nil {
+		return err
+	}
+
+	labelIDs, err := issueService.ResolveRepositoryLabelIDs(ctx, repository.NameWithOwner, opts.Labels)
+	if err != nil {
+		return err
+	}
+
+	assigneeIDs, err := issueService.ResolveUserIDs(ctx, opts.Assignees)
+	if err != nil {
+		return err
+	}
+
+	drafts, err := selectDrafts(ctx, itemService, project.ID, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(drafts) == 0 {
+		fmt.Println("No draft items to convert")
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Printf("Would convert %d drafts into issues in %s:\n", len(drafts), repository.NameWithOwner)
+		for i := range drafts {
+			fmt.Printf("  %s  %s\n", drafts[i].ItemID, drafts[i].Title)
+		}
+		return nil
+	}
+
+	failed := 0
+	for i := range drafts {
+		draft := &drafts[i]
+		if convertErr := convertDraft(ctx, itemService, issueService, draft, repository.ID, labelIDs, assigneeIDs); convertErr != nil {
+			failed++
+			fmt.Printf("❌ %s: %v\n", draft.Title, convertErr)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to convert %d of %d drafts", failed, len(drafts))
+	}
+
+	return nil
+}
+
+// selectDrafts returns the draft items given by ID or matching the filter
+func selectDrafts(ctx context.Context, itemService *service.ItemService, projectID string, opts *ConvertOptions) ([]service.ProjectItemInfo, error) {
+	items, err := itemService.ListProjectItems(ctx, projectID, strings.TrimSpace(opts.Filter+" is:draft"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	if len(opts.ItemIDs) == 0 {
+		return items, nil
+	}
+
+	drafts := make([]service.ProjectItemInfo, 0, len(opts.ItemIDs))
+	for _, itemID := range opts.ItemIDs {
+		draft, findErr := service.FindProjectItem(items, itemID)
+		if findErr != nil {
+			return nil, fmt.Errorf("draft item '%s' not found in project", itemID)
+		}
+		drafts = append(drafts, *draft)
+	}
+
+	return drafts, nil
+}
+
+// convertDraft converts a single draft and applies labels and assignees to the new issue
+func convertDraft(ctx context.Context, itemService *service.ItemService, issueService *service.IssueService,
+	draft *service.ProjectItemInfo, repositoryID string, labelIDs, assigneeIDs []string) error {
+	converted, err := itemService.ConvertDraftToIssue(ctx, draft.ItemID, repositoryID)
+	if err != nil {
+		return err
+	}
+
+	issue := service.ConvertProjectItem(converted)
+	fmt.Printf("✅ Converted '%s' into %s\n", draft.Title, formatIssueLink(&issue))
+
+	if changed := service.ChangedFieldValues(draft.FieldValues, issue.FieldValues); len(changed) > 0 {
+		fmt.Printf("⚠️  Field values changed during conversion: %s\n", strings.Join(changed, ", "))
+	}
+
+	if err := issueService.AddLabels(ctx, issue.ContentID, labelIDs); err != nil {
+		return err
+	}
+
+	return issueService.AddAssignees(ctx, issue.ContentID, assigneeIDs)
+}
+
+func formatIssueLink(item *service.ProjectItemInfo) string {
+	if item.URL != nil && *item.URL != "" {
+		return *item.URL
+	}
+	if item.Repository != nil && item.Number != nil {
+		return fmt.Sprintf("%s#%d", *item.Repository, *item.Number)
+	}
+	return item.ItemID
+}
@@ -0,0 +1,203 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// TransferOptions holds options for copying or moving items between projects
+type TransferOptions struct {
+	SourceRef   string
+	DestRef     string
+	Filter      string
+	MappingFile string
+	Items       []string
+	AllItems    bool
+	DryRun      bool
+	Move        bool
+}
+
+// NewCopyCmd creates the copy command
+func NewCopyCmd() *cobra.Command {
+	opts := &TransferOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy <src-project> <dst-project>",
+		Short: "Copy items to another project",
+		Long: `Copy items from one project to another.
+
+Issues and pull requests are added to the destination project and draft
+issues are recreated there. Field values are mapped by field name and option
+name. Use --mapping to translate fields or options that are named differently:
+
+  fields:
+    Status: Stage        # source field -> destination field
+  values:
+    Status:
+      In Review: Review  # source option -> destination option
+
+Map a field to an empty name to skip it. Use 'ghp item move' with two
+projects to remove the items from the source after they are copied.
+
+Examples:
+  ghp item copy myorg/1 myorg/2 --filter "status:Todo"
+  ghp item copy myorg/1 myorg/2 --item myorg/api#12 --item myorg/api#13
+  ghp item copy myorg/1 myorg/2 --filter "label:q3" --mapping q3-to-q4.yaml --dry-run`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SourceRef = args[0]
+			opts.DestRef = args[1]
+			opts.AllItems = cmd.Flags().Changed("filter")
+			return runTransfer(cmd.Context(), opts)
+		},
+	}
+
+	addTransferFlags(cmd, opts)
+
+	return cmd
+}
+
+func addTransferFlags(cmd *cobra.Command, opts *TransferOptions) {
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Select items using GitHub Projects filter syntax")
+	cmd.Flags().StringSliceVar(&opts.Items, "item", nil, "Item to transfer by item ID or issue reference (can be used multiple times)")
+	cmd.Flags().StringVar(&opts.MappingFile, "mapping", "", "YAML or JSON file mapping field and option names")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be transferred without making changes")
+}
+
+func runTransfer(ctx context.Context, opts *TransferOptions) error {
+	if len(opts.Items) == 0 && !opts.AllItems {
+		return fmt.Errorf("specify items with --item or --filter")
+	}
+
+	if len(opts.Items) > 0 && opts.Filter != "" {
+		return fmt.Errorf("--item and --filter cannot be used together")
+	}
+
+	var mapping *service.FieldMapping
+	if opts.MappingFile != "" {
+		var err error
+		mapping, err = service.LoadFieldMapping(opts.MappingFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	srcOwner, srcNumber, err := service.ParseProjectReference(opts.SourceRef)
+	if err != nil {
+		return fmt.Errorf("invalid source project reference: %w", err)
+	}
+
+	dstOwner, dstNumber, err := service.ParseProjectReference(opts.DestRef)
+	if err != nil {
+		return fmt.Errorf("invalid destination project reference: %w", err)
+	}
+
+	source, err := projectService.GetProjectWithOwnerDetection(ctx, srcOwner, srcNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get source project: %w", err)
+	}
+
+	dest, err := projectService.GetProjectWithOwnerDetection(ctx, dstOwner, dstNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get destination project: %w", err)
+	}
+
+	if source.ID == dest.ID {
+		return fmt.Errorf("source and destination projects must be different")
+	}
+
+	items, err := itemService.ListProjectItems(ctx, source.ID, opts.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	if len(opts.Items) > 0 {
+		items, err = selectProjectItems(items, opts.Items)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(items) == 0 {
+		fmt.Println("No items to transfer")
+		return nil
+	}
+
+	action := "copy"
+	if opts.Move {
+		action = "move"
+	}
+
+	if opts.DryRun {
+		return previewTransfer(items, dest.Fields.Nodes, mapping, action, dest.Title)
+	}
+
+	fmt.Printf("Transferring %d items from %s to %s...\n", len(items), source.Title, dest.Title)
+	result := itemService.TransferItems(ctx, source.ID, dest, items, mapping, opts.Move)
+
+	fmt.Printf("\n✅ Copied %d items to %s\n", result.Copied, dest.Title)
+	if opts.Move {
+		fmt.Printf("✅ Removed %d items from %s\n", result.Removed, source.Title)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Printf("⚠️  %s\n", warning)
+	}
+	for _, errMsg := range result.Errors {
+		fmt.Printf("  Error: %s\n", errMsg)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d items", action, result.Failed, len(items))
+	}
+	if result.NotRemoved > 0 {
+		return fmt.Errorf("%d items were copied but are still in %s", result.NotRemoved, source.Title)
+	}
+
+	return nil
+}
+
+// selectProjectItems returns the items matching the given references, in order
+func selectProjectItems(items []service.ProjectItemInfo, refs []string) ([]service.ProjectItemInfo, error) {
+	selected := make([]service.ProjectItemInfo, 0, len(refs))
+	for _, ref := range refs {
+		item, err := service.FindProjectItem(items, ref)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, *item)
+	}
+	return selected, nil
+}
+
+func previewTransfer(items []service.ProjectItemInfo, fields []graphql.ProjectV2Field, mapping *service.FieldMapping, action, destTitle string) error {
+	fmt.Printf("Would %s %d items to %s:\n", action, len(items), destTitle)
+	for i := range items {
+		item := &items[i]
+		fmt.Printf("  %s  %s\n", item.ItemID, item.Title)
+
+		_, warnings := service.MapFieldValues(item.FieldValues, fields, mapping)
+		for _, warning := range warnings {
+			fmt.Printf("    ⚠️  %s\n", warning)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/editor"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// CreateOptions holds options for the create command
+type CreateOptions struct {
+	In         io.Reader
+	ProjectRef string
+	Repo       string
+	Title      string
+	Body       string
+	BodyFile   string
+	Format     string
+	Labels     []string
+	Assignees  []string
+	Set        []string
+	Editor     bool
+}
+
+// createdItemJSON is the JSON representation of a created issue and its project item
+type createdItemJSON struct {
+	ItemID     string `json:"item_id"`
+	IssueID    string `json:"issue_id"`
+	URL        string `json:"url"`
+	Repository string `json:"repository"`
+	Title      string `json:"title"`
+	Number     int    `json:"number"`
+}
+
+// NewCreateCmd creates the create command
+func NewCreateCmd() *cobra.Command {
+	opts := &CreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <project>",
+		Short: "Create a repository issue in a project",
+		Long: `Create a new issue in a repository, add it to a project and set its field
+values in one step.
+
+Use 'ghp item add --draft' to create a draft issue that lives only in the project.
+
+With --editor, your editor opens a Markdown template whose front-matter holds
+the repository, title, labels, assignees and project field values, followed by
+the issue body. Values given as flags are filled in, and the project's fields
+and their options are listed as comments. Leave the title empty to cancel.
+
+The editor is taken from the "editor" config setting, $VISUAL or $EDITOR.
+
+The field values are checked against the project's field rules before the
+issue is created (see 'ghp field lint').
+
+Examples:
+  ghp item create myorg/2 --repo myorg/api --title "Fix login bug" --label bug
+  ghp item create myorg/2 --repo myorg/api --title "Rate limiting" --body-file spec.md \
+    --assignee octocat --set Status=Todo --set Priority=P2
+  ghp item create myorg/2 --repo myorg/api --editor`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.In = cmd.InOrStdin()
+			return runCreate(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository to create the issue in (owner/repo)")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Issue title")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Issue body")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read the issue body from a file (use \"-\" for standard input)")
+	cmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", nil, "Label to add (can be used multiple times)")
+	cmd.Flags().StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "User to assign (can be used multiple times)")
+	cmd.Flags().StringArrayVar(&opts.Set, "set", nil, "Field value as Name=Value (can be used multiple times)")
+	cmd.Flags().BoolVarP(&opts.Editor, "editor", "e", false, "Write the issue in your editor")
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func runCreate(ctx context.Context, opts *CreateOptions) error {
+	draft, err := buildIssueDraft(opts)
+	if err != nil {
+		return err
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	issueService := service.NewIssueService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if opts.Editor {
+		draft, err = editIssueDraft(draft, project.Fields.Nodes)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateIssueDraft(draft); err != nil {
+		return err
+	}
+
+	// Resolve field values before creating anything so typos fail early
+	fieldValues, err := service.ResolveFieldValues(project.Fields.Nodes, service.FormatFieldAssignments(draft.Fields))
+	if err != nil {
+		return err
+	}
+
+	rules, err := loadFieldRules(opts.ProjectRef, project.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+	content := &service.ItemInfo{
+		Type:       "Issue",
+		Title:      draft.Title,
+		State:      "OPEN",
+		Repository: &draft.Repository,
+		Labels:     draft.Labels,
+		Assignees:  draft.Assignees,
+	}
+	if violations := checkNewItemRules(rules, content, draft.Fields); len(violations) > 0 {
+		return ruleViolationError(violations)
+	}
+
+	issue, err := issueService.CreateIssue(ctx, draft)
+	if err != nil {
+		return err
+	}
+
+	item, err := itemService.AddItemToProject(ctx, project.ID, issue.ID)
+	if err != nil {
+		return fmt.Errorf("created issue %s but failed to add it to the project: %w", issue.URL, err)
+	}
+
+	if err := itemService.SetItemFieldValues(ctx, project.ID, item.ID, fieldValues); err != nil {
+		return fmt.Errorf("created issue %s but failed to set its field values: %w", issue.URL, err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(createdItemJSON{
+			ItemID:     item.ID,
+			IssueID:    issue.ID,
+			URL:        issue.URL,
+			Repository: draft.Repository,
+			Title:      issue.Title,
+			Number:     issue.Number,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("✅ Created %s#%d and added it to project %s\n", draft.Repository, issue.Number, project.Title)
+	fmt.Println(issue.URL)
+	return nil
+}
+
+// buildIssueDraft collects the issue described by the command-line flags
+func buildIssueDraft(opts *CreateOptions) (*service.IssueDraft, error) {
+	if opts.Body != "" && opts.BodyFile != "" {
+		return nil, fmt.Errorf("--body and --body-file cannot be used together")
+	}
+
+	if opts.Editor && opts.BodyFile == stdinItemRef {
+		return nil, fmt.Errorf("--editor cannot be used with a body read from standard input")
+	}
+
+	fields, err := service.ParseFieldAssignments(opts.Set)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &service.IssueDraft{
+		Repository: opts.Repo,
+		Title:      opts.Title,
+		Body:       opts.Body,
+		Labels:     opts.Labels,
+		Assignees:  opts.Assignees,
+		Fields:     fields,
+	}
+
+	if opts.BodyFile != "" {
+		body, err := readBodyFile(opts.BodyFile, opts.In)
+		if err != nil {
+			return nil, err
+		}
+		draft.Body = body
+	}
+
+	if !opts.Editor {
+		if err := validateIssueDraft(draft); err != nil {
+			return nil, err
+		}
+	}
+
+	return draft, nil
+}
+
+func validateIssueDraft(draft *service.IssueDraft) error {
+	if draft.Repository == "" {
+		return fmt.Errorf("repository is required (use --repo owner/repo)")
+	}
+	if parts := strings.Split(draft.Repository, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid repository format: %s (expected owner/repo)", draft.Repository)
+	}
+	if strings.TrimSpace(draft.Title) == "" {
+		return fmt.Errorf("title is required (use --title or --editor)")
+	}
+	return nil
+}
+
+func readBodyFile(path string, in io.Reader) (string, error) {
+	if path == stdinItemRef {
+		data, err := io.ReadAll(in)
+		if err != nil {
+			return "", fmt.Errorf("failed to read body from standard input: %w", err)
+		}
+		return string(data), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read body file: %w", err)
+	}
+	return string(data), nil
+}
+
+// editIssueDraft opens the draft in the user's editor and returns the edited draft
+func editIssueDraft(draft *service.IssueDraft, fields []graphql.ProjectV2Field) (*service.IssueDraft, error) {
+	template, err := service.RenderIssueTemplate(draft, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := editor.New().Edit(template, "ghp-issue-*.md")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := service.ParseIssueTemplate(edited)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Title == "" {
+		return nil, fmt.Errorf("aborting: the issue has no title")
+	}
+
+	return result, nil
+}
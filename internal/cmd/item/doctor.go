@@ -0,0 +1,267 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const defaultTitleSimilarity = 0.85
+
+// DoctorOptions holds options for the doctor command
+type DoctorOptions struct {
+	ProjectRef  string
+	StatusField string
+	DoneStatus  string
+	Format      string
+	Similarity  float64
+	Fix         bool
+	Force       bool
+}
+
+// doctorFindingJSON is the JSON representation of a doctor finding
+type doctorFindingJSON struct {
+	Kind    string   `json:"kind"`
+	Message string   `json:"message"`
+	Fix     string   `json:"fix,omitempty"`
+	Items   []string `json:"items"`
+}
+
+// NewDoctorCmd creates the doctor command
+func NewDoctorCmd() *cobra.Command {
+	opts := &DoctorOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "doctor <project>",
+		Short: "Find duplicate, orphaned and inconsistent items in a project",
+		Long: `Check a project for common problems:
+
+• inaccessible      Content was deleted, transferred or cannot be read (fix: archive)
+• draft-duplicate   A draft duplicates a real issue (fix: merge - copy the draft's
+                    field values onto the issue where unset, then archive the draft)
+• duplicate-title   Several items have similar titles (report only)
+• pr-issue-pair     A pull request is tracked alongside the issue it closes
+                    (fix: archive the pull request item)
+• closed-not-done   A closed issue or merged pull request is not marked done
+                    (fix: status-sync - set the status field to the done value)
+
+Titles are compared ignoring case and punctuation; --similarity sets how close
+two titles must be (1.0 means identical).
+
+With --fix, each fix is offered for confirmation; add --force to apply all of
+them without prompting. Archived items can be restored with 'ghp item unarchive'.
+
+Examples:
+  ghp item doctor myorg/2
+  ghp item doctor myorg/2 --field Stage --done Shipped
+  ghp item doctor myorg/2 --similarity 0.95 --format json
+  ghp item doctor myorg/2 --fix`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runDoctor(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.StatusField, "field", defaultStatusField, "Status field checked for closed items")
+	cmd.Flags().StringVar(&opts.DoneStatus, "done", "Done", "Status value that marks items as done")
+	cmd.Flags().Float64Var(&opts.Similarity, "similarity", defaultTitleSimilarity, "Minimum title similarity (0-1) for duplicates")
+	cmd.Flags().BoolVar(&opts.Fix, "fix", false, "Offer to fix the problems found")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Apply all fixes without prompting, requires --fix")
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func runDoctor(ctx context.Context, opts *DoctorOptions) error {
+	if opts.Similarity <= 0 || opts.Similarity > 1 {
+		return fmt.Errorf("--similarity must be between 0 and 1")
+	}
+
+	if opts.Force && !opts.Fix {
+		return fmt.Errorf("--force requires --fix")
+	}
+
+	if opts.Fix && opts.Format == formatJSON {
+		return fmt.Errorf("--fix cannot be used with --format json")
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	doctorOpts := service.DoctorOptions{
+		StatusField: opts.StatusField,
+		DoneStatus:  opts.DoneStatus,
+		Similarity:  opts.Similarity,
+	}
+
+	findings := service.DiagnoseProjectItems(items, doctorOpts)
+	service.SortDoctorFindings(findings)
+
+	switch opts.Format {
+	case formatJSON:
+		return outputDoctorJSON(findings)
+	case formatTable:
+		outputDoctorTable(findings, len(items))
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	if !opts.Fix {
+		return nil
+	}
+
+	return applyDoctorFixes(ctx, itemService, project, findings, doctorOpts, opts.Force)
+}
+
+func applyDoctorFixes(ctx context.Context, itemService *service.ItemService, project *graphql.ProjectV2,
+	findings []service.DoctorFinding, doctorOpts service.DoctorOptions, force bool) error {
+	fixed, failed := 0, 0
+
+	for i := range findings {
+		finding := &findings[i]
+		if finding.Fix == "" {
+			continue
+		}
+
+		description := describeDoctorFix(finding, doctorOpts)
+		if !force && !confirmDoctorFix(description) {
+			continue
+		}
+
+		if err := itemService.ApplyDoctorFix(ctx, project, finding, doctorOpts); err != nil {
+			fmt.Printf("❌ Failed to %s: %v\n", description, err)
+			failed++
+			continue
+		}
+
+		fmt.Printf("✅ %s\n", capitalize(description))
+		fixed++
+	}
+
+	fmt.Printf("\nFixed %d problems\n", fixed)
+	if failed > 0 {
+		return fmt.Errorf("failed to fix %d problems", failed)
+	}
+	return nil
+}
+
+func describeDoctorFix(finding *service.DoctorFinding, doctorOpts service.DoctorOptions) string {
+	target := projectItemLabel(&finding.Items[0])
+
+	switch finding.Fix {
+	case service.DoctorFixArchive:
+		return fmt.Sprintf("archive %s", target)
+	case service.DoctorFixMerge:
+		return fmt.Sprintf("merge %s into %s", target, projectItemLabel(&finding.Items[1]))
+	case service.DoctorFixStatusSync:
+		return fmt.Sprintf("set %s of %s to %s", doctorOpts.StatusField, target, doctorOpts.DoneStatus)
+	default:
+		return finding.Fix
+	}
+}
+
+func confirmDoctorFix(description string) bool {
+	fmt.Printf("%s? [y/N]: ", capitalize(description))
+
+	var answer string
+	if _, err := fmt.Scanln(&answer); err != nil {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func outputDoctorTable(findings []service.DoctorFinding, itemCount int) {
+	if len(findings) == 0 {
+		fmt.Printf("✅ No problems found in %d items\n", itemCount)
+		return
+	}
+
+	for i := range findings {
+		finding := &findings[i]
+		fmt.Printf("⚠️  [%s] %s\n", finding.Kind, finding.Message)
+		for j := range finding.Items {
+			item := &finding.Items[j]
+			fmt.Printf("    %s  %s\n", item.ItemID, projectItemLabel(item))
+		}
+		if finding.Fix != "" {
+			fmt.Printf("    fix: %s\n", finding.Fix)
+		}
+	}
+
+	fmt.Printf("\n%d problems found in %d items\n", len(findings), itemCount)
+}
+
+func outputDoctorJSON(findings []service.DoctorFinding) error {
+	output := make([]doctorFindingJSON, len(findings))
+	for i := range findings {
+		finding := &findings[i]
+		itemIDs := make([]string, len(finding.Items))
+		for j := range finding.Items {
+			itemIDs[j] = finding.Items[j].ItemID
+		}
+		output[i] = doctorFindingJSON{
+			Kind:    finding.Kind,
+			Message: finding.Message,
+			Fix:     finding.Fix,
+			Items:   itemIDs,
+		}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
+
+func projectItemLabel(item *service.ProjectItemInfo) string {
+	if item.Repository != nil && item.Number != nil {
+		return fmt.Sprintf("%s#%d %s", *item.Repository, *item.Number, item.Title)
+	}
+	return fmt.Sprintf("'%s'", item.Title)
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -39,6 +40,8 @@ Field values can be:
 • Option names for single-select fields
 • Dates in YYYY-MM-DD format for date fields
 
+Changes that break the project's field rules are refused (see 'ghp field lint').
+
 Examples:
   ghp item edit octocat/1 PVTI_123 --field "Status" --value "In Progress"
   ghp item edit myorg/2 item-456 --field "Priority" --value "High"
@@ -98,6 +101,26 @@ func runEdit(ctx context.Context, opts *EditOptions) error {
 		return fmt.Errorf("field '%s' not found in project. Available fields", opts.FieldName)
 	}
 
+	// Check the change against the project's field rules before updating
+	rules, err := loadFieldRules(opts.ProjectRef, project.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+	if len(rules) > 0 {
+		items, listErr := projectItemsByID(ctx, projectService, project.ID)
+		if listErr != nil {
+			return listErr
+		}
+		current, ok := items[opts.ItemID]
+		if !ok {
+			return fmt.Errorf("item %s not found in project", opts.ItemID)
+		}
+		changes := map[string]string{opts.FieldName: opts.Value}
+		if violations := service.CheckFieldChange(rules, current, changes, time.Now()); len(violations) > 0 {
+			return ruleViolationError(violations)
+		}
+	}
+
 	// Prepare field value based on field type
 	var fieldValue interface{}
 	// For now, we'll treat all values as strings
@@ -0,0 +1,163 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const (
+	historyTableWidth  = 90
+	maxActorLength     = 15
+	actorTruncateLimit = 12
+)
+
+// HistoryOptions holds options for the history command
+type HistoryOptions struct {
+	ProjectRef string
+	ItemRef    string
+	Format     string
+}
+
+// NewHistoryCmd creates the history command
+func NewHistoryCmd() *cobra.Command {
+	opts := &HistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history <project> <item>",
+		Short: "Show the change history of a project item",
+		Long: `Show a chronological audit of a project item's history.
+
+The history is built from the issue or pull request timeline and includes
+when the item was added to or removed from the project, Status changes,
+conversion from a draft, and close, reopen and merge events, each with the
+actor and timestamp.
+
+GitHub only records Status changes in the timeline; changes to other fields
+are not available. Archived items show when they were last updated, since
+the archive time itself is not recorded.
+
+The item can be a project item ID, an owner/repo#number reference or a URL.
+
+Examples:
+  ghp item history myorg/2 myorg/api#12
+  ghp item history myorg/2 PVTI_lADOANN5s84ACbL0zgBZrOY --format json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemRef = args[1]
+			return runHistory(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func runHistory(ctx context.Context, opts *HistoryOptions) error {
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	item, err := findProjectItemIncludingArchived(ctx, itemService, project.ID, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+
+	events, err := itemService.GetItemHistory(ctx, project.ID, item)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		return outputHistoryJSON(events)
+	case formatTable:
+		outputHistoryTable(item, events)
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+// findProjectItemIncludingArchived looks an item up among active items first, then archived ones
+func findProjectItemIncludingArchived(ctx context.Context, itemService *service.ItemService, projectID, ref string) (*service.ProjectItemInfo, error) {
+	for _, filter := range []string{"", "is:archived"} {
+		items, err := itemService.ListProjectItems(ctx, projectID, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list project items: %w", err)
+		}
+
+		if item, findErr := service.FindProjectItem(items, ref); findErr == nil {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("item %s not found in project", ref)
+}
+
+func outputHistoryTable(item *service.ProjectItemInfo, events []service.HistoryEvent) {
+	fmt.Printf("History of %s\n\n", item.Title)
+
+	if len(events) == 0 {
+		fmt.Println("No history found")
+		return
+	}
+
+	fmt.Printf("%-17s %-15s %-10s %s\n", "TIME", "ACTOR", "EVENT", "CHANGE")
+	fmt.Println(strings.Repeat("-", historyTableWidth))
+
+	for i := range events {
+		event := &events[i]
+		actor := event.Actor
+		if actor == "" {
+			actor = "-"
+		}
+		fmt.Printf("%-17s %-15s %-10s %s\n",
+			event.Time.Local().Format("2006-01-02 15:04"),
+			truncateString(actor, maxActorLength, actorTruncateLimit),
+			event.Kind,
+			service.FormatHistoryEvent(event))
+	}
+}
+
+func outputHistoryJSON(events []service.HistoryEvent) error {
+	if events == nil {
+		events = []service.HistoryEvent{}
+	}
+
+	data, err := json.MarshalIndent(events, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,140 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// IssueEditOptions holds the item selection shared by the label, assign, milestone, close and reopen commands
+type IssueEditOptions struct {
+	Edit       service.IssueEdit
+	ProjectRef string
+	Filter     string
+	Refs       []string
+	DryRun     bool
+}
+
+func addIssueEditFlags(cmd *cobra.Command, opts *IssueEditOptions) {
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Select items from a project (owner/number)")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Select project items using GitHub Projects filter syntax, requires --project")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show which items would be affected without making changes")
+}
+
+func validateIssueEditOptions(opts *IssueEditOptions) error {
+	if opts.Filter != "" && opts.ProjectRef == "" {
+		return fmt.Errorf("--filter requires --project")
+	}
+
+	if len(opts.Refs) == 0 && opts.Filter == "" {
+		return fmt.Errorf("specify items or --project with --filter")
+	}
+
+	if opts.Edit.IsEmpty() {
+		return fmt.Errorf("no changes specified")
+	}
+
+	return nil
+}
+
+// runIssueEdit resolves the selected items and applies a repository-level edit to each of them
+func runIssueEdit(ctx context.Context, opts *IssueEditOptions, action string) error {
+	if err := validateIssueEditOptions(opts); err != nil {
+		return err
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+	issueService := service.NewIssueService(client)
+
+	targets, err := resolveIssueTargets(ctx, itemService, projectService, opts)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) == 0 {
+		fmt.Println("No issues or pull requests selected")
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Printf("Would %s %d items:\n", action, len(targets))
+		for _, target := range targets {
+			fmt.Printf("  %s\n", target.Reference)
+		}
+		return nil
+	}
+
+	result := issueService.ApplyEdits(ctx, targets, &opts.Edit)
+
+	if result.Processed > 0 {
+		fmt.Printf("✅ Updated %d items\n", result.Processed)
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to %s %d items\n", action, result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		return fmt.Errorf("failed to %s %d of %d items", action, result.Failed, len(targets))
+	}
+
+	return nil
+}
+
+// resolveIssueTargets turns item references or a project selection into edit targets
+func resolveIssueTargets(ctx context.Context, itemService *service.ItemService, projectService *service.ProjectService, opts *IssueEditOptions) ([]service.IssueTarget, error) {
+	if opts.ProjectRef == "" {
+		targets := make([]service.IssueTarget, 0, len(opts.Refs))
+		for _, ref := range opts.Refs {
+			info, err := itemService.ResolveItemReference(ctx, ref)
+			if err != nil {
+				return nil, err
+			}
+			targets = append(targets, service.IssueTargetFromItem(info))
+		}
+		return targets, nil
+	}
+
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, opts.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	if len(opts.Refs) > 0 {
+		items, err = selectProjectItems(items, opts.Refs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets, drafts := service.IssueTargetsFromProjectItems(items)
+	for _, title := range drafts {
+		fmt.Printf("⚠️  Skipping draft issue '%s'\n", title)
+	}
+
+	return targets, nil
+}
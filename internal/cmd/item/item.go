@@ -18,16 +18,28 @@ This command group provides comprehensive item management capabilities:
 
 • Add existing issues and pull requests to projects
 • Create draft issues directly in projects
+• Create repository issues in projects with field values
+• Convert draft issues into repository issues
 • List and search items across repositories
-• View detailed item information
+• View detailed item information and change history
+• Archive and unarchive items for reversible board cleanup
 • Remove items from projects
 • Update item field values
+• Edit labels, assignees, milestone and state of issues and pull requests
+• Move and reorder items within a project
+• Copy or move items between projects
+• Find duplicate, orphaned and inconsistent items
+• Sweep stale items with configurable policies
+• Sync item status with issue and pull request state
+• Browse and edit sub-issue hierarchies
 
 For more information about GitHub Projects, visit:
 https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 		Example: `  ghp item list octocat/Hello-World               # List items from repository
   ghp item add octocat/1 octocat/Hello-World#123  # Add issue to project
   ghp item view octocat/Hello-World#456           # View item details
+  ghp item archive myorg/2 --filter status:Done   # Archive finished items
+  ghp item label octocat/Hello-World#1 --add bug  # Add a label to an issue
   ghp item remove myorg/2 item-id --force         # Remove item from project
   ghp item add octocat/1 --draft --title "Task"   # Create draft issue`,
 	}
@@ -35,9 +47,28 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 	// Add subcommands
 	cmd.AddCommand(NewAddCmd())
 	cmd.AddCommand(NewAddBulkCmd())
+	cmd.AddCommand(NewArchiveCmd())
+	cmd.AddCommand(NewAssignCmd())
+	cmd.AddCommand(NewCloseCmd())
+	cmd.AddCommand(NewConvertCmd())
+	cmd.AddCommand(NewCopyCmd())
+	cmd.AddCommand(NewCreateCmd())
+	cmd.AddCommand(NewDoctorCmd())
 	cmd.AddCommand(NewEditCmd())
+	cmd.AddCommand(NewHistoryCmd())
+	cmd.AddCommand(NewLabelCmd())
+	cmd.AddCommand(NewLinkParentCmd())
 	cmd.AddCommand(NewListCmd())
+	cmd.AddCommand(NewMilestoneCmd())
+	cmd.AddCommand(NewMoveCmd())
 	cmd.AddCommand(NewRemoveCmd())
+	cmd.AddCommand(NewReopenCmd())
+	cmd.AddCommand(NewReorderCmd())
+	cmd.AddCommand(NewSweepCmd())
+	cmd.AddCommand(NewSyncStatusCmd())
+	cmd.AddCommand(NewTreeCmd())
+	cmd.AddCommand(NewUnarchiveCmd())
+	cmd.AddCommand(NewUnlinkParentCmd())
 	cmd.AddCommand(NewUpdateBulkCmd())
 	cmd.AddCommand(NewViewCmd())
 
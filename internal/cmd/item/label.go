@@ -0,0 +1,37 @@
+package item
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewLabelCmd creates the label command
+func NewLabelCmd() *cobra.Command {
+	opts := &IssueEditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "label [item...]",
+		Short: "Add or remove labels on issues and pull requests",
+		Long: `Add or remove repository labels on issues and pull requests.
+
+Labels belong to the repository, not the project, so they cannot be set
+through project field updates. Items can be given as owner/repo#number
+references or URLs, or selected from a project with --project and --filter.
+When --project is given, items may also be project item IDs. Draft issues
+are skipped.
+
+Examples:
+  ghp item label octocat/api#12 --add bug --remove triage
+  ghp item label --project myorg/2 --filter status:Done --add shipped
+  ghp item label --project myorg/2 --filter label:wontfix --remove wontfix --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Refs = args
+			return runIssueEdit(cmd.Context(), opts, "label")
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.Edit.AddLabels, "add", nil, "Label to add (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&opts.Edit.RemoveLabels, "remove", nil, "Label to remove (can be used multiple times)")
+	addIssueEditFlags(cmd, opts)
+
+	return cmd
+}
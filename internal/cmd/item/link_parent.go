@@ -0,0 +1,166 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// LinkParentOptions holds options for the link-parent and unlink-parent commands
+type LinkParentOptions struct {
+	ItemRef   string
+	ParentRef string
+	Replace   bool
+}
+
+// NewLinkParentCmd creates the link-parent command
+func NewLinkParentCmd() *cobra.Command {
+	opts := &LinkParentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "link-parent <issue> <parent-issue>",
+		Short: "Make an issue a sub-issue of a parent issue",
+		Long: `Make an issue a sub-issue of a parent issue.
+
+Both issues are given as owner/repo#number references or URLs. An issue can
+only have one parent; use --replace to move it from its current parent.
+
+Examples:
+  ghp item link-parent myorg/api#34 myorg/api#12
+  ghp item link-parent myorg/web#7 myorg/api#12 --replace`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ItemRef = args[0]
+			opts.ParentRef = args[1]
+			return runLinkParent(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Replace, "replace", false, "Replace the issue's current parent")
+
+	return cmd
+}
+
+// NewUnlinkParentCmd creates the unlink-parent command
+func NewUnlinkParentCmd() *cobra.Command {
+	opts := &LinkParentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unlink-parent <issue> [parent-issue]",
+		Short: "Remove an issue from its parent issue",
+		Long: `Remove an issue from its parent issue.
+
+The parent is looked up automatically when it is not given.
+
+Examples:
+  ghp item unlink-parent myorg/api#34
+  ghp item unlink-parent myorg/api#34 myorg/api#12`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ItemRef = args[0]
+			if len(args) > 1 {
+				opts.ParentRef = args[1]
+			}
+			return runUnlinkParent(cmd.Context(), opts)
+		},
+	}
+
+	return cmd
+}
+
+func runLinkParent(ctx context.Context, opts *LinkParentOptions) error {
+	itemService, issueService, err := newSubIssueServices()
+	if err != nil {
+		return err
+	}
+
+	child, err := resolveIssue(ctx, itemService, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+
+	parent, err := resolveIssue(ctx, itemService, opts.ParentRef)
+	if err != nil {
+		return err
+	}
+
+	if err := issueService.AddSubIssue(ctx, parent.ID, child.ID, opts.Replace); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %s is now a sub-issue of %s\n", opts.ItemRef, opts.ParentRef)
+	return nil
+}
+
+func runUnlinkParent(ctx context.Context, opts *LinkParentOptions) error {
+	itemService, issueService, err := newSubIssueServices()
+	if err != nil {
+		return err
+	}
+
+	child, err := resolveIssue(ctx, itemService, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+
+	parentID := ""
+	parentRef := opts.ParentRef
+	if parentRef != "" {
+		parent, resolveErr := resolveIssue(ctx, itemService, parentRef)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		parentID = parent.ID
+	} else {
+		hierarchy, hierarchyErr := issueService.GetIssueHierarchy(ctx, child.ID)
+		if hierarchyErr != nil {
+			return hierarchyErr
+		}
+
+		parent := hierarchy.Node.Issue.Parent
+		if parent == nil {
+			return fmt.Errorf("%s has no parent issue", opts.ItemRef)
+		}
+		parentID = parent.ID
+		parentRef = fmt.Sprintf("%s#%d", parent.Repository.NameWithOwner, parent.Number)
+	}
+
+	if err := issueService.RemoveSubIssue(ctx, parentID, child.ID); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ %s is no longer a sub-issue of %s\n", opts.ItemRef, parentRef)
+	return nil
+}
+
+func newSubIssueServices() (*service.ItemService, *service.IssueService, error) {
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return nil, nil, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	return service.NewItemService(client), service.NewIssueService(client), nil
+}
+
+// resolveIssue resolves a reference that must point to an issue
+func resolveIssue(ctx context.Context, itemService *service.ItemService, ref string) (*service.ItemInfo, error) {
+	info, err := itemService.ResolveItemReference(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Type != "Issue" {
+		return nil, fmt.Errorf("%s is not an issue", ref)
+	}
+
+	return info, nil
+}
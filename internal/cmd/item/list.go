@@ -2,6 +2,7 @@ package item
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -30,9 +31,25 @@ const (
 	dateOnlyLength            = 10
 )
 
+// itemJSON is the JSON representation of an issue or pull request
+type itemJSON struct {
+	Number     *int    `json:"number,omitempty"`
+	URL        *string `json:"url,omitempty"`
+	Repository *string `json:"repository,omitempty"`
+	Author     *string `json:"author,omitempty"`
+	ID         string  `json:"id"`
+	Type       string  `json:"type"`
+	Title      string  `json:"title"`
+	State      string  `json:"state"`
+	UpdatedAt  string  `json:"updated_at"`
+}
+
 // ListOptions holds options for the list command
 type ListOptions struct {
 	Repository string
+	Project    string
+	Filter     string
+	Parent     string
 	Search     string
 	Type       string
 	State      string
@@ -41,6 +58,7 @@ type ListOptions struct {
 	Format     string
 	Labels     []string
 	Limit      int
+	Archived   bool
 }
 
 // NewListCmd creates the list command
@@ -55,12 +73,20 @@ func NewListCmd() *cobra.Command {
 You can list items from a specific repository or search across all of GitHub
 using various filters.
 
+With --project, the items of a project are listed instead. Project items can
+be narrowed with --filter using GitHub Projects filter syntax, archived
+items can be browsed with --archived, and --parent lists the sub-issues of
+a parent issue.
+
 Examples:
   ghp item list octocat/Hello-World                    # List items from repository
   ghp item list octocat/Hello-World --type issue       # List only issues
   ghp item list --search "is:issue is:open bug"       # Search across GitHub
   ghp item list --author octocat --state open          # Find items by author
-  ghp item list --assignee @me --type pr               # Find PRs assigned to you`,
+  ghp item list --assignee @me --type pr               # Find PRs assigned to you
+  ghp item list --project octocat/1 --filter status:Done  # List project items
+  ghp item list --project octocat/1 --archived         # Browse archived items
+  ghp item list --project octocat/1 --parent octocat/api#12  # List sub-issues of an epic`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -70,6 +96,10 @@ Examples:
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Project, "project", "", "List items of a project (owner/number)")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Filter project items using GitHub Projects filter syntax, requires --project")
+	cmd.Flags().BoolVar(&opts.Archived, "archived", false, "List archived project items, requires --project")
+	cmd.Flags().StringVar(&opts.Parent, "parent", "", "List project items whose parent issue is owner/repo#number, requires --project")
 	cmd.Flags().StringVar(&opts.Search, "search", "", "Search query (GitHub search syntax)")
 	cmd.Flags().StringVar(&opts.Type, "type", "", "Item type: issue, pr, pullrequest")
 	cmd.Flags().StringVar(&opts.State, "state", "", "Item state: open, closed, merged")
@@ -83,6 +113,14 @@ Examples:
 }
 
 func runList(ctx context.Context, opts *ListOptions) error {
+	if (opts.Archived || opts.Filter != "" || opts.Parent != "") && opts.Project == "" {
+		return fmt.Errorf("--archived, --filter and --parent require --project")
+	}
+
+	if opts.Project != "" && opts.Repository != "" {
+		return fmt.Errorf("repository argument and --project cannot be used together")
+	}
+
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
 	token, err := authManager.GetValidatedToken()
@@ -94,6 +132,10 @@ func runList(ctx context.Context, opts *ListOptions) error {
 	client := api.NewClient(token)
 	itemService := service.NewItemService(client)
 
+	if opts.Project != "" {
+		return listProjectItems(ctx, client, itemService, opts)
+	}
+
 	var items []service.ItemInfo
 
 	if opts.Repository != "" {
@@ -333,32 +375,27 @@ func formatItemDate(updated string) string {
 }
 
 func outputItemsJSON(items []service.ItemInfo) error {
-	// Simplified JSON output
-	fmt.Println("[")
+	output := make([]itemJSON, len(items))
 	for i := range items {
 		item := &items[i]
-		fmt.Printf("  {\n")
-		fmt.Printf("    \"type\": \"%s\",\n", item.Type)
-		fmt.Printf("    \"title\": \"%s\",\n", item.Title)
-		if item.Number != nil {
-			fmt.Printf("    \"number\": %d,\n", *item.Number)
-		}
-		fmt.Printf("    \"state\": \"%s\",\n", item.State)
-		if item.Repository != nil {
-			fmt.Printf("    \"repository\": \"%s\",\n", *item.Repository)
-		}
-		if item.Author != nil {
-			fmt.Printf("    \"author\": \"%s\",\n", *item.Author)
+		output[i] = itemJSON{
+			ID:         item.ID,
+			Type:       item.Type,
+			Title:      item.Title,
+			Number:     item.Number,
+			URL:        item.URL,
+			State:      item.State,
+			Repository: item.Repository,
+			Author:     item.Author,
+			UpdatedAt:  item.UpdatedAt,
 		}
-		fmt.Printf("    \"updated_at\": \"%s\"\n", item.UpdatedAt)
+	}
 
-		if i < len(items)-1 {
-			fmt.Printf("  },\n")
-		} else {
-			fmt.Printf("  }\n")
-		}
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
-	fmt.Println("]")
 
+	fmt.Println(string(data))
 	return nil
 }
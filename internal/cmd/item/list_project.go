@@ -0,0 +1,156 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const (
+	projectItemIDLength       = 30
+	maxFieldSummaryLength     = 30
+	fieldSummaryTruncateLimit = 27
+)
+
+// projectItemJSON is the JSON representation of a project item
+type projectItemJSON struct {
+	Number      *int              `json:"number,omitempty"`
+	URL         *string           `json:"url,omitempty"`
+	Repository  *string           `json:"repository,omitempty"`
+	Parent      *string           `json:"parent,omitempty"`
+	FieldValues map[string]string `json:"fieldValues"`
+	ID          string            `json:"id"`
+	ContentID   string            `json:"contentId,omitempty"`
+	Type        string            `json:"type"`
+	Title       string            `json:"title"`
+	State       string            `json:"state"`
+	UpdatedAt   string            `json:"updatedAt"`
+	Labels      []string          `json:"labels"`
+	Assignees   []string          `json:"assignees"`
+	Archived    bool              `json:"archived"`
+}
+
+// listProjectItems lists the items of a project, applying --filter, --archived and --parent
+func listProjectItems(ctx context.Context, client *api.Client, itemService *service.ItemService, opts *ListOptions) error {
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.Project)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	projectService := service.NewProjectService(client)
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	filter := opts.Filter
+	if opts.Archived {
+		filter += " is:archived"
+	}
+	if opts.Parent != "" {
+		if _, _, _, err := service.ParseItemReference(opts.Parent); err != nil {
+			return fmt.Errorf("invalid parent reference: %w", err)
+		}
+		filter += fmt.Sprintf(" parent:%q", opts.Parent)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, filter)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	if opts.Limit > 0 && len(items) > opts.Limit {
+		items = items[:opts.Limit]
+	}
+
+	return outputProjectItems(items, opts.Format)
+}
+
+func outputProjectItems(items []service.ProjectItemInfo, format string) error {
+	switch format {
+	case formatJSON:
+		return outputProjectItemsJSON(items)
+	case formatTable:
+		if len(items) == 0 {
+			fmt.Println("No items found")
+			return nil
+		}
+		return outputProjectItemsTable(items)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func outputProjectItemsTable(items []service.ProjectItemInfo) error {
+	fmt.Printf("%-30s %-10s %-8s %-6s %-30s %-30s\n",
+		"ID", "TYPE", "STATE", "NUMBER", "TITLE", "FIELDS")
+	fmt.Println(strings.Repeat("-", tableHeaderSeparatorWidth+projectItemIDLength))
+
+	for i := range items {
+		item := &items[i]
+		fmt.Printf("%-30s %-10s %-8s %-6s %-30s %-30s\n",
+			item.ItemID,
+			truncateString(item.Type, maxItemTypeLength, itemTypeTruncateLength),
+			truncateString(item.State, maxStateLength, stateTruncateLength),
+			formatItemNumber(item.Number),
+			truncateString(item.Title, maxTitleLength, listTitleTruncateLength),
+			truncateString(formatFieldSummary(item.FieldValues), maxFieldSummaryLength, fieldSummaryTruncateLimit))
+	}
+
+	fmt.Printf("\n%d items\n", len(items))
+	return nil
+}
+
+// formatFieldSummary renders field values as "Name: Value" pairs in a stable order
+func formatFieldSummary(values map[string]string) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if name == "Title" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s: %s", name, values[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+func outputProjectItemsJSON(items []service.ProjectItemInfo) error {
+	output := make([]projectItemJSON, len(items))
+	for i := range items {
+		item := &items[i]
+		output[i] = projectItemJSON{
+			ID:          item.ItemID,
+			ContentID:   item.ContentID,
+			Type:        item.Type,
+			Title:       item.Title,
+			State:       item.State,
+			Number:      item.Number,
+			URL:         item.URL,
+			Repository:  item.Repository,
+			Parent:      item.Parent,
+			Labels:      item.Labels,
+			Assignees:   item.Assignees,
+			FieldValues: item.FieldValues,
+			UpdatedAt:   item.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+			Archived:    item.Archived,
+		}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
@@ -0,0 +1,45 @@
+package item
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// NewMilestoneCmd creates the milestone command
+func NewMilestoneCmd() *cobra.Command {
+	opts := &IssueEditOptions{}
+	var milestone string
+	var clearMilestone bool
+
+	cmd := &cobra.Command{
+		Use:   "milestone [item...]",
+		Short: "Set or clear the milestone of issues and pull requests",
+		Long: `Set or clear the repository milestone of issues and pull requests.
+
+The milestone is given by title or number and must be open in each item's
+repository. Items can be given as owner/repo#number references or URLs, or
+selected from a project with --project and --filter. Draft issues are skipped.
+
+Examples:
+  ghp item milestone octocat/api#12 --set v1.2
+  ghp item milestone --project myorg/2 --filter "status:Ready repo:myorg/api" --set "Sprint 14"
+  ghp item milestone --project myorg/2 --filter is:closed --clear`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Refs = args
+			if milestone != "" && clearMilestone {
+				return fmt.Errorf("--set and --clear cannot be used together")
+			}
+			if milestone != "" || clearMilestone {
+				opts.Edit.Milestone = &milestone
+			}
+			return runIssueEdit(cmd.Context(), opts, "update milestone of")
+		},
+	}
+
+	cmd.Flags().StringVar(&milestone, "set", "", "Milestone title or number to set")
+	cmd.Flags().BoolVar(&clearMilestone, "clear", false, "Remove the milestone")
+	addIssueEditFlags(cmd, opts)
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// MoveOptions holds options for the move command
+type MoveOptions struct {
+	ProjectRef string
+	ItemRef    string
+	After      string
+	Top        bool
+	Bottom     bool
+}
+
+// NewMoveCmd creates the move command
+func NewMoveCmd() *cobra.Command {
+	opts := &MoveOptions{}
+	transferOpts := &TransferOptions{Move: true}
+
+	cmd := &cobra.Command{
+		Use:   "move <project> <item> | <src-project> <dst-project>",
+		Short: "Move an item within a project or items between projects",
+		Long: `Move an item to a new position in a project, or move items to another project.
+
+To reposition an item, reference it by project item ID or by its issue or pull
+request (owner/repo#123 or a GitHub URL) and give exactly one of --after, --top
+or --bottom.
+
+When two projects are given, the selected items are copied to the destination
+project like 'ghp item copy' and removed from the source project only after
+they were copied with all of their field values.
+
+Examples:
+  ghp item move octocat/1 octocat/api#12 --top                      # Move to the top
+  ghp item move octocat/1 octocat/api#12 --bottom                   # Move to the bottom
+  ghp item move octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY --after octocat/api#7
+  ghp item move myorg/1 myorg/2 --filter "status:Todo"              # Move items to another project
+  ghp item move myorg/1 myorg/2 --filter "label:q3" --mapping q3-to-q4.yaml`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.Top && !opts.Bottom && opts.After == "" {
+				if !isProjectReference(args[1]) {
+					return fmt.Errorf("one of --after, --top or --bottom is required")
+				}
+				transferOpts.SourceRef = args[0]
+				transferOpts.DestRef = args[1]
+				transferOpts.AllItems = cmd.Flags().Changed("filter")
+				return runTransfer(cmd.Context(), transferOpts)
+			}
+
+			opts.ProjectRef = args[0]
+			opts.ItemRef = args[1]
+			return runMove(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.After, "after", "", "Place the item directly after this item")
+	cmd.Flags().BoolVar(&opts.Top, "top", false, "Move the item to the top of the project")
+	cmd.Flags().BoolVar(&opts.Bottom, "bottom", false, "Move the item to the bottom of the project")
+	cmd.MarkFlagsMutuallyExclusive("after", "top", "bottom")
+
+	addTransferFlags(cmd, transferOpts)
+
+	return cmd
+}
+
+// isProjectReference reports whether an argument is a project reference (owner/number)
+// rather than an item ID, issue reference or URL
+func isProjectReference(ref string) bool {
+	if strings.Contains(ref, "://") {
+		return false
+	}
+	_, _, err := service.ParseProjectReference(ref)
+	return err == nil
+}
+
+func runMove(ctx context.Context, opts *MoveOptions) error {
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	// Get project details
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	item, err := service.FindProjectItem(items, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+
+	afterID, err := resolveMoveTarget(items, item, opts)
+	if err != nil {
+		return err
+	}
+
+	err = itemService.MoveItem(ctx, project.ID, item.ItemID, afterID)
+	if err != nil {
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+
+	fmt.Printf("✅ Moved '%s' %s\n", item.Title, describeMoveTarget(opts))
+	return nil
+}
+
+// resolveMoveTarget returns the ID of the item to place the moved item after, or nil for the top
+func resolveMoveTarget(items []service.ProjectItemInfo, item *service.ProjectItemInfo, opts *MoveOptions) (*string, error) {
+	switch {
+	case opts.Top:
+		return nil, nil
+	case opts.Bottom:
+		for i := len(items) - 1; i >= 0; i-- {
+			if items[i].ItemID != item.ItemID {
+				return &items[i].ItemID, nil
+			}
+		}
+		return nil, nil
+	default:
+		after, err := service.FindProjectItem(items, opts.After)
+		if err != nil {
+			return nil, err
+		}
+		if after.ItemID == item.ItemID {
+			return nil, fmt.Errorf("cannot move an item after itself")
+		}
+		return &after.ItemID, nil
+	}
+}
+
+func describeMoveTarget(opts *MoveOptions) string {
+	switch {
+	case opts.Top:
+		return "to the top"
+	case opts.Bottom:
+		return "to the bottom"
+	default:
+		return fmt.Sprintf("after %s", opts.After)
+	}
+}
@@ -31,7 +31,8 @@ The item ID should be the project-specific item ID, not the issue or PR ID.
 You can find item IDs by listing project items or using the GitHub web interface.
 
 ⚠️  WARNING: This action cannot be undone. The item will be removed from the project
-but the underlying issue or PR will remain unchanged.
+but the underlying issue or PR will remain unchanged. Use 'ghp item archive'
+to hide items from the project in a way that can be reverted.
 
 Examples:
   ghp item remove octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY    # Remove item from project
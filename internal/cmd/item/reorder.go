@@ -0,0 +1,137 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// ReorderOptions holds options for the reorder command
+type ReorderOptions struct {
+	ProjectRef string
+	By         string
+	DryRun     bool
+}
+
+// NewReorderCmd creates the reorder command
+func NewReorderCmd() *cobra.Command {
+	opts := &ReorderOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reorder <project>",
+		Short: "Reorder project items by field values",
+		Long: `Reorder all items in a project by one or more field values.
+
+The new order is computed locally and only the items that are out of place
+are moved, so reordering an almost sorted project makes very few updates.
+
+Sort fields are given as a comma-separated list. Single select fields sort by
+the order of their options, number and date fields sort numerically and
+chronologically, and other fields sort alphabetically. Prefix a field with
+"-" to sort it in descending order. Items without a value sort last.
+
+Examples:
+  ghp item reorder octocat/1 --by Priority                 # Order by priority
+  ghp item reorder octocat/1 --by "Priority,Due"           # Priority, then due date
+  ghp item reorder octocat/1 --by "-Estimate" --dry-run    # Preview largest estimates first`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runReorder(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.By, "by", "", "Comma-separated fields to sort by (required)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the moves without applying them")
+	_ = cmd.MarkFlagRequired("by")
+
+	return cmd
+}
+
+func runReorder(ctx context.Context, opts *ReorderOptions) error {
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	// Get project details
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	keys, err := service.ParseSortKeys(project.Fields.Nodes, opts.By)
+	if err != nil {
+		return err
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	sorted := service.SortProjectItems(items, keys)
+	moves := service.PlanItemMoves(projectItemIDs(items), projectItemIDs(sorted))
+
+	if len(moves) == 0 {
+		fmt.Println("✅ Items are already in order")
+		return nil
+	}
+
+	titles := make(map[string]string, len(items))
+	for i := range items {
+		titles[items[i].ItemID] = items[i].Title
+	}
+
+	if opts.DryRun {
+		fmt.Printf("Would move %d of %d items:\n", len(moves), len(items))
+		for _, move := range moves {
+			fmt.Printf("  %s\n", describeItemMove(move, titles))
+		}
+		return nil
+	}
+
+	fmt.Printf("Moving %d of %d items...\n", len(moves), len(items))
+	for i, move := range moves {
+		err = itemService.MoveItem(ctx, project.ID, move.ItemID, move.AfterID)
+		if err != nil {
+			return fmt.Errorf("failed after %d of %d moves: %w", i, len(moves), err)
+		}
+	}
+
+	fmt.Printf("✅ Reordered project by %s\n", opts.By)
+	return nil
+}
+
+func projectItemIDs(items []service.ProjectItemInfo) []string {
+	ids := make([]string, len(items))
+	for i := range items {
+		ids[i] = items[i].ItemID
+	}
+	return ids
+}
+
+func describeItemMove(move service.ItemMove, titles map[string]string) string {
+	if move.AfterID == nil {
+		return fmt.Sprintf("'%s' to the top", titles[move.ItemID])
+	}
+	return fmt.Sprintf("'%s' after '%s'", titles[move.ItemID], titles[*move.AfterID])
+}
@@ -0,0 +1,61 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// loadFieldRules returns the field validation rules of a project, checked against its
+// fields. The rule file is set with the "rules" config setting (default ~/.ghp-rules.yaml).
+func loadFieldRules(projectRef string, fields []graphql.ProjectV2Field) ([]service.FieldRule, error) {
+	rules, err := service.LoadProjectFieldRules(viper.GetString("rules"), projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.ValidateFieldRules(rules, fields); err != nil {
+		return nil, fmt.Errorf("invalid field rules for %s: %w", projectRef, err)
+	}
+
+	return rules, nil
+}
+
+// ruleViolationError reports the rules broken by a change
+func ruleViolationError(violations []service.RuleViolation) error {
+	fmt.Println("❌ The change breaks field rules:")
+	for _, violation := range violations {
+		fmt.Printf("  • %s\n", violation.Message)
+	}
+	return fmt.Errorf("the change breaks %d field rules", len(violations))
+}
+
+// projectItemsByID lists the items of a project keyed by item ID
+func projectItemsByID(ctx context.Context, projectService *service.ProjectService, projectID string) (map[string]*service.ProjectItemInfo, error) {
+	projectItems, err := projectService.ListProjectItems(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	items := service.ConvertProjectItems(projectItems)
+	byID := make(map[string]*service.ProjectItemInfo, len(items))
+	for i := range items {
+		byID[items[i].ItemID] = &items[i]
+	}
+	return byID, nil
+}
+
+// checkNewItemRules checks an issue or pull request that is about to be added to a
+// project with the given field values
+func checkNewItemRules(rules []service.FieldRule, content *service.ItemInfo, values map[string]string) []service.RuleViolation {
+	if len(rules) == 0 {
+		return nil
+	}
+	item := service.NewProjectItemInfo(content, values)
+	return service.CheckFieldRules(rules, &item, time.Now())
+}
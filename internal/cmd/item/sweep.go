@@ -0,0 +1,252 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+// SweepOptions holds options for the sweep command
+type SweepOptions struct {
+	ProjectRef string
+	PolicyFile string
+	DryRun     bool
+}
+
+// NewSweepCmd creates the sweep command
+func NewSweepCmd() *cobra.Command {
+	opts := &SweepOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sweep <project>",
+		Short: "Apply stale-item policies to a project",
+		Long: `Apply stale-item policies to a project.
+
+A policy file (YAML or JSON) lists policies, each with a filter selecting items
+and actions to apply to them:
+
+  policies:
+    - name: stale-in-progress
+      filter: status:"In Progress" updated:<@today-14d
+      actions:
+        set:
+          Status: Todo
+        labels: [stale]
+        comment: No activity for two weeks, moving back to Todo.
+    - name: archive-done
+      filter: status:Done updated:<@today-30d
+      actions:
+        archive: true
+
+Filters use GitHub Projects filter syntax. Dates can be compared with <, <=, >
+and >=, and written relative to today (@today, @today-14d, @today-2w, @today-1m).
+
+Actions:
+• set       Set project field values
+• labels    Add labels to the underlying issue or pull request
+• comment   Comment on the underlying issue or pull request
+• archive   Archive the item (restore with 'ghp item unarchive')
+
+Policies run in order and all see the project as it was before the sweep.
+Field values and labels already in place are skipped, and the comment is only
+posted along with another change, so an item a policy already handled is left
+alone. A policy therefore needs a set, labels or archive action besides its
+comment. This lets a sweep run repeatedly, e.g. from a cron job, without
+commenting twice. Use --dry-run to print the plan.
+
+Field values set by a policy are checked against the project's field rules
+(see 'ghp field lint'). Items the values would make break a rule are left
+alone and counted as failed.
+
+Examples:
+  ghp item sweep myorg/2 --policy sweep.yaml --dry-run
+  ghp item sweep myorg/2 --policy sweep.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runSweep(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.PolicyFile, "policy", "", "Policy file (YAML or JSON)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the planned changes without applying them")
+
+	_ = cmd.MarkFlagRequired("policy")
+
+	return cmd
+}
+
+func runSweep(ctx context.Context, opts *SweepOptions) error {
+	config, err := service.LoadSweepConfig(opts.PolicyFile)
+	if err != nil {
+		return err
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if err := service.ValidateSweepConfig(config, project.Fields.Nodes); err != nil {
+		return fmt.Errorf("invalid policy file: %w", err)
+	}
+
+	rules, err := loadFieldRules(opts.ProjectRef, project.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	plan, err := service.PlanSweep(items, config)
+	if err != nil {
+		return err
+	}
+
+	ruleErrors := excludeSweepRuleViolations(plan, rules, time.Now())
+
+	outputSweepPlan(plan, opts.DryRun)
+
+	if len(ruleErrors) > 0 {
+		fmt.Printf("❌ Skipping %d items whose new field values break field rules\n", len(ruleErrors))
+		for _, errMsg := range ruleErrors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		fmt.Println()
+	}
+
+	if opts.DryRun || len(plan.Entries) == 0 {
+		outputSweepSummary(plan, project.Title, true)
+		if !opts.DryRun && len(ruleErrors) > 0 {
+			return fmt.Errorf("%d items break field rules", len(ruleErrors))
+		}
+		return nil
+	}
+
+	result := itemService.ApplySweep(ctx, project, plan)
+
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to update %d items\n", result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+	}
+
+	outputSweepSummary(plan, project.Title, false)
+
+	if failed := result.Failed + len(ruleErrors); failed > 0 {
+		return fmt.Errorf("failed to update %d of %d items", failed, len(plan.Entries)+len(ruleErrors))
+	}
+
+	return nil
+}
+
+// excludeSweepRuleViolations removes the entries whose field values would make an item
+// break field rules from the plan, counting them as failed, and returns their errors
+func excludeSweepRuleViolations(plan *service.SweepPlan, rules []service.FieldRule, now time.Time) []string {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var errors []string
+	entries := plan.Entries[:0]
+	for i := range plan.Entries {
+		entry := plan.Entries[i]
+		if len(entry.Set) == 0 {
+			entries = append(entries, entry)
+			continue
+		}
+
+		violations := service.CheckFieldChange(rules, &entry.Item, entry.Set, now)
+		if len(violations) == 0 {
+			entries = append(entries, entry)
+			continue
+		}
+
+		errors = append(errors, fmt.Sprintf("%s: %s: %s", entry.Policy, projectItemLabel(&entry.Item), service.FormatRuleViolations(violations)))
+		for j := range plan.Summaries {
+			if plan.Summaries[j].Policy == entry.Policy {
+				plan.Summaries[j].Planned--
+				plan.Summaries[j].Failed++
+			}
+		}
+	}
+	plan.Entries = entries
+
+	return errors
+}
+
+func outputSweepPlan(plan *service.SweepPlan, dryRun bool) {
+	if len(plan.Entries) == 0 {
+		fmt.Println("No items need changes")
+		return
+	}
+
+	verb := "Updating"
+	if dryRun {
+		verb = "Would update"
+	}
+	fmt.Printf("%s %d items:\n", verb, len(plan.Entries))
+
+	for i := range plan.Entries {
+		entry := &plan.Entries[i]
+		fmt.Printf("\n  [%s] %s  %s\n", entry.Policy, entry.Item.ItemID, projectItemLabel(&entry.Item))
+		for _, action := range service.DescribeSweepEntry(entry) {
+			fmt.Printf("      %s\n", action)
+		}
+		for _, skipped := range entry.Skipped {
+			fmt.Printf("      ⚠️  skipped %s\n", skipped)
+		}
+	}
+	fmt.Println()
+}
+
+func outputSweepSummary(plan *service.SweepPlan, projectTitle string, dryRun bool) {
+	width := len("Policy")
+	for _, summary := range plan.Summaries {
+		if len(summary.Policy) > width {
+			width = len(summary.Policy)
+		}
+	}
+
+	fmt.Printf("Sweep summary for %s:\n", projectTitle)
+	if dryRun {
+		fmt.Printf("  %-*s  %7s  %7s\n", width, "Policy", "Matched", "Planned")
+		for _, summary := range plan.Summaries {
+			fmt.Printf("  %-*s  %7d  %7d\n", width, summary.Policy, summary.Matched, summary.Planned)
+		}
+		return
+	}
+
+	fmt.Printf("  %-*s  %7s  %7s  %7s\n", width, "Policy", "Matched", "Applied", "Failed")
+	for _, summary := range plan.Summaries {
+		fmt.Printf("  %-*s  %7d  %7d  %7d\n", width, summary.Policy, summary.Matched, summary.Applied, summary.Failed)
+	}
+}
@@ -0,0 +1,201 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const (
+	maxStatusItemLength      = 60
+	statusItemTruncateLength = 57
+)
+
+// SyncStatusOptions holds options for the sync-status command
+type SyncStatusOptions struct {
+	ProjectRef  string
+	StatusField string
+	Filter      string
+	Mapping     []string
+	DryRun      bool
+}
+
+// NewSyncStatusCmd creates the sync-status command
+func NewSyncStatusCmd() *cobra.Command {
+	opts := &SyncStatusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync-status <project>",
+		Short: "Sync item status with issue and pull request state",
+		Long: `Update the status of project items to match the state of their issues
+and pull requests.
+
+The default mapping is:
+
+  closed=Done    Closed issues and pull requests
+  merged=Done    Merged pull requests
+  open=Todo      Reopened items that are still marked with the closed
+                 or merged status
+
+Other statuses of open items, such as "In Progress", are left alone. Override
+a mapping with --map, or disable it with an empty value (e.g. --map open=).
+
+Only items whose status is out of sync are updated, so the command can run
+repeatedly. Draft issues are never changed. Use --dry-run to see the changes.
+
+New statuses are checked against the project's field rules (see 'ghp field
+lint'); items they would make break a rule are left alone and reported as
+failures.
+
+Examples:
+  ghp item sync-status myorg/2 --dry-run
+  ghp item sync-status myorg/2
+  ghp item sync-status myorg/2 --field Stage --map merged=Shipped --map open="In Progress"
+  ghp item sync-status myorg/2 --filter repo:myorg/api`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runSyncStatus(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.StatusField, "field", defaultStatusField, "Status field to update")
+	cmd.Flags().StringArrayVar(&opts.Mapping, "map", nil, "Map a state to a status as state=Value (open, closed, merged)")
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Only sync items matching GitHub Projects filter syntax")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the status changes without applying them")
+
+	return cmd
+}
+
+func runSyncStatus(ctx context.Context, opts *SyncStatusOptions) error {
+	mapping, err := service.ParseStatusMapping(opts.Mapping)
+	if err != nil {
+		return err
+	}
+
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	if err := service.ValidateStatusMapping(project.Fields.Nodes, opts.StatusField, mapping); err != nil {
+		return fmt.Errorf("invalid status mapping: %w (use --map to match the project's options)", err)
+	}
+
+	rules, err := loadFieldRules(opts.ProjectRef, project.Fields.Nodes)
+	if err != nil {
+		return err
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, opts.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	changes, ruleErrors := excludeStatusRuleViolations(service.PlanStatusSync(items, opts.StatusField, mapping), rules, opts.StatusField)
+	if len(changes) == 0 && len(ruleErrors) == 0 {
+		fmt.Printf("✅ All %d items are in sync\n", len(items))
+		return nil
+	}
+	if len(ruleErrors) > 0 {
+		fmt.Printf("❌ Skipping %d items whose new %s breaks field rules\n", len(ruleErrors), opts.StatusField)
+		for _, errMsg := range ruleErrors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+		if len(changes) > 0 {
+			fmt.Println()
+		}
+	}
+
+	if len(changes) > 0 {
+		if opts.DryRun {
+			fmt.Printf("Would update %d of %d items:\n", len(changes), len(items))
+		} else {
+			fmt.Printf("Updating %d of %d items:\n", len(changes), len(items))
+		}
+		outputStatusChanges(changes, opts.StatusField)
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+
+	result := &service.BulkUpdateResult{}
+	if len(changes) > 0 {
+		result = itemService.ApplyStatusSync(ctx, project, opts.StatusField, changes)
+	}
+
+	if result.Updated > 0 {
+		fmt.Printf("✅ Updated %s of %d items in project %s\n", opts.StatusField, result.Updated, project.Title)
+	}
+	if result.Failed > 0 {
+		fmt.Printf("❌ Failed to update %d items\n", result.Failed)
+		for _, errMsg := range result.Errors {
+			fmt.Printf("  Error: %s\n", errMsg)
+		}
+	}
+	if failed := result.Failed + len(ruleErrors); failed > 0 {
+		return fmt.Errorf("failed to update %d of %d items", failed, len(changes)+len(ruleErrors))
+	}
+
+	return nil
+}
+
+// excludeStatusRuleViolations splits status changes into those that keep the items within
+// the project's field rules and errors for the others
+func excludeStatusRuleViolations(changes []service.StatusChange, rules []service.FieldRule, statusField string) ([]service.StatusChange, []string) {
+	if len(rules) == 0 {
+		return changes, nil
+	}
+
+	now := time.Now()
+	allowed := make([]service.StatusChange, 0, len(changes))
+	var errors []string
+	for i := range changes {
+		change := changes[i]
+		violations := service.CheckFieldChange(rules, &change.Item, map[string]string{statusField: change.To}, now)
+		if len(violations) > 0 {
+			errors = append(errors, fmt.Sprintf("%s: %s", projectItemLabel(&change.Item), service.FormatRuleViolations(violations)))
+			continue
+		}
+		allowed = append(allowed, change)
+	}
+
+	return allowed, errors
+}
+
+func outputStatusChanges(changes []service.StatusChange, statusField string) {
+	for i := range changes {
+		change := &changes[i]
+		from := change.From
+		if from == "" {
+			from = "(none)"
+		}
+		fmt.Printf("  %-7s %s\n", change.State, truncateString(projectItemLabel(&change.Item), maxStatusItemLength, statusItemTruncateLength))
+		fmt.Printf("          %s: %s → %s\n", statusField, from, change.To)
+	}
+}
@@ -0,0 +1,217 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/service"
+)
+
+const (
+	defaultStatusField = "Status"
+	percentMultiplier  = 100
+)
+
+// TreeOptions holds options for the tree command
+type TreeOptions struct {
+	ProjectRef  string
+	ItemRef     string
+	StatusField string
+	Format      string
+}
+
+// issueTreeJSON is the JSON representation of a sub-issue tree node
+type issueTreeJSON struct {
+	Reference string           `json:"reference"`
+	Title     string           `json:"title"`
+	State     string           `json:"state"`
+	URL       string           `json:"url"`
+	Status    string           `json:"status,omitempty"`
+	Children  []*issueTreeJSON `json:"children,omitempty"`
+	Completed int              `json:"completed"`
+	Total     int              `json:"total"`
+	InProject bool             `json:"inProject"`
+}
+
+// NewTreeCmd creates the tree command
+func NewTreeCmd() *cobra.Command {
+	opts := &TreeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tree <project> <item>",
+		Short: "Show the sub-issue tree of an issue",
+		Long: `Show an issue and its sub-issues as a tree.
+
+Each node shows the issue's project status (or that it is not in the
+project) and, for parent issues, how many of its sub-issues are closed,
+rolled up over all levels below it.
+
+The item can be a project item ID, an owner/repo#number reference or a URL.
+
+Examples:
+  ghp item tree myorg/2 myorg/api#12                 # Show an epic with its sub-issues
+  ghp item tree myorg/2 PVTI_lADOANN5s84ACbL0zgBZrOY # Start from a project item
+  ghp item tree myorg/2 myorg/api#12 --field Stage   # Use another field as status
+  ghp item tree myorg/2 myorg/api#12 --format json`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemRef = args[1]
+			return runTree(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.StatusField, "field", defaultStatusField, "Project field to show as each node's status")
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func runTree(ctx context.Context, opts *TreeOptions) error {
+	// Parse project reference
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	token, err := authManager.GetValidatedToken()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClient(token)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+	issueService := service.NewIssueService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := itemService.ListProjectItems(ctx, project.ID, "")
+	if err != nil {
+		return fmt.Errorf("failed to list project items: %w", err)
+	}
+
+	issueID, err := resolveTreeRoot(ctx, itemService, items, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+
+	tree, err := issueService.GetIssueTree(ctx, issueID)
+	if err != nil {
+		return err
+	}
+
+	service.AnnotateIssueTree(tree, items, opts.StatusField)
+
+	switch opts.Format {
+	case formatJSON:
+		return outputIssueTreeJSON(tree)
+	case formatTable:
+		printIssueTree(tree)
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+// resolveTreeRoot finds the issue ID for a project item or issue reference
+func resolveTreeRoot(ctx context.Context, itemService *service.ItemService, items []service.ProjectItemInfo, ref string) (string, error) {
+	if item, err := service.FindProjectItem(items, ref); err == nil {
+		if item.Type != "Issue" {
+			return "", fmt.Errorf("%s is not an issue", ref)
+		}
+		return item.ContentID, nil
+	}
+
+	info, err := itemService.ResolveItemReference(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+
+	if info.Type != "Issue" {
+		return "", fmt.Errorf("%s is not an issue", ref)
+	}
+
+	return info.ID, nil
+}
+
+func printIssueTree(root *service.IssueTreeNode) {
+	fmt.Println(formatIssueTreeNode(root))
+	printIssueTreeChildren(root, "")
+
+	if root.Total > 0 {
+		fmt.Printf("\n%d of %d sub-issues closed (%d%%)\n", root.Completed, root.Total, root.Completed*percentMultiplier/root.Total)
+	}
+}
+
+func printIssueTreeChildren(node *service.IssueTreeNode, prefix string) {
+	for i, child := range node.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(node.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		fmt.Printf("%s%s%s\n", prefix, branch, formatIssueTreeNode(child))
+		printIssueTreeChildren(child, prefix+indent)
+	}
+}
+
+func formatIssueTreeNode(node *service.IssueTreeNode) string {
+	status := node.Status
+	switch {
+	case !node.InProject:
+		status = "not in project"
+	case status == "":
+		status = "no status"
+	}
+
+	line := fmt.Sprintf("%s %s [%s]", node.Reference, node.Title, status)
+	if node.State == "CLOSED" {
+		line += " ✓"
+	}
+	if node.Total > 0 {
+		line += fmt.Sprintf(" (%d/%d)", node.Completed, node.Total)
+	}
+
+	return line
+}
+
+func outputIssueTreeJSON(root *service.IssueTreeNode) error {
+	data, err := json.MarshalIndent(issueTreeToJSON(root), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	fmt.Println(string(data))
+	return nil
+}
+
+func issueTreeToJSON(node *service.IssueTreeNode) *issueTreeJSON {
+	output := &issueTreeJSON{
+		Reference: node.Reference,
+		Title:     node.Title,
+		State:     node.State,
+		URL:       node.URL,
+		Status:    node.Status,
+		Completed: node.Completed,
+		Total:     node.Total,
+		InProject: node.InProject,
+	}
+
+	for _, child := range node.Children {
+		output.Children = append(output.Children, issueTreeToJSON(child))
+	}
+
+	return output
+}
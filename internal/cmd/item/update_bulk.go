@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
@@ -38,8 +40,11 @@ Examples:
   # Update items by number range
   ghp item update-bulk myorg/123 --items 34-46 --field "Status" --value "In Progress"
   
-  # Update all items matching a filter
-  ghp item update-bulk myorg/123 --filter "assignee:@me" --field "Priority" --value "High"`,
+  # Update all items assigned to a user
+  ghp item update-bulk myorg/123 --filter "assignee:octocat" --field "Priority" --value "High"
+
+Items the change would make break the project's field rules are skipped and
+reported as failures (see 'ghp field lint').`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runUpdateBulk(cmd.Context(), args[0], filter, items, fieldName, value)
@@ -97,7 +102,23 @@ func runUpdateBulk(ctx context.Context, projectRef, filter, items, fieldName, va
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
-	var itemsToUpdate []string
+	field, err := service.FindProjectField(project.Fields.Nodes, fieldName)
+	if err != nil {
+		return err
+	}
+	if field.DataType == graphql.ProjectV2FieldDataTypeSingleSelect {
+		// The project only lists the first options of each field
+		field, err = service.NewFieldService(client).GetSingleSelectField(ctx, field.ID)
+		if err != nil {
+			return err
+		}
+	}
+	fieldValue, err := service.BuildFieldValue(field, value)
+	if err != nil {
+		return err
+	}
+
+	var itemsToUpdate, skipped []string
 
 	// Handle filter
 	if filter != "" {
@@ -109,41 +130,115 @@ func runUpdateBulk(ctx context.Context, projectRef, filter, items, fieldName, va
 	}
 
 	// Handle item range
+	var projectItems map[string]*service.ProjectItemInfo
 	if items != "" {
-		itemRange, rangeErr := service.ParseNumberRange(items)
+		numbers, rangeErr := service.ParseNumberRange(items)
 		if rangeErr != nil {
 			return fmt.Errorf("invalid item range: %w", rangeErr)
 		}
-		itemsToUpdate = append(itemsToUpdate, itemRange...)
+		projectItems, err = projectItemsByID(ctx, projectService, project.ID)
+		if err != nil {
+			return err
+		}
+		numbered, missing := projectItemIDsByNumber(projectItems, numbers)
+		itemsToUpdate = append(itemsToUpdate, numbered...)
+		skipped = append(skipped, missing...)
 	}
 
 	// Remove duplicates
 	itemsToUpdate = service.RemoveDuplicates(itemsToUpdate)
 
+	// Leave out items the change would make break the project's field rules
+	itemsToUpdate, ruleErrors, err := checkBulkUpdateRules(ctx, projectService, project, projectRef, projectItems, itemsToUpdate, field.Name, value)
+	if err != nil {
+		return err
+	}
+
 	fmt.Printf("Updating %d items in project %s...\n", len(itemsToUpdate), projectRef)
-	fmt.Printf("Setting field '%s' to '%s'\n\n", fieldName, value)
+	fmt.Printf("Setting field '%s' to '%s'\n\n", field.Name, value)
 
 	// Update items using service
 	input := service.BulkUpdateInput{
 		ProjectID: project.ID,
 		ItemIDs:   itemsToUpdate,
-		FieldName: fieldName,
-		Value:     value,
+		FieldID:   field.ID,
+		Value:     fieldValue,
 	}
 
 	result, err := itemService.BulkUpdateItems(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to update items: %w", err)
 	}
+	result.Failed += len(skipped) + len(ruleErrors)
+	result.Errors = append(append(skipped, ruleErrors...), result.Errors...)
 
-	fmt.Printf("\n✅ Successfully updated %d items", result.Updated)
+	fmt.Printf("\n✅ Successfully updated %d items\n", result.Updated)
 	if result.Failed > 0 {
-		fmt.Printf(" (%d failed)", result.Failed)
+		fmt.Printf("❌ Failed to update %d items:\n", result.Failed)
 		for _, errMsg := range result.Errors {
-			fmt.Printf("\n  Error: %s", errMsg)
+			fmt.Printf("  Error: %s\n", errMsg)
 		}
+		return fmt.Errorf("failed to update %d items", result.Failed)
 	}
-	fmt.Printf("\n")
 
 	return nil
 }
+
+// projectItemIDsByNumber looks up the project items of issue and pull request numbers,
+// returning errors for numbers that are not in the project or match several items
+func projectItemIDsByNumber(items map[string]*service.ProjectItemInfo, numbers []int) ([]string, []string) {
+	byNumber := make(map[int][]string, len(items))
+	for id, item := range items {
+		if item.Number != nil {
+			byNumber[*item.Number] = append(byNumber[*item.Number], id)
+		}
+	}
+
+	ids := make([]string, 0, len(numbers))
+	var errors []string
+	for _, number := range numbers {
+		switch matches := byNumber[number]; len(matches) {
+		case 0:
+			errors = append(errors, fmt.Sprintf("#%d: not in the project", number))
+		case 1:
+			ids = append(ids, matches[0])
+		default:
+			errors = append(errors, fmt.Sprintf("#%d: matches %d items from different repositories", number, len(matches)))
+		}
+	}
+
+	return ids, errors
+}
+
+// checkBulkUpdateRules splits items into those the change can be applied to and
+// errors for those it would make break the project's field rules
+func checkBulkUpdateRules(ctx context.Context, projectService *service.ProjectService, project *graphql.ProjectV2,
+	projectRef string, items map[string]*service.ProjectItemInfo, itemIDs []string, fieldName, value string) ([]string, []string, error) {
+	rules, err := loadFieldRules(projectRef, project.Fields.Nodes)
+	if err != nil || len(rules) == 0 {
+		return itemIDs, nil, err
+	}
+
+	if items == nil {
+		items, err = projectItemsByID(ctx, projectService, project.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	now := time.Now()
+	changes := map[string]string{fieldName: value}
+	allowed := make([]string, 0, len(itemIDs))
+	var errors []string
+	for _, itemID := range itemIDs {
+		if item, ok := items[itemID]; ok {
+			if violations := service.CheckFieldChange(rules, item, changes, now); len(violations) > 0 {
+				errors = append(errors, fmt.Sprintf("%s: %s", itemID, service.FormatRuleViolations(violations)))
+				continue
+			}
+		}
+		allowed = append(allowed, itemID)
+	}
+
+	return allowed, errors, nil
+}
@@ -2,6 +2,7 @@ package item
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/browser"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
@@ -19,9 +21,11 @@ const (
 
 // ViewOptions holds options for the view command
 type ViewOptions struct {
-	ItemRef string
-	Format  string
-	Web     bool
+	ItemRef  string
+	Project  string
+	Format   string
+	Web      bool
+	PrintURL bool
 }
 
 // NewViewCmd creates the view command
@@ -38,11 +42,15 @@ Item references can be in the following formats:
 • https://github.com/owner/repo/issues/123 (GitHub issue URL)
 • https://github.com/owner/repo/pull/456 (GitHub PR URL)
 
+The output includes every project the item belongs to, with its project item
+ID, archived state and field values. Use --project to show a single project.
+
 Examples:
   ghp item view octocat/Hello-World#123              # View issue details
   ghp item view https://github.com/cli/cli/pull/456  # View PR from URL
   ghp item view myorg/repo#789 --format json         # View in JSON format
-  ghp item view octocat/Hello-World#123 --web        # Open in browser`,
+  ghp item view octocat/Hello-World#123 --web        # Open in browser
+  ghp item view myorg/repo#789 --project myorg/5     # Show fields in one project`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ItemRef = args[0]
@@ -52,6 +60,8 @@ Examples:
 
 	cmd.Flags().StringVar(&opts.Format, "format", "details", "Output format: details, json")
 	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open item in web browser")
+	cmd.Flags().BoolVar(&opts.PrintURL, "print-url", false, "Print the item URL instead of opening a browser")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Only show membership in this project (owner/number)")
 
 	return cmd
 }
@@ -77,11 +87,16 @@ func runView(ctx context.Context, opts *ViewOptions) error {
 	// Try to get as issue first
 	issue, err := itemService.GetIssue(ctx, owner, repo, number)
 	if err == nil {
-		if opts.Web {
-			fmt.Printf("Opening issue in browser: %s\n", issue.URL)
-			return nil
+		if opts.Web || opts.PrintURL {
+			return browser.OpenURL(issue.URL, opts.PrintURL)
+		}
+
+		memberships, membershipErr := getProjectMemberships(ctx, itemService, owner, repo, number, "Issue", opts.Project)
+		if membershipErr != nil {
+			return membershipErr
 		}
-		return outputIssueDetails(issue, opts.Format)
+
+		return outputIssueDetails(issue, memberships, opts.Format)
 	}
 
 	// Try as pull request
@@ -90,37 +105,65 @@ func runView(ctx context.Context, opts *ViewOptions) error {
 		return fmt.Errorf("failed to find issue or pull request: %w", err)
 	}
 
-	if opts.Web {
-		fmt.Printf("Opening pull request in browser: %s\n", pr.URL)
-		return nil
+	if opts.Web || opts.PrintURL {
+		return browser.OpenURL(pr.URL, opts.PrintURL)
+	}
+
+	memberships, err := getProjectMemberships(ctx, itemService, owner, repo, number, "PullRequest", opts.Project)
+	if err != nil {
+		return err
+	}
+
+	return outputPullRequestDetails(pr, memberships, opts.Format)
+}
+
+// getProjectMemberships retrieves the item's project memberships, optionally limited to one project
+func getProjectMemberships(ctx context.Context, itemService *service.ItemService, owner, repo string, number int,
+	contentType, projectRef string) ([]service.ProjectMembership, error) {
+	memberships, err := itemService.GetProjectMemberships(ctx, owner, repo, number, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectRef == "" {
+		return memberships, nil
+	}
+
+	memberships, err = service.FilterMembershipsByProject(memberships, projectRef)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	if len(memberships) == 0 {
+		return nil, fmt.Errorf("item is not in project %s", projectRef)
 	}
 
-	return outputPullRequestDetails(pr, opts.Format)
+	return memberships, nil
 }
 
-func outputIssueDetails(issue *graphql.Issue, format string) error {
+func outputIssueDetails(issue *graphql.Issue, memberships []service.ProjectMembership, format string) error {
 	switch format {
 	case "json":
-		return outputIssueDetailsJSON(issue)
+		return outputIssueDetailsJSON(issue, memberships)
 	case "details":
-		return outputIssueDetailsTable(issue)
+		return outputIssueDetailsTable(issue, memberships)
 	default:
 		return fmt.Errorf("unknown format: %s", format)
 	}
 }
 
-func outputPullRequestDetails(pr *graphql.PullRequest, format string) error {
+func outputPullRequestDetails(pr *graphql.PullRequest, memberships []service.ProjectMembership, format string) error {
 	switch format {
 	case "json":
-		return outputPullRequestDetailsJSON(pr)
+		return outputPullRequestDetailsJSON(pr, memberships)
 	case "details":
-		return outputPullRequestDetailsTable(pr)
+		return outputPullRequestDetailsTable(pr, memberships)
 	default:
 		return fmt.Errorf("unknown format: %s", format)
 	}
 }
 
-func outputIssueDetailsTable(issue *graphql.Issue) error {
+func outputIssueDetailsTable(issue *graphql.Issue, memberships []service.ProjectMembership) error {
 	fmt.Printf("Issue #%d\n", issue.Number)
 	fmt.Printf("Title: %s\n", issue.Title)
 	fmt.Printf("Repository: %s\n", issue.Repository.NameWithOwner)
@@ -151,6 +194,8 @@ func outputIssueDetailsTable(issue *graphql.Issue) error {
 		}
 	}
 
+	printProjectMemberships(memberships)
+
 	// Body
 	if issue.Body != "" {
 		fmt.Printf("\nDescription:\n")
@@ -172,7 +217,7 @@ func outputIssueDetailsTable(issue *graphql.Issue) error {
 	return nil
 }
 
-func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
+func outputPullRequestDetailsTable(pr *graphql.PullRequest, memberships []service.ProjectMembership) error {
 	fmt.Printf("Pull Request #%d\n", pr.Number)
 	fmt.Printf("Title: %s\n", pr.Title)
 	fmt.Printf("Repository: %s\n", pr.Repository.NameWithOwner)
@@ -212,6 +257,8 @@ func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
 		}
 	}
 
+	printProjectMemberships(memberships)
+
 	// Body
 	if pr.Body != "" {
 		fmt.Printf("\nDescription:\n")
@@ -233,7 +280,7 @@ func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
 	return nil
 }
 
-func outputIssueDetailsJSON(issue *graphql.Issue) error {
+func outputIssueDetailsJSON(issue *graphql.Issue, memberships []service.ProjectMembership) error {
 	fmt.Printf("{\n")
 	fmt.Printf("  \"type\": \"Issue\",\n")
 	fmt.Printf("  \"number\": %d,\n", issue.Number)
@@ -244,12 +291,11 @@ func outputIssueDetailsJSON(issue *graphql.Issue) error {
 	fmt.Printf("  \"closed\": %t,\n", issue.Closed)
 	fmt.Printf("  \"url\": \"%s\",\n", issue.URL)
 	fmt.Printf("  \"created_at\": \"%s\",\n", issue.CreatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("  \"updated_at\": \"%s\"\n", issue.UpdatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("}\n")
-	return nil
+	fmt.Printf("  \"updated_at\": \"%s\",\n", issue.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	return printProjectMembershipsJSON(memberships)
 }
 
-func outputPullRequestDetailsJSON(pr *graphql.PullRequest) error {
+func outputPullRequestDetailsJSON(pr *graphql.PullRequest, memberships []service.ProjectMembership) error {
 	fmt.Printf("{\n")
 	fmt.Printf("  \"type\": \"PullRequest\",\n")
 	fmt.Printf("  \"number\": %d,\n", pr.Number)
@@ -261,7 +307,45 @@ func outputPullRequestDetailsJSON(pr *graphql.PullRequest) error {
 	fmt.Printf("  \"merged\": %t,\n", pr.Merged)
 	fmt.Printf("  \"url\": \"%s\",\n", pr.URL)
 	fmt.Printf("  \"created_at\": \"%s\",\n", pr.CreatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("  \"updated_at\": \"%s\"\n", pr.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	fmt.Printf("  \"updated_at\": \"%s\",\n", pr.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	return printProjectMembershipsJSON(memberships)
+}
+
+func printProjectMemberships(memberships []service.ProjectMembership) {
+	if len(memberships) == 0 {
+		return
+	}
+
+	fmt.Printf("\nProjects:\n")
+	for i := range memberships {
+		membership := &memberships[i]
+		archived := ""
+		if membership.Archived {
+			archived = " [archived]"
+		}
+
+		fmt.Printf("  • %s (%s/%d)%s\n", membership.ProjectTitle, membership.ProjectOwner, membership.ProjectNumber, archived)
+		fmt.Printf("    Item ID: %s\n", membership.ItemID)
+		for _, field := range membership.Fields {
+			if field.Name == "Title" {
+				continue
+			}
+			fmt.Printf("    %s: %v (%s)\n", field.Name, field.Value, strings.ToLower(string(field.Type)))
+		}
+	}
+}
+
+func printProjectMembershipsJSON(memberships []service.ProjectMembership) error {
+	if memberships == nil {
+		memberships = []service.ProjectMembership{}
+	}
+
+	data, err := json.MarshalIndent(memberships, "  ", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	fmt.Printf("  \"projects\": %s\n", data)
 	fmt.Printf("}\n")
 	return nil
 }
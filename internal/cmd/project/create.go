@@ -9,6 +9,7 @@ import (
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/browser"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
@@ -23,6 +24,7 @@ type CreateOptions struct {
 	Format      string
 	Org         bool
 	Web         bool
+	PrintURL    bool
 }
 
 // NewCreateCmd creates the create command
@@ -54,6 +56,7 @@ Examples:
 	cmd.Flags().StringVar(&opts.OwnerID, "owner-id", "", "Owner ID (user or organization)")
 	cmd.Flags().BoolVar(&opts.Org, "org", false, "Create organization project")
 	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open project in web browser after creation")
+	cmd.Flags().BoolVar(&opts.PrintURL, "print-url", false, "Print the project URL instead of opening a browser")
 	cmd.Flags().StringVar(&opts.Format, "format", "details", "Output format: details, json")
 
 	return cmd
@@ -99,15 +102,18 @@ func runCreate(ctx context.Context, opts *CreateOptions, args []string) error {
 		return fmt.Errorf("failed to create project: %w", err)
 	}
 
+	// Output project details
+	fmt.Printf("✅ Project created successfully!\n\n")
+	if err := outputCreatedProject(project, opts.Format); err != nil {
+		return err
+	}
+
 	// Open in web browser if requested
-	if opts.Web {
-		fmt.Printf("Opening project in browser: %s\n", project.URL)
-		// In a real implementation, we'd use a library to open the browser
+	if opts.Web || opts.PrintURL {
+		return browser.OpenURL(project.URL, opts.PrintURL)
 	}
 
-	// Output project details
-	fmt.Printf("✅ Project created successfully!\n\n")
-	return outputCreatedProject(project, opts.Format)
+	return nil
 }
 
 func outputCreatedProject(project *graphql.ProjectV2, format string) error {
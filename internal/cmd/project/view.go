@@ -11,18 +11,20 @@ import (
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/browser"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
 // ViewOptions holds options for the view command
 type ViewOptions struct {
-	Owner  string
-	Format string
-	Number int
-	Org    bool
-	Fields bool
-	Items  bool
-	Web    bool
+	Owner    string
+	Format   string
+	Number   int
+	Org      bool
+	Fields   bool
+	Items    bool
+	Web      bool
+	PrintURL bool
 }
 
 // NewViewCmd creates the view command
@@ -37,7 +39,8 @@ func NewViewCmd() *cobra.Command {
 Examples:
   ghp project view 123               # View project 123 in current repository context
   ghp project view octocat/123       # View project 123 owned by octocat
-  ghp project view --org myorg/456   # View project 456 owned by organization myorg`,
+  ghp project view --org myorg/456   # View project 456 owned by organization myorg
+  ghp project view octocat/123 --web # Open project in web browser`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runView(cmd.Context(), opts, args)
@@ -49,6 +52,7 @@ Examples:
 	cmd.Flags().BoolVar(&opts.Fields, "fields", false, "Show project fields")
 	cmd.Flags().BoolVar(&opts.Items, "items", false, "Show project items")
 	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open project in web browser")
+	cmd.Flags().BoolVar(&opts.PrintURL, "print-url", false, "Print the project URL instead of opening a browser")
 
 	return cmd
 }
@@ -93,10 +97,8 @@ func runView(ctx context.Context, opts *ViewOptions, args []string) error {
 	}
 
 	// Open in web browser if requested
-	if opts.Web {
-		fmt.Printf("Opening project in browser: %s\n", project.URL)
-		// In a real implementation, we'd use a library to open the browser
-		return nil
+	if opts.Web || opts.PrintURL {
+		return browser.OpenURL(project.URL, opts.PrintURL)
 	}
 
 	// Output project details
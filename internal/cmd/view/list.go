@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/auth"
+	"github.com/roboco-io/gh-project-cli/internal/browser"
 	"github.com/roboco-io/gh-project-cli/internal/service"
 )
 
@@ -17,6 +18,9 @@ import (
 type ListOptions struct {
 	ProjectRef string
 	Format     string
+	View       string
+	Web        bool
+	PrintURL   bool
 }
 
 // NewListCmd creates the list command
@@ -34,7 +38,8 @@ names, layouts, and basic configuration.
 Examples:
   ghp view list octocat/123
   ghp view list --org myorg/456
-  ghp view list octocat/123 --format json`,
+  ghp view list octocat/123 --format json
+  ghp view list octocat/123 --web --view Board   # Open a view in the browser`,
 
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -45,6 +50,9 @@ Examples:
 	}
 
 	cmd.Flags().Bool("org", false, "List views from organization project")
+	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open the project, or the view given by --view, in web browser")
+	cmd.Flags().BoolVar(&opts.PrintURL, "print-url", false, "Print the URL instead of opening a browser")
+	cmd.Flags().StringVar(&opts.View, "view", "", "View name or number to open with --web or --print-url")
 
 	return cmd
 }
@@ -86,10 +94,34 @@ func runList(ctx context.Context, opts *ListOptions) error {
 		return fmt.Errorf("failed to list views: %w", err)
 	}
 
+	if opts.Web || opts.PrintURL {
+		url, urlErr := viewURL(project.URL, views, opts.View)
+		if urlErr != nil {
+			return urlErr
+		}
+		return browser.OpenURL(url, opts.PrintURL)
+	}
+
 	// Output views
 	return outputViews(views, project.Title, opts.Format)
 }
 
+// viewURL returns the URL of the view given by name or number, or the project URL when none is given
+func viewURL(projectURL string, views []service.ViewInfo, view string) (string, error) {
+	if view == "" {
+		return projectURL, nil
+	}
+
+	number, numErr := strconv.Atoi(view)
+	for i := range views {
+		if (numErr == nil && views[i].Number == number) || strings.EqualFold(views[i].Name, view) {
+			return service.ProjectViewURL(projectURL, views[i].Number), nil
+		}
+	}
+
+	return "", fmt.Errorf("view '%s' not found in project", view)
+}
+
 func outputViews(views []service.ViewInfo, projectName, format string) error {
 	switch format {
 	case "json":
@@ -0,0 +1,91 @@
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Editor opens text in the user's editor.
+//
+// The editor is chosen in this order:
+//  1. the "editor" config setting (editor: in ~/.ghp.yaml or GHP_EDITOR)
+//  2. the $VISUAL and $EDITOR environment variables
+//  3. the platform default: notepad (Windows) or vi (others)
+type Editor struct {
+	getenv func(string) string
+	run    func(name string, args ...string) error
+	editor string
+	goos   string
+}
+
+// New creates an editor using the configured editor and the current platform
+func New() *Editor {
+	return &Editor{
+		editor: viper.GetString("editor"),
+		goos:   runtime.GOOS,
+		getenv: os.Getenv,
+		run: func(name string, args ...string) error {
+			cmd := exec.Command(name, args...)
+			cmd.Stdin = os.Stdin
+			cmd.Stdout = os.Stdout
+			cmd.Stderr = os.Stderr
+			return cmd.Run()
+		},
+	}
+}
+
+// Command returns the command and arguments used to edit a file
+func (e *Editor) Command(path string) (string, []string) {
+	editor := e.editor
+	for _, key := range []string{"VISUAL", "EDITOR"} {
+		if strings.TrimSpace(editor) != "" {
+			break
+		}
+		editor = e.getenv(key)
+	}
+
+	if parts := strings.Fields(editor); len(parts) > 0 {
+		return parts[0], append(parts[1:], path)
+	}
+
+	if e.goos == "windows" {
+		return "notepad", []string{path}
+	}
+	return "vi", []string{path}
+}
+
+// Edit opens text in the editor and returns the edited text.
+// pattern names the temporary file as in os.CreateTemp, e.g. "issue-*.md".
+func (e *Editor) Edit(text, pattern string) (string, error) {
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	name, args := e.Command(path)
+	if err := e.run(name, args...); err != nil {
+		return "", fmt.Errorf("editor %s failed: %w", name, err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+
+	return string(edited), nil
+}
@@ -0,0 +1,85 @@
+package editor
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEditor(goos, editor string, env map[string]string) *Editor {
+	return &Editor{
+		editor: editor,
+		goos:   goos,
+		getenv: func(key string) string { return env[key] },
+		run:    func(string, ...string) error { return nil },
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	const path = "/tmp/issue-1.md"
+
+	t.Run("Config override takes precedence over the environment", func(t *testing.T) {
+		editor := newTestEditor("linux", "code --wait", map[string]string{"VISUAL": "emacs", "EDITOR": "nano"})
+
+		name, args := editor.Command(path)
+
+		assert.Equal(t, "code", name)
+		assert.Equal(t, []string{"--wait", path}, args)
+	})
+
+	t.Run("$VISUAL takes precedence over $EDITOR", func(t *testing.T) {
+		editor := newTestEditor("linux", "", map[string]string{"VISUAL": "emacs", "EDITOR": "nano"})
+
+		name, _ := editor.Command(path)
+
+		assert.Equal(t, "emacs", name)
+	})
+
+	t.Run("$EDITOR is used when $VISUAL is unset", func(t *testing.T) {
+		editor := newTestEditor("linux", "", map[string]string{"EDITOR": "nano -w"})
+
+		name, args := editor.Command(path)
+
+		assert.Equal(t, "nano", name)
+		assert.Equal(t, []string{"-w", path}, args)
+	})
+
+	t.Run("Platform defaults", func(t *testing.T) {
+		name, _ := newTestEditor("linux", "", nil).Command(path)
+		assert.Equal(t, "vi", name)
+
+		name, _ = newTestEditor("windows", "", nil).Command(path)
+		assert.Equal(t, "notepad", name)
+	})
+}
+
+func TestEditorEdit(t *testing.T) {
+	t.Run("Returns the edited text", func(t *testing.T) {
+		editor := newTestEditor("linux", "", nil)
+		editor.run = func(_ string, args ...string) error {
+			path := args[len(args)-1]
+			original, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return os.WriteFile(path, append(original, []byte("edited\n")...), 0o600)
+		}
+
+		text, err := editor.Edit("title: \n", "issue-*.md")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "title: \nedited\n", text)
+	})
+
+	t.Run("Editor failure returns error", func(t *testing.T) {
+		editor := newTestEditor("linux", "", nil)
+		editor.run = func(string, ...string) error { return errors.New("exit status 1") }
+
+		_, err := editor.Edit("", "issue-*.md")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "editor vi failed")
+	})
+}
@@ -8,4 +8,14 @@ const (
 	// Search and query constants
 	minSearchPartsLength   = 4
 	defaultSearchPartsSize = 10
+
+	// Pagination constants
+	projectItemsPageSize       = 100
+	searchPageSize             = 100
+	timelinePageSize           = 100
+	repositoryMetadataPageSize = 100
+	itemFieldValuesPageSize    = 100
+
+	// Sub-issue constants
+	maxIssueTreeDepth = 8
 )
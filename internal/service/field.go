@@ -46,12 +46,14 @@ type CreateFieldInput struct {
 	DataType            graphql.ProjectV2FieldDataType
 	SingleSelectOptions []string
 	Duration            string
+	StartDate           string
 }
 
 // UpdateFieldInput represents input for updating a field
 type UpdateFieldInput struct {
-	Name    *string
-	FieldID string
+	Name                *string
+	FieldID             string
+	SingleSelectOptions []graphql.SingleSelectOptionInput
 }
 
 // DeleteFieldInput represents input for deleting a field
@@ -88,6 +90,7 @@ func (s *FieldService) CreateField(ctx context.Context, input CreateFieldInput)
 		DataType:            input.DataType,
 		SingleSelectOptions: input.SingleSelectOptions,
 		Duration:            input.Duration,
+		StartDate:           input.StartDate,
 	})
 
 	var mutation graphql.CreateFieldMutation
@@ -102,8 +105,9 @@ func (s *FieldService) CreateField(ctx context.Context, input CreateFieldInput)
 // UpdateField updates an existing project field
 func (s *FieldService) UpdateField(ctx context.Context, input UpdateFieldInput) (*graphql.ProjectV2Field, error) {
 	variables := graphql.BuildUpdateFieldVariables(graphql.UpdateFieldInput{
-		FieldID: input.FieldID,
-		Name:    input.Name,
+		FieldID:             input.FieldID,
+		Name:                input.Name,
+		SingleSelectOptions: input.SingleSelectOptions,
 	})
 
 	var mutation graphql.UpdateFieldMutation
@@ -201,6 +205,33 @@ func (s *FieldService) GetProjectFields(ctx context.Context, owner string, numbe
 		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
+	return ConvertProjectFields(project), nil
+}
+
+// GetSingleSelectField fetches a single select field with all of its options
+func (s *FieldService) GetSingleSelectField(ctx context.Context, fieldID string) (*graphql.ProjectV2Field, error) {
+	var query graphql.GetSingleSelectFieldQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetSingleSelectFieldVariables(fieldID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field: %w", err)
+	}
+
+	node := &query.Node.SingleSelectField
+	if node.ID == "" {
+		return nil, fmt.Errorf("field %s is not a single select field", fieldID)
+	}
+
+	field := &graphql.ProjectV2Field{
+		ID:       node.ID,
+		Name:     node.Name,
+		DataType: graphql.ProjectV2FieldDataTypeSingleSelect,
+	}
+	field.Options.Nodes = node.Options
+	return field, nil
+}
+
+// ConvertProjectFields converts the fields of a project into FieldInfo values
+func ConvertProjectFields(project *graphql.ProjectV2) []FieldInfo {
 	fields := make([]FieldInfo, len(project.Fields.Nodes))
 	for i, field := range project.Fields.Nodes {
 		options := make([]FieldOptionInfo, len(field.Options.Nodes))
@@ -223,7 +254,7 @@ func (s *FieldService) GetProjectFields(ctx context.Context, owner string, numbe
 		}
 	}
 
-	return fields, nil
+	return fields
 }
 
 // ValidateFieldName validates a field name
@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// ComputeConfig is a set of computed fields kept up to date by 'ghp field compute'.
+// Fields are computed in order, so an expression can use the fields computed before it.
+//
+// Example computed field file:
+//
+//	fields:
+//	  - field: Days in status
+//	    expression: days_since(changed(Status))
+//	  - field: Age
+//	    expression: days_since(@created)
+//	    filter: is:open
+//	  - field: Score
+//	    expression: round(Impact * Confidence / Effort, 1)
+type ComputeConfig struct {
+	Fields []ComputedField `json:"fields" yaml:"fields"`
+}
+
+// ComputedField is a field whose value is derived from an expression. Filter limits
+// the items the field is computed for; other items are left alone.
+type ComputedField struct {
+	expression *FieldExpression
+	filter     *ItemFilter
+	Field      string `json:"field" yaml:"field"`
+	Expression string `json:"expression" yaml:"expression"`
+	Filter     string `json:"filter,omitempty" yaml:"filter,omitempty"`
+}
+
+// ComputedChange is a computed value that differs from the item's current value.
+// An empty value clears the field.
+type ComputedChange struct {
+	Field   string
+	Current string
+	Value   string
+	Item    ProjectItemInfo
+}
+
+// ComputeError is an expression that could not be evaluated for an item
+type ComputeError struct {
+	Field   string
+	Message string
+	Item    ProjectItemInfo
+}
+
+// ComputeSummary counts the items a computed field was evaluated for and the changes
+type ComputeSummary struct {
+	Field   string
+	Items   int
+	Changed int
+	Failed  int
+}
+
+// ComputePlan is the set of changes needed to bring computed fields up to date
+type ComputePlan struct {
+	Changes   []ComputedChange
+	Errors    []ComputeError
+	Summaries []ComputeSummary
+}
+
+// LoadComputeConfig reads computed fields from a YAML or JSON file
+func LoadComputeConfig(path string) (*ComputeConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read computed field file: %w", err)
+	}
+
+	return ParseComputeConfig(data)
+}
+
+// ParseComputeConfig parses and validates computed fields. An expression can only
+// use computed fields defined before it.
+func ParseComputeConfig(data []byte) (*ComputeConfig, error) {
+	config := &ComputeConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse computed field file: %w", err)
+	}
+
+	if len(config.Fields) == 0 {
+		return nil, fmt.Errorf("computed field file defines no fields")
+	}
+
+	for i := range config.Fields {
+		computed := &config.Fields[i]
+		computed.Field = strings.TrimSpace(computed.Field)
+		if computed.Field == "" {
+			return nil, fmt.Errorf("computed field %d has no field", i+1)
+		}
+		for j := 0; j < i; j++ {
+			if strings.EqualFold(config.Fields[j].Field, computed.Field) {
+				return nil, fmt.Errorf("field %s is computed twice", computed.Field)
+			}
+		}
+
+		if strings.TrimSpace(computed.Expression) == "" {
+			return nil, fmt.Errorf("computed field %s has no expression", computed.Field)
+		}
+		expression, err := ParseFieldExpression(computed.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("computed field %s has an invalid expression: %w", computed.Field, err)
+		}
+		computed.expression = expression
+
+		if computed.Filter != "" {
+			filter, err := ParseItemFilter(computed.Filter)
+			if err != nil {
+				return nil, fmt.Errorf("computed field %s has an invalid filter: %w", computed.Field, err)
+			}
+			computed.filter = filter
+		}
+	}
+
+	for i := range config.Fields {
+		computed := &config.Fields[i]
+		for _, name := range computed.expression.References() {
+			for j := i; j < len(config.Fields); j++ {
+				if strings.EqualFold(config.Fields[j].Field, name) {
+					return nil, fmt.Errorf("computed field %s uses %s, which is not computed before it", computed.Field, name)
+				}
+			}
+		}
+	}
+
+	return config, nil
+}
+
+// ValidateComputeConfig checks that computed fields are writable text, number, date or
+// single select fields of the project and that the fields their expressions use exist
+func ValidateComputeConfig(config *ComputeConfig, fields []graphql.ProjectV2Field) error {
+	for i := range config.Fields {
+		computed := &config.Fields[i]
+
+		if IsReadOnlyField(computed.Field) {
+			return fmt.Errorf("computed field %s is read-only", computed.Field)
+		}
+		field, err := FindProjectField(fields, computed.Field)
+		if err != nil {
+			return err
+		}
+		switch field.DataType {
+		case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber,
+			graphql.ProjectV2FieldDataTypeDate, graphql.ProjectV2FieldDataTypeSingleSelect:
+		default:
+			return fmt.Errorf("computed field %s is a %s field (expected text, number, date or single select)",
+				field.Name, FormatFieldDataType(field.DataType))
+		}
+
+		for _, name := range computed.expression.References() {
+			if _, err := FindProjectField(fields, name); err != nil {
+				return fmt.Errorf("computed field %s: %w", computed.Field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// PlanFieldCompute evaluates the computed fields for every unarchived item and returns
+// the values that differ from the current ones. Items keep the values computed for them
+// earlier in the plan, so later expressions see them; fields using a value that could
+// not be computed are not computed either.
+func PlanFieldCompute(config *ComputeConfig, fields []graphql.ProjectV2Field, items []ProjectItemInfo, now time.Time) (*ComputePlan, error) {
+	plan := &ComputePlan{Summaries: make([]ComputeSummary, len(config.Fields))}
+
+	targets := make([]*graphql.ProjectV2Field, len(config.Fields))
+	references := make([][]string, len(config.Fields))
+	for i := range config.Fields {
+		plan.Summaries[i].Field = config.Fields[i].Field
+		field, err := FindProjectField(fields, config.Fields[i].Field)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = field
+		references[i] = config.Fields[i].expression.References()
+	}
+
+	for i := range items {
+		if items[i].Archived {
+			continue
+		}
+
+		item := items[i]
+		item.FieldValues = make(map[string]string, len(items[i].FieldValues))
+		for name, value := range items[i].FieldValues {
+			item.FieldValues[name] = value
+		}
+
+		var failed []string
+		for j := range config.Fields {
+			computed := &config.Fields[j]
+			if computed.filter != nil && !MatchesItemFilter(&item, computed.filter) {
+				continue
+			}
+			plan.Summaries[j].Items++
+
+			value, err := computeFieldValue(computed, targets[j], &item, now)
+			for _, name := range references[j] {
+				if containsFold(failed, name) {
+					err = fmt.Errorf("uses %s, which could not be computed", name)
+					break
+				}
+			}
+			if err != nil {
+				plan.Errors = append(plan.Errors, ComputeError{Field: targets[j].Name, Message: err.Error(), Item: items[i]})
+				plan.Summaries[j].Failed++
+				failed = append(failed, targets[j].Name)
+				continue
+			}
+
+			current := fieldValue(&item, targets[j].Name)
+			if computedValueEqual(targets[j], current, value) {
+				continue
+			}
+
+			plan.Changes = append(plan.Changes, ComputedChange{Field: targets[j].Name, Current: current, Value: value, Item: items[i]})
+			plan.Summaries[j].Changed++
+			setItemFieldValue(&item, targets[j].Name, value)
+		}
+	}
+
+	return plan, nil
+}
+
+// ApplyFieldCompute writes the changes of a compute plan
+func (s *ItemService) ApplyFieldCompute(ctx context.Context, projectID string, fields []graphql.ProjectV2Field, plan *ComputePlan) *BulkUpdateResult {
+	result := &BulkUpdateResult{}
+	projectService := NewProjectService(s.client)
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+
+		field, err := FindProjectField(fields, change.Field)
+		if err == nil {
+			if change.Value == "" {
+				err = projectService.ClearItemField(ctx, ClearItemFieldInput{
+					ProjectID: projectID,
+					ItemID:    change.Item.ItemID,
+					FieldID:   field.ID,
+				})
+			} else {
+				var value map[string]interface{}
+				if value, err = BuildFieldValue(field, change.Value); err == nil {
+					err = s.SetItemFieldValues(ctx, projectID, change.Item.ItemID, map[string]interface{}{field.ID: value})
+				}
+			}
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s %s: %v", itemLabel(&change.Item), change.Field, err))
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	return result
+}
+
+// computeFieldValue evaluates a computed field for an item and formats the result for the field's type
+func computeFieldValue(computed *ComputedField, field *graphql.ProjectV2Field, item *ProjectItemInfo, now time.Time) (string, error) {
+	value, err := computed.expression.Evaluate(item, now)
+	if err != nil || value == nil {
+		return "", err
+	}
+
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeNumber:
+		number, _, err := exprNumber(value)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case graphql.ProjectV2FieldDataTypeDate:
+		formatted := FormatExprValue(value)
+		if _, err := time.Parse(dateLayout, formatted); err != nil {
+			return "", fmt.Errorf("not a date: %q", formatted)
+		}
+		return formatted, nil
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		formatted := FormatExprValue(value)
+		for _, option := range field.Options.Nodes {
+			if strings.EqualFold(option.Name, formatted) {
+				return option.Name, nil
+			}
+		}
+		return "", fmt.Errorf("option '%s' not found in field '%s'", formatted, field.Name)
+	default:
+		return FormatExprValue(value), nil
+	}
+}
+
+// computedValueEqual compares numbers numerically and options case-insensitively
+func computedValueEqual(field *graphql.ProjectV2Field, current, value string) bool {
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeNumber:
+		a, errA := strconv.ParseFloat(current, 64)
+		b, errB := strconv.ParseFloat(value, 64)
+		if errA == nil && errB == nil {
+			return a == b
+		}
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		return strings.EqualFold(current, value)
+	}
+	return current == value
+}
+
+// setItemFieldValue sets or, for an empty value, removes a field value of an item
+func setItemFieldValue(item *ProjectItemInfo, name, value string) {
+	for existing := range item.FieldValues {
+		if strings.EqualFold(existing, name) {
+			delete(item.FieldValues, existing)
+		}
+	}
+	if value != "" {
+		item.FieldValues[name] = value
+	}
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+const testComputedFields = `
+fields:
+  - field: Score
+    expression: round(Impact * Confidence / Effort, 1)
+  - field: Risk
+    expression: if(Score >= 1, "High", "Low")
+  - field: Age
+    expression: days_since(@created)
+    filter: is:open
+`
+
+func computeProjectFields() []graphql.ProjectV2Field {
+	risk := graphql.ProjectV2Field{ID: "f-risk", Name: "Risk", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+	risk.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{{ID: "o-high", Name: "High"}, {ID: "o-low", Name: "Low"}}
+	return []graphql.ProjectV2Field{
+		{ID: "f-title", Name: "Title", DataType: "TITLE"},
+		{ID: "f-impact", Name: "Impact", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-confidence", Name: "Confidence", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-effort", Name: "Effort", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-score", Name: "Score", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-age", Name: "Age", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-sprint", Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration},
+		risk,
+	}
+}
+
+func TestParseComputeConfig(t *testing.T) {
+	t.Run("Parses computed fields", func(t *testing.T) {
+		config, err := ParseComputeConfig([]byte(testComputedFields))
+
+		require.NoError(t, err)
+		require.Len(t, config.Fields, 3)
+		assert.Equal(t, "Score", config.Fields[0].Field)
+		assert.Equal(t, "is:open", config.Fields[2].Filter)
+	})
+
+	t.Run("Invalid computed fields return errors", func(t *testing.T) {
+		tests := []struct {
+			data    string
+			message string
+		}{
+			{"fields: []", "defines no fields"},
+			{"fields: [{expression: '1'}]", "has no field"},
+			{"fields: [{field: Score}]", "has no expression"},
+			{"fields: [{field: Score, expression: 'Impact *'}]", "invalid expression"},
+			{"fields: [{field: Score, expression: '1', filter: 'updated:<@today-2x'}]", "invalid filter"},
+			{"fields: [{field: Score, expression: '1'}, {field: score, expression: '2'}]", "computed twice"},
+			{"fields: [{field: Score, expression: 'Score + 1'}]", "uses Score, which is not computed before it"},
+			{"fields: [{field: Risk, expression: 'Score'}, {field: Score, expression: '1'}]", "uses Score"},
+			{"fields: {", "failed to parse"},
+		}
+
+		for _, tt := range tests {
+			_, err := ParseComputeConfig([]byte(tt.data))
+			assert.ErrorContains(t, err, tt.message)
+		}
+	})
+}
+
+func TestValidateComputeConfig(t *testing.T) {
+	validate := func(data string) error {
+		config, err := ParseComputeConfig([]byte(data))
+		require.NoError(t, err)
+		return ValidateComputeConfig(config, computeProjectFields())
+	}
+
+	assert.NoError(t, validate(testComputedFields))
+	assert.ErrorContains(t, validate("fields: [{field: Size, expression: '1'}]"), "field 'Size' not found")
+	assert.ErrorContains(t, validate("fields: [{field: Title, expression: '1'}]"), "is read-only")
+	assert.ErrorContains(t, validate("fields: [{field: Sprint, expression: '1'}]"), "is a Iteration field")
+	assert.ErrorContains(t, validate("fields: [{field: Score, expression: 'Value * 2'}]"), "field 'Value' not found")
+}
+
+func TestPlanFieldCompute(t *testing.T) {
+	config, err := ParseComputeConfig([]byte(testComputedFields))
+	require.NoError(t, err)
+
+	now := time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", Title: "Up to date", State: "OPEN", CreatedAt: created,
+			FieldValues: map[string]string{"Impact": "4", "Confidence": "0.5", "Effort": "2", "Score": "1.0", "Risk": "high", "Age": "13"}},
+		{ItemID: "item-2", Title: "Stale", State: "CLOSED", CreatedAt: created,
+			FieldValues: map[string]string{"Impact": "1", "Confidence": "0.5", "Effort": "2", "Score": "4", "Age": "2"}},
+		{ItemID: "item-3", Title: "Missing effort", State: "OPEN", CreatedAt: created,
+			FieldValues: map[string]string{"Impact": "1", "Score": "2", "Risk": "High"}},
+		{ItemID: "item-4", Title: "Broken", State: "OPEN", CreatedAt: created,
+			FieldValues: map[string]string{"Impact": "lots", "Confidence": "1", "Effort": "1", "Age": "13"}},
+		{ItemID: "item-5", Title: "Archived", Archived: true, FieldValues: map[string]string{"Impact": "1"}},
+	}
+
+	plan, err := PlanFieldCompute(config, computeProjectFields(), items, now)
+	require.NoError(t, err)
+
+	var changes []string
+	for _, change := range plan.Changes {
+		changes = append(changes, change.Item.ItemID+" "+change.Field+": "+change.Current+" → "+change.Value)
+	}
+	assert.Equal(t, []string{
+		"item-2 Score: 4 → 0.3",
+		"item-2 Risk:  → Low",
+		"item-3 Score: 2 → ",
+		"item-3 Risk: High → Low",
+		"item-3 Age:  → 13",
+	}, changes)
+
+	require.Len(t, plan.Errors, 2)
+	assert.Equal(t, "item-4", plan.Errors[0].Item.ItemID)
+	assert.Contains(t, plan.Errors[0].Message, `not a number: "lots"`)
+	assert.Equal(t, "Risk", plan.Errors[1].Field)
+
+	assert.Equal(t, ComputeSummary{Field: "Score", Items: 4, Changed: 2, Failed: 1}, plan.Summaries[0])
+	assert.Equal(t, ComputeSummary{Field: "Age", Items: 3, Changed: 1}, plan.Summaries[2])
+	assert.Equal(t, "4", items[1].FieldValues["Score"])
+}
+
+func TestApplyFieldCompute(t *testing.T) {
+	t.Run("Reports failed updates", func(t *testing.T) {
+		itemService := NewItemService(api.NewClient("invalid-token"))
+		plan := &ComputePlan{Changes: []ComputedChange{
+			{Field: "Score", Value: "1.5", Item: ProjectItemInfo{ItemID: "item-1", Title: "One"}},
+			{Field: "Score", Value: "", Item: ProjectItemInfo{ItemID: "item-2", Title: "Two"}},
+			{Field: "Risk", Value: "Extreme", Item: ProjectItemInfo{ItemID: "item-3", Title: "Three"}},
+		}}
+
+		result := itemService.ApplyFieldCompute(context.Background(), "project-1", computeProjectFields(), plan)
+
+		assert.Equal(t, 0, result.Updated)
+		assert.Equal(t, 3, result.Failed)
+		assert.Contains(t, result.Errors[1], "failed to clear item field")
+		assert.Contains(t, result.Errors[2], "option 'Extreme' not found")
+	})
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// conversionDateLayouts are the date formats accepted when converting values to dates.
+// Slash-separated dates with the year last are read as month/day/year.
+var conversionDateLayouts = []string{
+	dateLayout,
+	"2006/01/02",
+	"2006.01.02",
+	time.RFC3339,
+	"01/02/2006",
+	"1/2/2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+}
+
+// FieldConversion is an item's value converted to another field type.
+// Error explains why the value cannot be converted.
+type FieldConversion struct {
+	Item  ProjectItemInfo
+	From  string
+	To    string
+	Error string
+}
+
+// FieldConversionPlan describes how the values of a field are migrated to a field of another type
+type FieldConversionPlan struct {
+	Field         *graphql.ProjectV2Field
+	Target        graphql.ProjectV2FieldDataType
+	Options       []string
+	Conversions   []FieldConversion
+	Unconvertible []FieldConversion
+}
+
+// ValidateFieldConversion checks that a field can be converted to the target type
+func ValidateFieldConversion(field *graphql.ProjectV2Field, target graphql.ProjectV2FieldDataType) error {
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber, graphql.ProjectV2FieldDataTypeDate,
+		graphql.ProjectV2FieldDataTypeSingleSelect, graphql.ProjectV2FieldDataTypeIteration:
+	default:
+		return fmt.Errorf("field '%s' cannot be converted: %s fields are built in", field.Name, FormatFieldDataType(field.DataType))
+	}
+
+	switch target {
+	case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber,
+		graphql.ProjectV2FieldDataTypeDate, graphql.ProjectV2FieldDataTypeSingleSelect:
+	default:
+		return fmt.Errorf("cannot convert to %s fields (expected text, number, date or single_select)", FormatFieldDataType(target))
+	}
+
+	if field.DataType == target {
+		return fmt.Errorf("field '%s' is already a %s field", field.Name, FormatFieldDataType(target))
+	}
+
+	return nil
+}
+
+// PlanFieldConversion converts every item's value of a field to the target type.
+// Items without a value are left out. For single select targets the options are
+// derived from the converted values.
+func PlanFieldConversion(items []ProjectItemInfo, field *graphql.ProjectV2Field, target graphql.ProjectV2FieldDataType) (*FieldConversionPlan, error) {
+	if err := ValidateFieldConversion(field, target); err != nil {
+		return nil, err
+	}
+
+	plan := &FieldConversionPlan{Field: field, Target: target}
+
+	for i := range items {
+		item := &items[i]
+		value := fieldValue(item, field.Name)
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+
+		conversion := FieldConversion{Item: *item, From: value}
+		converted, err := ConvertFieldValue(value, target)
+		if err != nil {
+			conversion.Error = err.Error()
+			plan.Unconvertible = append(plan.Unconvertible, conversion)
+			continue
+		}
+
+		conversion.To = converted
+		plan.Conversions = append(plan.Conversions, conversion)
+	}
+
+	if target == graphql.ProjectV2FieldDataTypeSingleSelect {
+		values := make([]string, len(plan.Conversions))
+		for i := range plan.Conversions {
+			values[i] = plan.Conversions[i].To
+		}
+		plan.Options = deriveSelectOptions(values)
+	}
+
+	return plan, nil
+}
+
+// ConvertFieldValue converts a displayed field value to the representation of the target type
+func ConvertFieldValue(value string, target graphql.ProjectV2FieldDataType) (string, error) {
+	value = strings.TrimSpace(value)
+
+	switch target {
+	case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeSingleSelect:
+		return value, nil
+	case graphql.ProjectV2FieldDataTypeNumber:
+		number, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+		if err != nil {
+			return "", fmt.Errorf("not a number")
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case graphql.ProjectV2FieldDataTypeDate:
+		for _, layout := range conversionDateLayouts {
+			if date, err := time.Parse(layout, value); err == nil {
+				return date.Format(dateLayout), nil
+			}
+		}
+		return "", fmt.Errorf("not a recognized date")
+	default:
+		return "", fmt.Errorf("unsupported target type: %s", target)
+	}
+}
+
+// ApplyFieldConversion sets the converted values on the new field, continuing past failures
+func (s *ItemService) ApplyFieldConversion(ctx context.Context, projectID string, field *graphql.ProjectV2Field, plan *FieldConversionPlan) *BulkUpdateResult {
+	result := &BulkUpdateResult{}
+
+	for i := range plan.Conversions {
+		conversion := &plan.Conversions[i]
+
+		value, err := BuildFieldValue(field, conversion.To)
+		if err == nil {
+			err = s.SetItemFieldValues(ctx, projectID, conversion.Item.ItemID, map[string]interface{}{field.ID: value})
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemLabel(&conversion.Item), err))
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	return result
+}
+
+// deriveSelectOptions returns the distinct values (case-insensitive) as options,
+// in numeric order when all values are numbers and alphabetical order otherwise
+func deriveSelectOptions(values []string) []string {
+	seen := make(map[string]bool)
+	var options []string
+	numeric := true
+
+	for _, value := range values {
+		key := strings.ToLower(value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		options = append(options, value)
+
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			numeric = false
+		}
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		if numeric {
+			a, _ := strconv.ParseFloat(options[i], 64)
+			b, _ := strconv.ParseFloat(options[j], 64)
+			return a < b
+		}
+		return strings.ToLower(options[i]) < strings.ToLower(options[j])
+	})
+
+	return options
+}
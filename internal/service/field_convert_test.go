@@ -0,0 +1,118 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func testConversionItems() []ProjectItemInfo {
+	return []ProjectItemInfo{
+		{ItemID: "item-1", Title: "Login", FieldValues: map[string]string{"Estimate": "3"}},
+		{ItemID: "item-2", Title: "Signup", FieldValues: map[string]string{"Estimate": "1,200"}},
+		{ItemID: "item-3", Title: "Search", FieldValues: map[string]string{"Estimate": "large"}},
+		{ItemID: "item-4", Title: "Docs", FieldValues: map[string]string{"Estimate": "3"}},
+		{ItemID: "item-5", Title: "Billing", FieldValues: map[string]string{}},
+		{ItemID: "item-6", Title: "Archived", Archived: true, FieldValues: map[string]string{"Estimate": "10"}},
+	}
+}
+
+func TestValidateFieldConversion(t *testing.T) {
+	t.Run("Accepts custom fields and supported targets", func(t *testing.T) {
+		field := &graphql.ProjectV2Field{Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeText}
+		assert.NoError(t, ValidateFieldConversion(field, graphql.ProjectV2FieldDataTypeNumber))
+		assert.NoError(t, ValidateFieldConversion(field, graphql.ProjectV2FieldDataTypeSingleSelect))
+	})
+
+	t.Run("Rejects same type, iteration targets and built-in fields", func(t *testing.T) {
+		text := &graphql.ProjectV2Field{Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeText}
+		assert.Error(t, ValidateFieldConversion(text, graphql.ProjectV2FieldDataTypeText))
+		assert.Error(t, ValidateFieldConversion(text, graphql.ProjectV2FieldDataTypeIteration))
+
+		assignees := &graphql.ProjectV2Field{Name: "Assignees", DataType: "ASSIGNEES"}
+		assert.Error(t, ValidateFieldConversion(assignees, graphql.ProjectV2FieldDataTypeText))
+	})
+}
+
+func TestConvertFieldValue(t *testing.T) {
+	t.Run("Converts numbers", func(t *testing.T) {
+		value, err := ConvertFieldValue(" 1,200.50 ", graphql.ProjectV2FieldDataTypeNumber)
+		require.NoError(t, err)
+		assert.Equal(t, "1200.5", value)
+
+		_, err = ConvertFieldValue("large", graphql.ProjectV2FieldDataTypeNumber)
+		assert.Error(t, err)
+	})
+
+	t.Run("Converts dates in common formats", func(t *testing.T) {
+		for _, input := range []string{"2025-03-07", "2025/03/07", "03/07/2025", "3/7/2025", "Mar 7, 2025", "7 March 2025", "2025-03-07T10:00:00Z"} {
+			value, err := ConvertFieldValue(input, graphql.ProjectV2FieldDataTypeDate)
+			require.NoError(t, err, input)
+			assert.Equal(t, "2025-03-07", value, input)
+		}
+
+		_, err := ConvertFieldValue("next week", graphql.ProjectV2FieldDataTypeDate)
+		assert.Error(t, err)
+	})
+
+	t.Run("Keeps text for text and single select targets", func(t *testing.T) {
+		value, err := ConvertFieldValue(" High ", graphql.ProjectV2FieldDataTypeSingleSelect)
+		require.NoError(t, err)
+		assert.Equal(t, "High", value)
+	})
+}
+
+func TestPlanFieldConversion(t *testing.T) {
+	field := &graphql.ProjectV2Field{ID: "field-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeText}
+
+	t.Run("Reports unconvertible values and skips empty ones", func(t *testing.T) {
+		plan, err := PlanFieldConversion(testConversionItems(), field, graphql.ProjectV2FieldDataTypeNumber)
+		require.NoError(t, err)
+
+		assert.Len(t, plan.Conversions, 4)
+		assert.Equal(t, "1200", plan.Conversions[1].To)
+		assert.Equal(t, "item-6", plan.Conversions[3].Item.ItemID, "archived items are converted too")
+		require.Len(t, plan.Unconvertible, 1)
+		assert.Equal(t, "large", plan.Unconvertible[0].From)
+		assert.Equal(t, "not a number", plan.Unconvertible[0].Error)
+		assert.Empty(t, plan.Options)
+	})
+
+	t.Run("Derives single select options from values", func(t *testing.T) {
+		items := []ProjectItemInfo{
+			{ItemID: "item-1", FieldValues: map[string]string{"Estimate": "medium"}},
+			{ItemID: "item-2", FieldValues: map[string]string{"Estimate": "Large"}},
+			{ItemID: "item-3", FieldValues: map[string]string{"Estimate": "large"}},
+			{ItemID: "item-4", FieldValues: map[string]string{"Estimate": "Small"}},
+		}
+
+		plan, err := PlanFieldConversion(items, field, graphql.ProjectV2FieldDataTypeSingleSelect)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Large", "medium", "Small"}, plan.Options)
+		assert.Len(t, plan.Conversions, 4)
+		assert.Empty(t, plan.Unconvertible)
+	})
+
+	t.Run("Orders numeric options numerically", func(t *testing.T) {
+		number := &graphql.ProjectV2Field{Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber}
+		items := []ProjectItemInfo{
+			{ItemID: "item-1", FieldValues: map[string]string{"Estimate": "10"}},
+			{ItemID: "item-2", FieldValues: map[string]string{"Estimate": "2"}},
+			{ItemID: "item-3", FieldValues: map[string]string{"Estimate": "3"}},
+		}
+
+		plan, err := PlanFieldConversion(items, number, graphql.ProjectV2FieldDataTypeSingleSelect)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"2", "3", "10"}, plan.Options)
+	})
+
+	t.Run("Rejects invalid conversions", func(t *testing.T) {
+		_, err := PlanFieldConversion(testConversionItems(), field, graphql.ProjectV2FieldDataTypeText)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// SkippedField is a field that is not copied, with the reason
+type SkippedField struct {
+	Name   string
+	Reason string
+}
+
+// FieldCopyPlan is the set of changes needed to copy fields to another project
+type FieldCopyPlan struct {
+	Schema  *FieldSchema
+	Plan    *SchemaPlan
+	Skipped []SkippedField
+}
+
+// BuildCopySchema describes the custom fields and the Status field of a project as a
+// schema, keeping option order, colors and descriptions. Iteration fields take the
+// duration and the start of the current iteration from their schedule (keyed by field
+// ID). When names is not empty only those fields are described, in that order.
+func BuildCopySchema(fields []FieldInfo, schedules map[string]*IterationSchedule, names []string) (*FieldSchema, error) {
+	var selected []*FieldInfo
+	if len(names) == 0 {
+		for i := range fields {
+			if isCopyableField(&fields[i]) {
+				selected = append(selected, &fields[i])
+			}
+		}
+	} else {
+		for _, name := range names {
+			field := findFieldInfo(fields, name)
+			if field == nil {
+				return nil, fmt.Errorf("field '%s' not found in the source project", name)
+			}
+			if !isCopyableField(field) {
+				return nil, fmt.Errorf("field '%s' is a built-in field and cannot be copied", field.Name)
+			}
+			selected = append(selected, field)
+		}
+	}
+
+	schema := &FieldSchema{}
+	for _, field := range selected {
+		spec := FieldSpec{Name: field.Name, Type: string(field.DataType)}
+
+		for _, option := range field.Options {
+			optionSpec := OptionSpec{Name: option.Name, Color: NormalizeColor(option.Color)}
+			if option.Description != nil && *option.Description != "" {
+				description := *option.Description
+				optionSpec.Description = &description
+			}
+			spec.Options = append(spec.Options, optionSpec)
+		}
+
+		if schedule, ok := schedules[field.ID]; ok {
+			startDate := schedule.StartDate
+			for _, iteration := range schedule.Iterations {
+				if !iteration.Completed {
+					startDate = iteration.StartDate
+					break
+				}
+			}
+			spec.Iteration = &IterationSpec{
+				Duration:  fmt.Sprintf("%dd", schedule.Duration),
+				StartDate: startDate.Format(dateLayout),
+			}
+		}
+
+		schema.Fields = append(schema.Fields, spec)
+	}
+
+	if len(schema.Fields) == 0 {
+		return nil, fmt.Errorf("the source project has no fields to copy")
+	}
+
+	return schema, nil
+}
+
+// PlanFieldCopy plans copying a schema to a project. Fields that exist in the
+// destination (by name) are skipped unless merge is set, in which case missing options
+// are added and option colors, descriptions and iteration durations are updated.
+// Nothing is deleted. Fields whose type differs in the destination are always skipped.
+func PlanFieldCopy(schema *FieldSchema, fields []FieldInfo, iterationDurations map[string]int, merge bool) (*FieldCopyPlan, error) {
+	plan := &FieldCopyPlan{Schema: &FieldSchema{}}
+
+	for i := range schema.Fields {
+		spec := schema.Fields[i]
+
+		existing := findFieldInfo(fields, spec.Name)
+		switch {
+		case existing == nil:
+		case string(existing.DataType) != spec.Type:
+			plan.Skipped = append(plan.Skipped, SkippedField{
+				Name: spec.Name,
+				Reason: fmt.Sprintf("is a %s field in the destination but %s in the source",
+					FormatFieldDataType(existing.DataType), FormatFieldDataType(graphql.ProjectV2FieldDataType(spec.Type))),
+			})
+			continue
+		case !merge:
+			plan.Skipped = append(plan.Skipped, SkippedField{Name: spec.Name, Reason: "already exists (use --merge to update it)"})
+			continue
+		}
+
+		plan.Schema.Fields = append(plan.Schema.Fields, spec)
+	}
+
+	schemaPlan, err := PlanFieldSchema(plan.Schema, fields, iterationDurations, false)
+	if err != nil {
+		return nil, err
+	}
+	// Fields and options that only exist in the destination are left alone
+	schemaPlan.Unmanaged = nil
+	plan.Plan = schemaPlan
+
+	return plan, nil
+}
+
+// ReorderFieldOptions moves the named options (case-insensitive) to the front of a
+// single select field in the given order, followed by the remaining options. It
+// reports whether the order changed.
+func (s *FieldService) ReorderFieldOptions(ctx context.Context, field *graphql.ProjectV2Field, names []string) (bool, error) {
+	current := field.Options.Nodes
+	ordered := make([]graphql.ProjectV2SingleSelectFieldOption, 0, len(current))
+	placed := make(map[string]bool, len(current))
+
+	for _, name := range names {
+		for _, option := range current {
+			if !placed[option.ID] && strings.EqualFold(option.Name, name) {
+				ordered = append(ordered, option)
+				placed[option.ID] = true
+				break
+			}
+		}
+	}
+	for _, option := range current {
+		if !placed[option.ID] {
+			ordered = append(ordered, option)
+		}
+	}
+
+	changed := false
+	for i := range ordered {
+		if ordered[i].ID != current[i].ID {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	options := make([]graphql.SingleSelectOptionInput, len(ordered))
+	for i, option := range ordered {
+		options[i] = graphql.SingleSelectOptionInput{
+			ID:          option.ID,
+			Name:        option.Name,
+			Color:       option.Color,
+			Description: derefString(option.Description),
+		}
+	}
+
+	if _, err := s.UpdateField(ctx, UpdateFieldInput{FieldID: field.ID, SingleSelectOptions: options}); err != nil {
+		return false, fmt.Errorf("failed to reorder options of %s: %w", field.Name, err)
+	}
+
+	return true, nil
+}
+
+// isCopyableField reports whether a field's definition can be copied to another project.
+// Besides custom fields this includes Status, whose options can be merged.
+func isCopyableField(field *FieldInfo) bool {
+	return isCustomField(field) || field.DataType == graphql.ProjectV2FieldDataTypeSingleSelect
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func copySourceFields() []FieldInfo {
+	urgent := "Drop everything"
+	return []FieldInfo{
+		{ID: "f-title", Name: "Title", DataType: "TITLE"},
+		{ID: "f-status", Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-backlog", Name: "Backlog", Color: "GRAY"},
+			{ID: "o-todo", Name: "Todo", Color: "BLUE"},
+			{ID: "o-done", Name: "Done", Color: "GREEN"},
+		}},
+		{ID: "f-priority", Name: "Priority", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-p0", Name: "P0", Color: "RED", Description: &urgent},
+			{ID: "o-p1", Name: "P1", Color: "ORANGE"},
+		}},
+		{ID: "f-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-sprint", Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration},
+	}
+}
+
+func TestBuildCopySchema(t *testing.T) {
+	schedules := map[string]*IterationSchedule{
+		"f-sprint": {
+			StartDate: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			Duration:  14,
+			Iterations: []Iteration{
+				{Title: "Sprint 1", StartDate: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), Completed: true},
+				{Title: "Sprint 2", StartDate: time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	t.Run("Describes custom fields and Status", func(t *testing.T) {
+		schema, err := BuildCopySchema(copySourceFields(), schedules, nil)
+		require.NoError(t, err)
+
+		require.Len(t, schema.Fields, 4)
+		assert.Equal(t, "Status", schema.Fields[0].Name)
+		assert.Equal(t, []string{"Backlog", "Todo", "Done"}, optionSpecNames(schema.Fields[0].Options))
+
+		priority := schema.Fields[1]
+		assert.Equal(t, "SINGLE_SELECT", priority.Type)
+		assert.Equal(t, "RED", priority.Options[0].Color)
+		assert.Equal(t, "Drop everything", *priority.Options[0].Description)
+		assert.Nil(t, priority.Options[1].Description)
+
+		sprint := schema.Fields[3]
+		require.NotNil(t, sprint.Iteration)
+		assert.Equal(t, "14d", sprint.Iteration.Duration)
+		assert.Equal(t, "2025-01-20", sprint.Iteration.StartDate)
+	})
+
+	t.Run("Selects fields by name in the given order", func(t *testing.T) {
+		schema, err := BuildCopySchema(copySourceFields(), schedules, []string{"estimate", "Priority"})
+		require.NoError(t, err)
+
+		require.Len(t, schema.Fields, 2)
+		assert.Equal(t, "Estimate", schema.Fields[0].Name)
+		assert.Equal(t, "Priority", schema.Fields[1].Name)
+	})
+
+	t.Run("Rejects unknown and built-in fields", func(t *testing.T) {
+		_, err := BuildCopySchema(copySourceFields(), schedules, []string{"Size"})
+		assert.ErrorContains(t, err, "field 'Size' not found")
+
+		_, err = BuildCopySchema(copySourceFields(), schedules, []string{"Title"})
+		assert.ErrorContains(t, err, "built-in field")
+	})
+}
+
+func TestPlanFieldCopy(t *testing.T) {
+	schema, err := BuildCopySchema(copySourceFields(), nil, []string{"Status", "Priority", "Estimate"})
+	require.NoError(t, err)
+
+	destination := []FieldInfo{
+		{ID: "d-status", Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "d-todo", Name: "Todo", Color: "GRAY"},
+			{ID: "d-done", Name: "Done", Color: "GREEN"},
+			{ID: "d-wontfix", Name: "Won't fix", Color: "GRAY"},
+		}},
+		{ID: "d-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeText},
+	}
+
+	t.Run("Skips existing fields without merge", func(t *testing.T) {
+		plan, err := PlanFieldCopy(schema, destination, nil, false)
+		require.NoError(t, err)
+
+		require.Len(t, plan.Skipped, 2)
+		assert.Equal(t, "Status", plan.Skipped[0].Name)
+		assert.Contains(t, plan.Skipped[0].Reason, "--merge")
+		assert.Equal(t, "Estimate", plan.Skipped[1].Name)
+		assert.Contains(t, plan.Skipped[1].Reason, "Text field in the destination")
+
+		require.Len(t, plan.Plan.Operations, 1)
+		assert.Equal(t, SchemaCreateField, plan.Plan.Operations[0].Kind)
+		assert.Equal(t, "Priority", plan.Plan.Operations[0].Field)
+	})
+
+	t.Run("Merges options into existing fields without deleting", func(t *testing.T) {
+		plan, err := PlanFieldCopy(schema, destination, nil, true)
+		require.NoError(t, err)
+
+		require.Len(t, plan.Skipped, 1)
+		assert.Empty(t, plan.Plan.Unmanaged)
+
+		var kinds []string
+		for i := range plan.Plan.Operations {
+			kinds = append(kinds, plan.Plan.Operations[i].Kind+" "+DescribeSchemaOperation(&plan.Plan.Operations[i]))
+		}
+		assert.Equal(t, []string{
+			"create-field create field Priority (Single Select): P0, P1",
+			"add-option add option Backlog to Status (Gray)",
+			"update-option update option Todo in Status: color Gray → Blue",
+			"reorder-options reorder options of Status: Backlog, Todo, Done, Won't fix",
+		}, kinds)
+	})
+}
+
+func TestReorderFieldOptions(t *testing.T) {
+	t.Run("Leaves options that are already in order alone", func(t *testing.T) {
+		fieldService := NewFieldService(api.NewClient("invalid-token"))
+		field := &graphql.ProjectV2Field{ID: "field-1", Name: "Status"}
+		field.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{
+			{ID: "o-1", Name: "Backlog"}, {ID: "o-2", Name: "Todo"}, {ID: "o-3", Name: "Extra"},
+		}
+
+		changed, err := fieldService.ReorderFieldOptions(context.Background(), field, []string{"backlog", "Todo"})
+
+		require.NoError(t, err)
+		assert.False(t, changed)
+	})
+
+	t.Run("Reports update failures", func(t *testing.T) {
+		fieldService := NewFieldService(api.NewClient("invalid-token"))
+		field := &graphql.ProjectV2Field{ID: "field-1", Name: "Status"}
+		field.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{
+			{ID: "o-2", Name: "Todo"}, {ID: "o-1", Name: "Backlog"},
+		}
+
+		changed, err := fieldService.ReorderFieldOptions(context.Background(), field, []string{"Backlog", "Todo"})
+
+		assert.ErrorContains(t, err, "failed to reorder options of Status")
+		assert.False(t, changed)
+	})
+}
+
+func optionSpecNames(options []OptionSpec) []string {
+	names := make([]string, len(options))
+	for i := range options {
+		names[i] = options[i].Name
+	}
+	return names
+}
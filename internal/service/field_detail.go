@@ -0,0 +1,207 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// jsonSchemaDialect is the JSON Schema version of generated schemas
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// datePattern matches the YYYY-MM-DD dates date fields accept
+const datePattern = `^\d{4}-\d{2}-\d{2}$`
+
+// Ways a view can use a field
+const (
+	ViewUsageGroup  = "group"
+	ViewUsageColumn = "column"
+	ViewUsageSort   = "sort"
+)
+
+// FieldViewUsage is a view that groups, lays out board columns or sorts by a field
+type FieldViewUsage struct {
+	View      string
+	Usage     string
+	Direction graphql.ProjectV2ViewSortDirection
+}
+
+// FieldDetail is a field with its iteration configuration and the views that use it
+type FieldDetail struct {
+	Iterations *IterationSchedule
+	Field      FieldInfo
+	Views      []FieldViewUsage
+}
+
+// BuildFieldDetails combines fields with their iteration schedules (keyed by field ID)
+// and the views that group or sort by them, keeping the fields' order
+func BuildFieldDetails(fields []FieldInfo, schedules map[string]*IterationSchedule, views []ViewInfo) []FieldDetail {
+	details := make([]FieldDetail, len(fields))
+	for i := range fields {
+		details[i] = FieldDetail{Field: fields[i], Iterations: schedules[fields[i].ID]}
+	}
+
+	usedBy := func(fieldID, fieldName string) *FieldDetail {
+		for i := range details {
+			if details[i].Field.ID == fieldID || (fieldID == "" && strings.EqualFold(details[i].Field.Name, fieldName)) {
+				return &details[i]
+			}
+		}
+		return nil
+	}
+
+	for i := range views {
+		view := &views[i]
+		for _, group := range view.GroupBy {
+			if detail := usedBy(group.FieldID, group.FieldName); detail != nil {
+				detail.Views = append(detail.Views, FieldViewUsage{View: view.Name, Usage: ViewUsageGroup, Direction: group.Direction})
+			}
+		}
+		for _, group := range view.VerticalGroupBy {
+			if detail := usedBy(group.FieldID, group.FieldName); detail != nil {
+				detail.Views = append(detail.Views, FieldViewUsage{View: view.Name, Usage: ViewUsageColumn, Direction: group.Direction})
+			}
+		}
+		for _, sort := range view.SortBy {
+			if detail := usedBy(sort.FieldID, sort.FieldName); detail != nil {
+				detail.Views = append(detail.Views, FieldViewUsage{View: view.Name, Usage: ViewUsageSort, Direction: sort.Direction})
+			}
+		}
+	}
+
+	return details
+}
+
+// BuildItemValueSchema returns a JSON Schema for the field values of a project's items:
+// an object keyed by field name holding the values 'ghp' accepts for every field that
+// can be set. Single select and iteration fields list their options and iterations
+// (from schedules, keyed by field ID). Field rules without a condition narrow the schema
+// with allowed values, numeric bounds, patterns and required fields; date bounds and
+// conditional rules cannot be expressed and are left out.
+func BuildItemValueSchema(title string, fields []FieldInfo, schedules map[string]*IterationSchedule, rules []FieldRule) map[string]interface{} {
+	properties := make(map[string]interface{})
+	var required []string
+
+	for i := range fields {
+		field := &fields[i]
+		if IsReadOnlyField(field.Name) {
+			continue
+		}
+
+		property := fieldValueSchema(field, schedules[field.ID])
+		if property == nil {
+			continue
+		}
+
+		for j := range rules {
+			rule := &rules[j]
+			if rule.When != "" || !strings.EqualFold(rule.Field, field.Name) {
+				continue
+			}
+			applyRuleSchema(property, field, rule)
+			if rule.Required && !containsFold(required, field.Name) {
+				required = append(required, field.Name)
+			}
+		}
+
+		properties[field.Name] = property
+	}
+
+	schema := map[string]interface{}{
+		"$schema":              jsonSchemaDialect,
+		"title":                title,
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+
+	return schema
+}
+
+// fieldValueSchema returns the schema of a field's values, or nil for fields that cannot be set
+func fieldValueSchema(field *FieldInfo, schedule *IterationSchedule) map[string]interface{} {
+	property := map[string]interface{}{"description": FormatFieldDataType(field.DataType) + " field"}
+
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeText:
+		property["type"] = "string"
+	case graphql.ProjectV2FieldDataTypeNumber:
+		property["type"] = "number"
+	case graphql.ProjectV2FieldDataTypeDate:
+		property["type"] = "string"
+		property["format"] = "date"
+		property["pattern"] = datePattern
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		options := make([]interface{}, len(field.Options))
+		for i, option := range field.Options {
+			entry := map[string]interface{}{"const": option.Name}
+			if option.Description != nil && *option.Description != "" {
+				entry["description"] = *option.Description
+			}
+			options[i] = entry
+		}
+		property["type"] = "string"
+		property["oneOf"] = options
+	case graphql.ProjectV2FieldDataTypeIteration:
+		property["type"] = "string"
+		if schedule != nil {
+			// Items can only be set to active and upcoming iterations
+			titles := make([]string, 0, len(schedule.Iterations))
+			for _, iteration := range schedule.Iterations {
+				if !iteration.Completed {
+					titles = append(titles, iteration.Title)
+				}
+			}
+			property["enum"] = titles
+		}
+	default:
+		return nil
+	}
+
+	return property
+}
+
+// applyRuleSchema narrows a field's schema with the constraints of a field rule
+func applyRuleSchema(property map[string]interface{}, field *FieldInfo, rule *FieldRule) {
+	if len(rule.Values) > 0 {
+		if field.DataType == graphql.ProjectV2FieldDataTypeNumber {
+			values := make([]interface{}, 0, len(rule.Values))
+			for _, value := range rule.Values {
+				if number, err := strconv.ParseFloat(value, 64); err == nil {
+					values = append(values, number)
+				}
+			}
+			property["enum"] = values
+		} else {
+			property["enum"] = rule.Values
+		}
+	}
+
+	if field.DataType == graphql.ProjectV2FieldDataTypeNumber {
+		if minimum, err := strconv.ParseFloat(rule.Min, 64); err == nil {
+			property["minimum"] = minimum
+		}
+		if maximum, err := strconv.ParseFloat(rule.Max, 64); err == nil {
+			property["maximum"] = maximum
+		}
+	}
+
+	if rule.Pattern != "" {
+		if _, ok := property["pattern"]; ok {
+			// Keep the field's own pattern, which dates need
+			property["allOf"] = append(schemaList(property["allOf"]), map[string]interface{}{"pattern": rule.Pattern})
+		} else {
+			property["pattern"] = rule.Pattern
+		}
+	}
+}
+
+// schemaList returns the schemas of an allOf keyword, or nil when it is not set
+func schemaList(value interface{}) []interface{} {
+	list, _ := value.([]interface{})
+	return list
+}
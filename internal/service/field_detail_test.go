@@ -0,0 +1,145 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func detailProjectFields() []FieldInfo {
+	urgent := "Drop everything"
+	return []FieldInfo{
+		{ID: "f-title", Name: "Title", DataType: "TITLE"},
+		{ID: "f-status", Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-todo", Name: "Todo"},
+			{ID: "o-done", Name: "Done"},
+		}},
+		{ID: "f-priority", Name: "Priority", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-urgent", Name: "Urgent", Description: &urgent},
+			{ID: "o-low", Name: "Low"},
+		}},
+		{ID: "f-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-due", Name: "Due", DataType: graphql.ProjectV2FieldDataTypeDate},
+		{ID: "f-code", Name: "Code", DataType: graphql.ProjectV2FieldDataTypeText},
+		{ID: "f-sprint", Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration},
+		{ID: "f-labels", Name: "Labels", DataType: "LABELS"},
+	}
+}
+
+func detailSchedules() map[string]*IterationSchedule {
+	return map[string]*IterationSchedule{
+		"f-sprint": {FieldID: "f-sprint", FieldName: "Sprint", Duration: 14, Iterations: []Iteration{
+			{ID: "i-0", Title: "Sprint 0", Duration: 14, Completed: true},
+			{ID: "i-1", Title: "Sprint 1", Duration: 14},
+			{ID: "i-2", Title: "Sprint 2", Duration: 14},
+		}},
+	}
+}
+
+func TestBuildFieldDetails(t *testing.T) {
+	views := []ViewInfo{
+		{Name: "Board", VerticalGroupBy: []ViewGroupByInfo{{FieldID: "f-status", FieldName: "Status"}}},
+		{Name: "Backlog",
+			GroupBy: []ViewGroupByInfo{{FieldID: "f-priority", FieldName: "Priority", Direction: graphql.ProjectV2ViewSortDirectionASC}},
+			SortBy:  []ViewSortByInfo{{FieldName: "estimate", Direction: graphql.ProjectV2ViewSortDirectionDESC}}},
+		{Name: "Other project", GroupBy: []ViewGroupByInfo{{FieldID: "f-elsewhere", FieldName: "Status"}}},
+	}
+
+	details := BuildFieldDetails(detailProjectFields(), detailSchedules(), views)
+
+	require.Len(t, details, 8)
+	assert.Equal(t, "Status", details[1].Field.Name)
+	assert.Equal(t, []FieldViewUsage{{View: "Board", Usage: ViewUsageColumn}}, details[1].Views)
+	assert.Equal(t, []FieldViewUsage{{View: "Backlog", Usage: ViewUsageGroup, Direction: graphql.ProjectV2ViewSortDirectionASC}}, details[2].Views)
+	assert.Equal(t, []FieldViewUsage{{View: "Backlog", Usage: ViewUsageSort, Direction: graphql.ProjectV2ViewSortDirectionDESC}}, details[3].Views)
+	assert.Empty(t, details[4].Views)
+	assert.Nil(t, details[1].Iterations)
+	require.NotNil(t, details[6].Iterations)
+	assert.Len(t, details[6].Iterations.Iterations, 3)
+}
+
+func TestBuildItemValueSchema(t *testing.T) {
+	schemaJSON := func(t *testing.T, rules []FieldRule) map[string]interface{} {
+		t.Helper()
+		// Round-trip through JSON to check the schema as other tools see it
+		data, err := json.Marshal(BuildItemValueSchema("Roadmap", detailProjectFields(), detailSchedules(), rules))
+		require.NoError(t, err)
+		var schema map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &schema))
+		return schema
+	}
+
+	t.Run("Describes settable fields", func(t *testing.T) {
+		schema := schemaJSON(t, nil)
+
+		assert.Equal(t, jsonSchemaDialect, schema["$schema"])
+		assert.Equal(t, "Roadmap", schema["title"])
+		assert.Equal(t, false, schema["additionalProperties"])
+		assert.NotContains(t, schema, "required")
+
+		properties := schema["properties"].(map[string]interface{})
+		assert.Len(t, properties, 6)
+		assert.NotContains(t, properties, "Title")
+		assert.NotContains(t, properties, "Labels")
+
+		assert.Equal(t, "number", properties["Estimate"].(map[string]interface{})["type"])
+		assert.Equal(t, "string", properties["Code"].(map[string]interface{})["type"])
+
+		due := properties["Due"].(map[string]interface{})
+		assert.Equal(t, "date", due["format"])
+		assert.Equal(t, datePattern, due["pattern"])
+
+		priority := properties["Priority"].(map[string]interface{})
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"const": "Urgent", "description": "Drop everything"},
+			map[string]interface{}{"const": "Low"},
+		}, priority["oneOf"])
+
+		sprint := properties["Sprint"].(map[string]interface{})
+		assert.Equal(t, []interface{}{"Sprint 1", "Sprint 2"}, sprint["enum"])
+	})
+
+	t.Run("Applies unconditional field rules", func(t *testing.T) {
+		rules, err := ParseFieldRules([]byte(`
+projects:
+  octocat/1:
+    - field: Estimate
+      values: [1, 2, 3]
+    - field: Estimate
+      min: 1
+      max: 3
+    - field: Due
+      min: "@today"
+      pattern: ^2025-
+    - field: Code
+      pattern: ^[A-Z]+-\d+$
+      required: true
+    - field: Priority
+      required: true
+      when: status:Done
+`))
+		require.NoError(t, err)
+		projectRules, err := rules.ForProject("octocat/1")
+		require.NoError(t, err)
+
+		schema := schemaJSON(t, projectRules)
+		properties := schema["properties"].(map[string]interface{})
+
+		estimate := properties["Estimate"].(map[string]interface{})
+		assert.Equal(t, []interface{}{1.0, 2.0, 3.0}, estimate["enum"])
+		assert.Equal(t, 1.0, estimate["minimum"])
+		assert.Equal(t, 3.0, estimate["maximum"])
+
+		due := properties["Due"].(map[string]interface{})
+		assert.Equal(t, datePattern, due["pattern"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"pattern": "^2025-"}}, due["allOf"])
+		assert.NotContains(t, due, "minimum")
+
+		assert.Equal(t, `^[A-Z]+-\d+$`, properties["Code"].(map[string]interface{})["pattern"])
+		assert.Equal(t, []interface{}{"Code"}, schema["required"])
+	})
+}
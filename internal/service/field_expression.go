@@ -0,0 +1,848 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// secondsPerDay converts Unix times to days
+const secondsPerDay = 24 * 60 * 60
+
+// FieldExpression is a parsed computed field expression. Expressions combine field
+// values, item metadata, literals, operators and functions:
+//
+//	Impact * Confidence / Effort
+//	days_since(changed(Status))
+//	if([Due Date] < @today, "Overdue", "On track")
+//
+// Field names are written as they are, or in brackets when they are not a single
+// word. Metadata is prefixed with @: @created, @updated, @today, @number, @title,
+// @state, @type, @repository and @milestone. Missing values are empty; arithmetic
+// on an empty value is empty, so the computed field is cleared.
+type FieldExpression struct {
+	root   exprNode
+	Source string
+}
+
+// exprContext holds what an expression is evaluated against
+type exprContext struct {
+	item *ProjectItemInfo
+	now  time.Time
+}
+
+// exprNode is a node of a parsed expression. Values are nil (empty), float64,
+// string, bool or time.Time.
+type exprNode interface {
+	eval(ctx *exprContext) (interface{}, error)
+}
+
+// exprFunction describes a function that can be called in expressions
+type exprFunction struct {
+	call    func(ctx *exprContext, args []exprNode) (interface{}, error)
+	minArgs int
+	maxArgs int // -1 for no limit
+}
+
+// exprMetadata lists the item metadata expressions can read
+var exprMetadata = map[string]bool{
+	"created": true, "updated": true, "today": true, "number": true, "title": true,
+	"state": true, "type": true, "repository": true, "milestone": true,
+}
+
+// exprFunctions lists the functions expressions can call
+var exprFunctions = map[string]exprFunction{
+	"days_since":   {call: exprDaysSince, minArgs: 1, maxArgs: 1},
+	"days_between": {call: exprDaysBetween, minArgs: 2, maxArgs: 2},
+	"add_days":     {call: exprAddDays, minArgs: 2, maxArgs: 2},
+	"changed":      {call: exprChanged, minArgs: 1, maxArgs: 1},
+	"round":        {call: exprRound, minArgs: 1, maxArgs: 2},
+	"floor":        {call: exprMath(math.Floor), minArgs: 1, maxArgs: 1},
+	"ceil":         {call: exprMath(math.Ceil), minArgs: 1, maxArgs: 1},
+	"abs":          {call: exprMath(math.Abs), minArgs: 1, maxArgs: 1},
+	"min":          {call: exprExtreme(-1), minArgs: 1, maxArgs: -1},
+	"max":          {call: exprExtreme(1), minArgs: 1, maxArgs: -1},
+	"if":           {call: exprIf, minArgs: 2, maxArgs: 3},
+	"coalesce":     {call: exprCoalesce, minArgs: 1, maxArgs: -1},
+	"switch":       {call: exprSwitch, minArgs: 3, maxArgs: -1},
+	"concat":       {call: exprConcat, minArgs: 1, maxArgs: -1},
+	"has_label":    {call: exprHasLabel, minArgs: 1, maxArgs: 1},
+}
+
+// ParseFieldExpression parses a computed field expression
+func ParseFieldExpression(source string) (*FieldExpression, error) {
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &exprParser{tokens: tokens}
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !parser.done() {
+		return nil, fmt.Errorf("unexpected %s in expression", parser.peek().text)
+	}
+
+	return &FieldExpression{root: root, Source: source}, nil
+}
+
+// References returns the names of the fields the expression reads, in order of appearance
+func (e *FieldExpression) References() []string {
+	var names []string
+	collectExprReferences(e.root, &names)
+	return names
+}
+
+// Evaluate evaluates the expression for an item. A nil result means no value.
+func (e *FieldExpression) Evaluate(item *ProjectItemInfo, now time.Time) (interface{}, error) {
+	return e.root.eval(&exprContext{item: item, now: now})
+}
+
+// Expression nodes
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(_ *exprContext) (interface{}, error) {
+	return n.value, nil
+}
+
+type fieldNode struct {
+	name string
+}
+
+func (n *fieldNode) eval(ctx *exprContext) (interface{}, error) {
+	if value := fieldValue(ctx.item, n.name); value != "" {
+		return value, nil
+	}
+	return nil, nil
+}
+
+type metadataNode struct {
+	name string
+}
+
+func (n *metadataNode) eval(ctx *exprContext) (interface{}, error) {
+	item := ctx.item
+	switch n.name {
+	case "created":
+		return optionalTime(item.CreatedAt.In(ctx.now.Location())), nil
+	case "updated":
+		return optionalTime(item.UpdatedAt.In(ctx.now.Location())), nil
+	case "today":
+		year, month, day := ctx.now.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, ctx.now.Location()), nil
+	case "number":
+		if item.Number == nil {
+			return nil, nil
+		}
+		return float64(*item.Number), nil
+	case "title":
+		return optionalString(item.Title), nil
+	case "state":
+		return optionalString(item.State), nil
+	case "type":
+		return optionalString(item.Type), nil
+	case "repository":
+		return optionalString(derefString(item.Repository)), nil
+	default:
+		return optionalString(derefString(item.Milestone)), nil
+	}
+}
+
+type unaryNode struct {
+	operand exprNode
+	op      string
+}
+
+func (n *unaryNode) eval(ctx *exprContext) (interface{}, error) {
+	value, err := n.operand.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "!" {
+		return !exprTruthy(value), nil
+	}
+
+	number, ok, err := exprNumber(value)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return -number, nil
+}
+
+type binaryNode struct {
+	left  exprNode
+	right exprNode
+	op    string
+}
+
+func (n *binaryNode) eval(ctx *exprContext) (interface{}, error) {
+	left, err := n.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || only evaluate the right side when needed
+	switch n.op {
+	case "&&":
+		if !exprTruthy(left) {
+			return false, nil
+		}
+		right, rightErr := n.right.eval(ctx)
+		return exprTruthy(right), rightErr
+	case "||":
+		if exprTruthy(left) {
+			return true, nil
+		}
+		right, rightErr := n.right.eval(ctx)
+		return exprTruthy(right), rightErr
+	}
+
+	right, err := n.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return exprEqual(left, right), nil
+	case "!=":
+		return !exprEqual(left, right), nil
+	case "<", "<=", ">", ">=":
+		return exprCompare(n.op, left, right), nil
+	}
+
+	a, leftOK, err := exprNumber(left)
+	if err != nil {
+		return nil, err
+	}
+	b, rightOK, err := exprNumber(right)
+	if err != nil {
+		return nil, err
+	}
+	if !leftOK || !rightOK {
+		return nil, nil
+	}
+
+	switch n.op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	default:
+		if b == 0 {
+			return nil, nil
+		}
+		return a / b, nil
+	}
+}
+
+type callNode struct {
+	function exprFunction
+	name     string
+	args     []exprNode
+}
+
+func (n *callNode) eval(ctx *exprContext) (interface{}, error) {
+	value, err := n.function.call(ctx, n.args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return value, nil
+}
+
+func collectExprReferences(node exprNode, names *[]string) {
+	switch n := node.(type) {
+	case *fieldNode:
+		for _, name := range *names {
+			if strings.EqualFold(name, n.name) {
+				return
+			}
+		}
+		*names = append(*names, n.name)
+	case *unaryNode:
+		collectExprReferences(n.operand, names)
+	case *binaryNode:
+		collectExprReferences(n.left, names)
+		collectExprReferences(n.right, names)
+	case *callNode:
+		for _, arg := range n.args {
+			collectExprReferences(arg, names)
+		}
+	}
+}
+
+// Functions
+
+func exprDaysSince(ctx *exprContext, args []exprNode) (interface{}, error) {
+	date, ok, err := evalExprDate(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	return float64(exprDayNumber(ctx.now) - exprDayNumber(date)), nil
+}
+
+func exprDaysBetween(ctx *exprContext, args []exprNode) (interface{}, error) {
+	from, fromOK, err := evalExprDate(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	to, toOK, err := evalExprDate(ctx, args[1])
+	if err != nil || !fromOK || !toOK {
+		return nil, err
+	}
+	return float64(exprDayNumber(to) - exprDayNumber(from)), nil
+}
+
+func exprAddDays(ctx *exprContext, args []exprNode) (interface{}, error) {
+	date, dateOK, err := evalExprDate(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	days, daysOK, err := evalExprNumber(ctx, args[1])
+	if err != nil || !dateOK || !daysOK {
+		return nil, err
+	}
+	return date.AddDate(0, 0, int(days)), nil
+}
+
+func exprChanged(ctx *exprContext, args []exprNode) (interface{}, error) {
+	field := args[0].(*fieldNode)
+	for name, updatedAt := range ctx.item.FieldUpdatedAt {
+		if strings.EqualFold(name, field.name) {
+			return updatedAt, nil
+		}
+	}
+	return nil, nil
+}
+
+func exprRound(ctx *exprContext, args []exprNode) (interface{}, error) {
+	number, ok, err := evalExprNumber(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	digits := 0.0
+	if len(args) == 2 {
+		if digits, _, err = evalExprNumber(ctx, args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	scale := math.Pow(10, math.Trunc(digits))
+	return math.Round(number*scale) / scale, nil
+}
+
+func exprMath(fn func(float64) float64) func(*exprContext, []exprNode) (interface{}, error) {
+	return func(ctx *exprContext, args []exprNode) (interface{}, error) {
+		number, ok, err := evalExprNumber(ctx, args[0])
+		if err != nil || !ok {
+			return nil, err
+		}
+		return fn(number), nil
+	}
+}
+
+// exprExtreme returns min (sign -1) or max (sign 1) of its non-empty arguments
+func exprExtreme(sign float64) func(*exprContext, []exprNode) (interface{}, error) {
+	return func(ctx *exprContext, args []exprNode) (interface{}, error) {
+		var result interface{}
+		for _, arg := range args {
+			number, ok, err := evalExprNumber(ctx, arg)
+			if err != nil {
+				return nil, err
+			}
+			if ok && (result == nil || (number-result.(float64))*sign > 0) {
+				result = number
+			}
+		}
+		return result, nil
+	}
+}
+
+func exprIf(ctx *exprContext, args []exprNode) (interface{}, error) {
+	condition, err := args[0].eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exprTruthy(condition) {
+		return args[1].eval(ctx)
+	}
+	if len(args) == 3 {
+		return args[2].eval(ctx)
+	}
+	return nil, nil
+}
+
+func exprCoalesce(ctx *exprContext, args []exprNode) (interface{}, error) {
+	for _, arg := range args {
+		value, err := arg.eval(ctx)
+		if err != nil || value != nil {
+			return value, err
+		}
+	}
+	return nil, nil
+}
+
+// exprSwitch evaluates switch(value, case1, result1, case2, result2, ..., [default])
+func exprSwitch(ctx *exprContext, args []exprNode) (interface{}, error) {
+	value, err := args[0].eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cases := args[1:]
+	for i := 0; i+1 < len(cases); i += 2 {
+		match, caseErr := cases[i].eval(ctx)
+		if caseErr != nil {
+			return nil, caseErr
+		}
+		if exprEqual(value, match) {
+			return cases[i+1].eval(ctx)
+		}
+	}
+
+	if len(cases)%2 == 1 {
+		return cases[len(cases)-1].eval(ctx)
+	}
+	return nil, nil
+}
+
+func exprConcat(ctx *exprContext, args []exprNode) (interface{}, error) {
+	var b strings.Builder
+	for _, arg := range args {
+		value, err := arg.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(FormatExprValue(value))
+	}
+	return optionalString(b.String()), nil
+}
+
+func exprHasLabel(ctx *exprContext, args []exprNode) (interface{}, error) {
+	label, err := args[0].eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return containsFold(ctx.item.Labels, FormatExprValue(label)), nil
+}
+
+// Values
+
+// FormatExprValue formats an expression value: numbers without trailing zeros and
+// dates as YYYY-MM-DD. Empty values format as "".
+func FormatExprValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.Format(dateLayout)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func evalExprNumber(ctx *exprContext, node exprNode) (float64, bool, error) {
+	value, err := node.eval(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	return exprNumber(value)
+}
+
+func evalExprDate(ctx *exprContext, node exprNode) (time.Time, bool, error) {
+	value, err := node.eval(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return v.In(ctx.now.Location()), true, nil
+	case string:
+		date, parseErr := time.ParseInLocation(dateLayout, v, ctx.now.Location())
+		if parseErr != nil {
+			return time.Time{}, false, fmt.Errorf("not a date: %q", v)
+		}
+		return date, true, nil
+	default:
+		return time.Time{}, false, fmt.Errorf("not a date: %s", FormatExprValue(v))
+	}
+}
+
+// exprNumber converts a value to a number. ok is false for empty values.
+func exprNumber(value interface{}) (number float64, ok bool, err error) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false, nil
+	case float64:
+		return v, true, nil
+	case string:
+		number, parseErr := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if parseErr != nil {
+			return 0, false, fmt.Errorf("not a number: %q", v)
+		}
+		return number, true, nil
+	default:
+		return 0, false, fmt.Errorf("not a number: %s", FormatExprValue(v))
+	}
+}
+
+// exprDayNumber returns the number of days between the Unix epoch and a date
+func exprDayNumber(t time.Time) int64 {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay
+}
+
+func exprTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	default:
+		return true
+	}
+}
+
+// exprEqual compares numbers numerically, dates by day and text case-insensitively
+func exprEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if x, xOK, xErr := exprNumber(a); xErr == nil && xOK {
+		if y, yOK, yErr := exprNumber(b); yErr == nil && yOK {
+			return x == y
+		}
+	}
+	return strings.EqualFold(FormatExprValue(a), FormatExprValue(b))
+}
+
+// exprCompare orders numbers numerically and dates and text as strings. Empty
+// values are never ordered.
+func exprCompare(op string, a, b interface{}) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	cmp := compareFilterValues(FormatExprValue(a), FormatExprValue(b))
+	switch op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+func optionalString(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func optionalTime(value time.Time) interface{} {
+	if value.IsZero() {
+		return nil
+	}
+	return value
+}
+
+// Parsing
+
+type exprTokenKind int
+
+const (
+	tokenNumber exprTokenKind = iota
+	tokenString
+	tokenIdent
+	tokenField
+	tokenMetadata
+	tokenOperator
+)
+
+type exprToken struct {
+	text string
+	kind exprTokenKind
+}
+
+// exprOperators lists operators, longest first
+var exprOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "+", "-", "*", "/", "!", "(", ")", ","}
+
+func tokenizeExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: string(runes[start:i])})
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated string in expression")
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: string(runes[i+1 : end])})
+			i = end + 1
+		case r == '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated field name in expression")
+			}
+			name := strings.TrimSpace(string(runes[i+1 : end]))
+			if name == "" {
+				return nil, fmt.Errorf("empty field name in expression")
+			}
+			tokens = append(tokens, exprToken{kind: tokenField, text: name})
+			i = end + 1
+		case r == '@' || isExprIdentRune(r):
+			start := i
+			i++
+			for i < len(runes) && isExprIdentRune(runes[i]) {
+				i++
+			}
+			kind := tokenIdent
+			text := string(runes[start:i])
+			if r == '@' {
+				kind = tokenMetadata
+				text = strings.ToLower(text[1:])
+			}
+			tokens = append(tokens, exprToken{kind: kind, text: text})
+		default:
+			operator := ""
+			for _, candidate := range exprOperators {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, fmt.Errorf("unexpected character %q in expression", r)
+			}
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: operator})
+			i += len(operator)
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	return tokens, nil
+}
+
+func isExprIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *exprParser) peek() exprToken {
+	if p.done() {
+		return exprToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+// accept consumes the next token when it is one of the given operators
+func (p *exprParser) accept(operators ...string) (string, bool) {
+	token := p.peek()
+	if p.done() || token.kind != tokenOperator {
+		return "", false
+	}
+	for _, operator := range operators {
+		if token.text == operator {
+			p.pos++
+			return operator, true
+		}
+	}
+	return "", false
+}
+
+func (p *exprParser) expect(operator string) error {
+	if _, ok := p.accept(operator); !ok {
+		if p.done() {
+			return fmt.Errorf("expected %s at the end of the expression", operator)
+		}
+		return fmt.Errorf("expected %s but found %s", operator, p.peek().text)
+	}
+	return nil
+}
+
+// parseBinary parses left-associative binary operators of one precedence level
+func (p *exprParser) parseBinary(next func() (exprNode, error), operators ...string) (exprNode, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.accept(operators...)
+		if !ok {
+			return left, nil
+		}
+		right, rightErr := next()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseBinary(p.parseComparison, "&&")
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	return p.parseBinary(p.parseAdditive, "==", "!=", "<=", ">=", "<", ">")
+}
+
+func (p *exprParser) parseAdditive() (exprNode, error) {
+	return p.parseBinary(p.parseMultiplicative, "+", "-")
+}
+
+func (p *exprParser) parseMultiplicative() (exprNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if op, ok := p.accept("-", "!"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	if p.done() {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	token := p.tokens[p.pos]
+	p.pos++
+
+	switch token.kind {
+	case tokenNumber:
+		number, err := strconv.ParseFloat(token.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number in expression: %s", token.text)
+		}
+		return &literalNode{value: number}, nil
+	case tokenString:
+		return &literalNode{value: optionalString(token.text)}, nil
+	case tokenField:
+		return &fieldNode{name: token.text}, nil
+	case tokenMetadata:
+		if !exprMetadata[token.text] {
+			return nil, fmt.Errorf("unknown item metadata: @%s", token.text)
+		}
+		return &metadataNode{name: token.text}, nil
+	case tokenIdent:
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(token.text)
+		}
+		switch strings.ToLower(token.text) {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		}
+		return &fieldNode{name: token.text}, nil
+	default:
+		if token.text == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+		return nil, fmt.Errorf("unexpected %s in expression", token.text)
+	}
+}
+
+func (p *exprParser) parseCall(name string) (exprNode, error) {
+	function, ok := exprFunctions[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown function: %s", name)
+	}
+
+	var args []exprNode
+	if _, closed := p.accept(")"); !closed {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if _, more := p.accept(","); !more {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(args) < function.minArgs || (function.maxArgs >= 0 && len(args) > function.maxArgs) {
+		return nil, fmt.Errorf("wrong number of arguments for %s: %d", name, len(args))
+	}
+
+	name = strings.ToLower(name)
+	if name == "changed" {
+		if _, isField := args[0].(*fieldNode); !isField {
+			return nil, fmt.Errorf("changed expects a field name")
+		}
+	}
+
+	return &callNode{name: name, function: function, args: args}, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expressionNow = time.Date(2025, 3, 14, 15, 30, 0, 0, time.UTC)
+
+func expressionItem() *ProjectItemInfo {
+	number := 42
+	repository := "octocat/app"
+	return &ProjectItemInfo{
+		CreatedAt:  time.Date(2025, 3, 4, 8, 0, 0, 0, time.UTC),
+		Number:     &number,
+		Repository: &repository,
+		Title:      "Fix login",
+		State:      "OPEN",
+		Labels:     []string{"bug"},
+		FieldValues: map[string]string{
+			"Impact": "3", "Confidence": "0.5", "Effort": "2",
+			"Status": "In Progress", "Due Date": "2025-03-10", "Priority": "High",
+		},
+		FieldUpdatedAt: map[string]time.Time{"Status": time.Date(2025, 3, 11, 22, 0, 0, 0, time.UTC)},
+	}
+}
+
+func evaluateExpression(t *testing.T, source string) interface{} {
+	t.Helper()
+	expression, err := ParseFieldExpression(source)
+	require.NoError(t, err)
+	value, err := expression.Evaluate(expressionItem(), expressionNow)
+	require.NoError(t, err)
+	return value
+}
+
+func TestFieldExpressionEvaluate(t *testing.T) {
+	t.Run("Arithmetic follows precedence", func(t *testing.T) {
+		assert.Equal(t, 0.75, evaluateExpression(t, "Impact * Confidence / Effort"))
+		assert.Equal(t, 7.0, evaluateExpression(t, "1 + 2 * 3"))
+		assert.Equal(t, 9.0, evaluateExpression(t, "(1 + 2) * 3"))
+		assert.Equal(t, -1.0, evaluateExpression(t, "-impact + 2"))
+	})
+
+	t.Run("Empty values propagate", func(t *testing.T) {
+		assert.Nil(t, evaluateExpression(t, "Impact * Size"))
+		assert.Nil(t, evaluateExpression(t, "Impact / 0"))
+		assert.Equal(t, 1.0, evaluateExpression(t, "coalesce(Size, 1)"))
+	})
+
+	t.Run("Dates and metadata", func(t *testing.T) {
+		assert.Equal(t, 10.0, evaluateExpression(t, "days_since(@created)"))
+		assert.Equal(t, 3.0, evaluateExpression(t, "days_since(changed(Status))"))
+		assert.Equal(t, 4.0, evaluateExpression(t, "days_between([Due Date], @today)"))
+		assert.Equal(t, "2025-03-17", FormatExprValue(evaluateExpression(t, "add_days([Due Date], 7)")))
+		assert.Nil(t, evaluateExpression(t, "days_since(changed(Priority))"))
+		assert.Equal(t, "octocat/app#42", evaluateExpression(t, `concat(@repository, "#", @number)`))
+	})
+
+	t.Run("Conditions and functions", func(t *testing.T) {
+		assert.Equal(t, "Overdue", evaluateExpression(t, `if([Due Date] < @today && @state == "open", "Overdue", "On track")`))
+		assert.Equal(t, 3.0, evaluateExpression(t, `switch(Priority, "Low", 1, "high", 3, 0)`))
+		assert.Equal(t, 0.0, evaluateExpression(t, `switch(Status, "Done", 1, 0)`))
+		assert.Nil(t, evaluateExpression(t, `switch(Status, "Done", 1)`))
+		assert.Equal(t, true, evaluateExpression(t, `has_label("BUG") || Size == ""`))
+		assert.Equal(t, 0.8, evaluateExpression(t, "round(Impact * Confidence / Effort, 1)"))
+		assert.Equal(t, 2.0, evaluateExpression(t, "max(Effort, Size, 1)"))
+		assert.Equal(t, 0.5, evaluateExpression(t, "min(Impact, Confidence)"))
+	})
+
+	t.Run("Non-numeric values fail arithmetic", func(t *testing.T) {
+		expression, err := ParseFieldExpression("Priority * 2")
+		require.NoError(t, err)
+
+		_, err = expression.Evaluate(expressionItem(), expressionNow)
+
+		assert.ErrorContains(t, err, `not a number: "High"`)
+	})
+}
+
+func TestParseFieldExpression(t *testing.T) {
+	t.Run("Lists referenced fields once", func(t *testing.T) {
+		expression, err := ParseFieldExpression("if(Effort > 0, Impact / effort, [Due Date])")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Effort", "Impact", "Due Date"}, expression.References())
+	})
+
+	t.Run("Invalid expressions return errors", func(t *testing.T) {
+		tests := []struct {
+			source  string
+			message string
+		}{
+			{"", "empty expression"},
+			{"Impact *", "unexpected end of expression"},
+			{"(Impact + 1", "expected )"},
+			{"Impact Effort", "unexpected Effort"},
+			{"median(Impact)", "unknown function"},
+			{"round()", "wrong number of arguments"},
+			{"changed(@created)", "changed expects a field name"},
+			{"@closed", "unknown item metadata"},
+			{`"open`, "unterminated string"},
+			{"[Due Date", "unterminated field name"},
+			{"Impact % 2", "unexpected character"},
+		}
+
+		for _, tt := range tests {
+			_, err := ParseFieldExpression(tt.source)
+			assert.ErrorContains(t, err, tt.message, tt.source)
+		}
+	})
+}
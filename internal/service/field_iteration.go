@@ -0,0 +1,332 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// Iteration states reported by IterationState
+const (
+	IterationStateCompleted = "completed"
+	IterationStateCurrent   = "current"
+	IterationStateUpcoming  = "upcoming"
+)
+
+// Iteration is a single iteration of an iteration field
+type Iteration struct {
+	StartDate time.Time
+	ID        string
+	Title     string
+	Duration  int
+	Completed bool
+}
+
+// EndDate returns the last day of the iteration
+func (i *Iteration) EndDate() time.Time {
+	return i.StartDate.AddDate(0, 0, i.Duration-1)
+}
+
+// IterationBreak is a gap between two consecutive iterations
+type IterationBreak struct {
+	StartDate time.Time
+	After     string
+	Days      int
+}
+
+// IterationSchedule is the editable configuration of an iteration field.
+// Iterations are sorted by start date and include completed iterations.
+type IterationSchedule struct {
+	StartDate  time.Time
+	FieldID    string
+	FieldName  string
+	Iterations []Iteration
+	Duration   int
+}
+
+// GetIterationSchedule fetches an iteration field and its iterations
+func (s *FieldService) GetIterationSchedule(ctx context.Context, fieldID string) (*IterationSchedule, error) {
+	var query graphql.GetIterationFieldQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetIterationFieldVariables(fieldID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iteration field: %w", err)
+	}
+
+	if query.Node.IterationField.ID == "" {
+		return nil, fmt.Errorf("field %s is not an iteration field", fieldID)
+	}
+
+	return NewIterationSchedule(&query.Node.IterationField)
+}
+
+// UpdateIterationSchedule replaces the iterations of an iteration field with the schedule.
+// The API takes no iteration IDs, so every iteration, completed ones included, is
+// recreated with a new ID and the field's item values can be reset.
+func (s *FieldService) UpdateIterationSchedule(ctx context.Context, schedule *IterationSchedule) (*IterationSchedule, error) {
+	return s.UpdateIterationField(ctx, schedule, nil)
+}
+
+// UpdateIterationField replaces the iterations of an iteration field like
+// UpdateIterationSchedule and, when name is set, renames the field in the same update
+func (s *FieldService) UpdateIterationField(ctx context.Context, schedule *IterationSchedule, name *string) (*IterationSchedule, error) {
+	config := schedule.ConfigurationInput()
+	variables := graphql.BuildUpdateFieldVariables(graphql.UpdateFieldInput{
+		FieldID:                schedule.FieldID,
+		Name:                   name,
+		IterationConfiguration: &config,
+	})
+
+	var mutation graphql.UpdateIterationFieldMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update iterations: %w", err)
+	}
+
+	return NewIterationSchedule(&mutation.UpdateProjectV2Field.ProjectV2Field.IterationField)
+}
+
+// NewIterationSchedule converts an iteration field into a schedule
+func NewIterationSchedule(field *graphql.ProjectV2IterationField) (*IterationSchedule, error) {
+	schedule := &IterationSchedule{
+		FieldID:   field.ID,
+		FieldName: field.Name,
+		Duration:  field.Configuration.Duration,
+	}
+
+	add := func(infos []graphql.ProjectV2IterationInfo, completed bool) error {
+		for _, info := range infos {
+			start, err := time.Parse(dateLayout, info.StartDate)
+			if err != nil {
+				return fmt.Errorf("iteration %s has an invalid start date: %s", info.Title, info.StartDate)
+			}
+			schedule.Iterations = append(schedule.Iterations, Iteration{
+				ID:        info.ID,
+				Title:     info.Title,
+				StartDate: start,
+				Duration:  info.Duration,
+				Completed: completed,
+			})
+		}
+		return nil
+	}
+
+	if err := add(field.Configuration.CompletedIterations, true); err != nil {
+		return nil, err
+	}
+	if err := add(field.Configuration.Iterations, false); err != nil {
+		return nil, err
+	}
+
+	schedule.sort()
+	if len(schedule.Iterations) > 0 {
+		schedule.StartDate = schedule.Iterations[0].StartDate
+	}
+
+	return schedule, nil
+}
+
+// IterationState reports whether an iteration is completed, current or upcoming on the given day
+func IterationState(iteration *Iteration, today time.Time) string {
+	day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case iteration.Completed || day.After(iteration.EndDate()):
+		return IterationStateCompleted
+	case day.Before(iteration.StartDate):
+		return IterationStateUpcoming
+	default:
+		return IterationStateCurrent
+	}
+}
+
+// Breaks returns the gaps between consecutive iterations
+func (sc *IterationSchedule) Breaks() []IterationBreak {
+	var breaks []IterationBreak
+	for i := 1; i < len(sc.Iterations); i++ {
+		previous := &sc.Iterations[i-1]
+		start := previous.EndDate().AddDate(0, 0, 1)
+		if days := daysBetween(start, sc.Iterations[i].StartDate); days > 0 {
+			breaks = append(breaks, IterationBreak{After: previous.Title, StartDate: start, Days: days})
+		}
+	}
+	return breaks
+}
+
+// SetDuration changes the default length of new iterations
+func (sc *IterationSchedule) SetDuration(days int) error {
+	if days <= 0 {
+		return fmt.Errorf("iteration duration must be positive")
+	}
+	sc.Duration = days
+	return nil
+}
+
+// SetStartDate moves the iterations that are not completed so the first of them
+// starts on the given date, keeping their lengths and the breaks between them
+func (sc *IterationSchedule) SetStartDate(date time.Time) error {
+	first := -1
+	for i := range sc.Iterations {
+		if !sc.Iterations[i].Completed {
+			first = i
+			break
+		}
+	}
+
+	if first < 0 {
+		if len(sc.Iterations) > 0 && !date.After(sc.Iterations[len(sc.Iterations)-1].EndDate()) {
+			return fmt.Errorf("start date %s overlaps completed iterations", date.Format(dateLayout))
+		}
+		sc.StartDate = date
+		return nil
+	}
+
+	if first > 0 && !date.After(sc.Iterations[first-1].EndDate()) {
+		return fmt.Errorf("start date %s overlaps completed iteration %s", date.Format(dateLayout), sc.Iterations[first-1].Title)
+	}
+
+	sc.shift(first, daysBetween(sc.Iterations[first].StartDate, date))
+	if first == 0 {
+		sc.StartDate = date
+	}
+	return nil
+}
+
+// AddIteration appends an iteration of the default length after the last iteration
+func (sc *IterationSchedule) AddIteration(title string) error {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return fmt.Errorf("iteration title cannot be empty")
+	}
+	if _, err := sc.find(title); err == nil {
+		return fmt.Errorf("iteration %s already exists", title)
+	}
+	if sc.Duration <= 0 {
+		return fmt.Errorf("iteration duration is not set (use --duration)")
+	}
+
+	start := sc.StartDate
+	if n := len(sc.Iterations); n > 0 {
+		start = sc.Iterations[n-1].EndDate().AddDate(0, 0, 1)
+	}
+	if start.IsZero() {
+		return fmt.Errorf("iteration start date is not set (use --start-date)")
+	}
+
+	sc.Iterations = append(sc.Iterations, Iteration{
+		Title:     title,
+		StartDate: start,
+		Duration:  sc.Duration,
+	})
+	return nil
+}
+
+// RemoveIteration removes an iteration, leaving the other iterations in place
+func (sc *IterationSchedule) RemoveIteration(title string) error {
+	index, err := sc.find(title)
+	if err != nil {
+		return err
+	}
+
+	sc.Iterations = append(sc.Iterations[:index], sc.Iterations[index+1:]...)
+	return nil
+}
+
+// AddBreak inserts a break of the given length after an iteration by moving the later iterations
+func (sc *IterationSchedule) AddBreak(after string, days int) error {
+	if days <= 0 {
+		return fmt.Errorf("break length must be positive")
+	}
+
+	index, err := sc.find(after)
+	if err != nil {
+		return err
+	}
+	if index == len(sc.Iterations)-1 {
+		return fmt.Errorf("iteration %s is the last iteration; a break needs a following iteration", sc.Iterations[index].Title)
+	}
+
+	return sc.shiftAfter(index, days)
+}
+
+// RemoveBreak closes the break after an iteration by moving the later iterations
+func (sc *IterationSchedule) RemoveBreak(after string) error {
+	index, err := sc.find(after)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range sc.Breaks() {
+		if b.After == sc.Iterations[index].Title {
+			return sc.shiftAfter(index, -b.Days)
+		}
+	}
+
+	return fmt.Errorf("there is no break after iteration %s", sc.Iterations[index].Title)
+}
+
+// ConfigurationInput converts the schedule into the iteration configuration of an update
+func (sc *IterationSchedule) ConfigurationInput() graphql.IterationConfigurationInput {
+	config := graphql.IterationConfigurationInput{
+		StartDate:  sc.StartDate.Format(dateLayout),
+		Duration:   sc.Duration,
+		Iterations: make([]graphql.IterationInput, len(sc.Iterations)),
+	}
+
+	if len(sc.Iterations) > 0 {
+		config.StartDate = sc.Iterations[0].StartDate.Format(dateLayout)
+	}
+
+	for i := range sc.Iterations {
+		iteration := &sc.Iterations[i]
+		config.Iterations[i] = graphql.IterationInput{
+			Title:     iteration.Title,
+			StartDate: iteration.StartDate.Format(dateLayout),
+			Duration:  iteration.Duration,
+		}
+	}
+
+	return config
+}
+
+// shiftAfter moves the iterations after index by the given number of days
+func (sc *IterationSchedule) shiftAfter(index, days int) error {
+	for i := index + 1; i < len(sc.Iterations); i++ {
+		if sc.Iterations[i].Completed {
+			return fmt.Errorf("cannot move completed iteration %s", sc.Iterations[i].Title)
+		}
+	}
+
+	sc.shift(index+1, days)
+	return nil
+}
+
+// shift moves the iterations from index on by the given number of days
+func (sc *IterationSchedule) shift(index, days int) {
+	for i := index; i < len(sc.Iterations); i++ {
+		sc.Iterations[i].StartDate = sc.Iterations[i].StartDate.AddDate(0, 0, days)
+	}
+}
+
+// find returns the index of an iteration by title (case-insensitive) or ID
+func (sc *IterationSchedule) find(title string) (int, error) {
+	for i := range sc.Iterations {
+		if sc.Iterations[i].ID == title || strings.EqualFold(sc.Iterations[i].Title, strings.TrimSpace(title)) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("iteration %s not found in field %s", title, sc.FieldName)
+}
+
+func (sc *IterationSchedule) sort() {
+	sort.SliceStable(sc.Iterations, func(i, j int) bool {
+		return sc.Iterations[i].StartDate.Before(sc.Iterations[j].StartDate)
+	})
+}
+
+// daysBetween returns the number of days from one date to another
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
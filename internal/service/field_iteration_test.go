@@ -0,0 +1,181 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func testIterationField() *graphql.ProjectV2IterationField {
+	field := &graphql.ProjectV2IterationField{ID: "field-sprint", Name: "Sprint"}
+	field.Configuration.Duration = 14
+	field.Configuration.CompletedIterations = []graphql.ProjectV2IterationInfo{
+		{ID: "it-1", Title: "Sprint 1", StartDate: "2025-03-03", Duration: 14},
+	}
+	field.Configuration.Iterations = []graphql.ProjectV2IterationInfo{
+		{ID: "it-3", Title: "Sprint 3", StartDate: "2025-04-07", Duration: 14},
+		{ID: "it-2", Title: "Sprint 2", StartDate: "2025-03-17", Duration: 14},
+	}
+	return field
+}
+
+func testIterationSchedule(t *testing.T) *IterationSchedule {
+	t.Helper()
+	schedule, err := NewIterationSchedule(testIterationField())
+	require.NoError(t, err)
+	return schedule
+}
+
+func iterationStarts(schedule *IterationSchedule) []string {
+	starts := make([]string, len(schedule.Iterations))
+	for i := range schedule.Iterations {
+		starts[i] = schedule.Iterations[i].Title + "@" + schedule.Iterations[i].StartDate.Format(dateLayout)
+	}
+	return starts
+}
+
+func mustDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	date, err := time.Parse(dateLayout, value)
+	require.NoError(t, err)
+	return date
+}
+
+func TestNewIterationSchedule(t *testing.T) {
+	t.Run("Sorts completed and active iterations by start date", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+
+		assert.Equal(t, "field-sprint", schedule.FieldID)
+		assert.Equal(t, 14, schedule.Duration)
+		assert.Equal(t, []string{"Sprint 1@2025-03-03", "Sprint 2@2025-03-17", "Sprint 3@2025-04-07"}, iterationStarts(schedule))
+		assert.True(t, schedule.Iterations[0].Completed)
+		assert.False(t, schedule.Iterations[1].Completed)
+		assert.Equal(t, "2025-03-03", schedule.StartDate.Format(dateLayout))
+	})
+
+	t.Run("Rejects invalid start dates", func(t *testing.T) {
+		field := testIterationField()
+		field.Configuration.Iterations[0].StartDate = "April"
+
+		_, err := NewIterationSchedule(field)
+		assert.Error(t, err)
+	})
+}
+
+func TestIterationState(t *testing.T) {
+	t.Run("Reports state by date", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		sprint2 := &schedule.Iterations[1]
+
+		assert.Equal(t, "2025-03-30", sprint2.EndDate().Format(dateLayout))
+		assert.Equal(t, IterationStateUpcoming, IterationState(sprint2, mustDate(t, "2025-03-16")))
+		assert.Equal(t, IterationStateCurrent, IterationState(sprint2, mustDate(t, "2025-03-17")))
+		assert.Equal(t, IterationStateCurrent, IterationState(sprint2, mustDate(t, "2025-03-30")))
+		assert.Equal(t, IterationStateCompleted, IterationState(sprint2, mustDate(t, "2025-03-31")))
+	})
+
+	t.Run("Completed iterations stay completed", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		assert.Equal(t, IterationStateCompleted, IterationState(&schedule.Iterations[0], mustDate(t, "2025-03-01")))
+	})
+}
+
+func TestIterationScheduleBreaks(t *testing.T) {
+	t.Run("Finds gaps between iterations", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+
+		breaks := schedule.Breaks()
+		require.Len(t, breaks, 1)
+		assert.Equal(t, "Sprint 2", breaks[0].After)
+		assert.Equal(t, "2025-03-31", breaks[0].StartDate.Format(dateLayout))
+		assert.Equal(t, 7, breaks[0].Days)
+	})
+}
+
+func TestIterationScheduleEdits(t *testing.T) {
+	t.Run("AddIteration appends after the last iteration", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		require.NoError(t, schedule.SetDuration(7))
+		require.NoError(t, schedule.AddIteration("Sprint 4"))
+
+		last := schedule.Iterations[len(schedule.Iterations)-1]
+		assert.Equal(t, "2025-04-21", last.StartDate.Format(dateLayout))
+		assert.Equal(t, 7, last.Duration)
+
+		assert.Error(t, schedule.AddIteration("sprint 4"))
+		assert.Error(t, schedule.AddIteration(" "))
+	})
+
+	t.Run("AddIteration on an empty field uses the start date", func(t *testing.T) {
+		schedule := &IterationSchedule{FieldName: "Sprint", Duration: 14}
+		assert.Error(t, schedule.AddIteration("Sprint 1"))
+
+		require.NoError(t, schedule.SetStartDate(mustDate(t, "2025-05-05")))
+		require.NoError(t, schedule.AddIteration("Sprint 1"))
+		require.NoError(t, schedule.AddIteration("Sprint 2"))
+		assert.Equal(t, []string{"Sprint 1@2025-05-05", "Sprint 2@2025-05-19"}, iterationStarts(schedule))
+	})
+
+	t.Run("RemoveIteration leaves other iterations in place", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		require.NoError(t, schedule.RemoveIteration("sprint 2"))
+		assert.Equal(t, []string{"Sprint 1@2025-03-03", "Sprint 3@2025-04-07"}, iterationStarts(schedule))
+
+		assert.Error(t, schedule.RemoveIteration("Sprint 9"))
+	})
+
+	t.Run("AddBreak moves later iterations", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		require.NoError(t, schedule.AddBreak("Sprint 2", 7))
+		assert.Equal(t, []string{"Sprint 1@2025-03-03", "Sprint 2@2025-03-17", "Sprint 3@2025-04-14"}, iterationStarts(schedule))
+
+		assert.Error(t, schedule.AddBreak("Sprint 3", 7), "no following iteration")
+		assert.Error(t, schedule.AddBreak("Sprint 2", 0))
+	})
+
+	t.Run("AddBreak cannot move completed iterations", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		schedule.Iterations[1].Completed = true
+
+		assert.ErrorContains(t, schedule.AddBreak("Sprint 1", 7), "completed iteration Sprint 2")
+	})
+
+	t.Run("RemoveBreak closes the gap", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		require.NoError(t, schedule.RemoveBreak("Sprint 2"))
+		assert.Equal(t, "2025-03-31", schedule.Iterations[2].StartDate.Format(dateLayout))
+		assert.Empty(t, schedule.Breaks())
+
+		assert.Error(t, schedule.RemoveBreak("Sprint 2"))
+	})
+
+	t.Run("SetStartDate moves iterations that are not completed", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		require.NoError(t, schedule.SetStartDate(mustDate(t, "2025-03-24")))
+		assert.Equal(t, []string{"Sprint 1@2025-03-03", "Sprint 2@2025-03-24", "Sprint 3@2025-04-14"}, iterationStarts(schedule))
+
+		assert.Error(t, schedule.SetStartDate(mustDate(t, "2025-03-10")), "overlaps Sprint 1")
+	})
+
+	t.Run("SetDuration rejects non-positive values", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+		assert.Error(t, schedule.SetDuration(0))
+	})
+}
+
+func TestIterationScheduleConfigurationInput(t *testing.T) {
+	t.Run("Includes all iterations in order", func(t *testing.T) {
+		schedule := testIterationSchedule(t)
+
+		config := schedule.ConfigurationInput()
+
+		assert.Equal(t, "2025-03-03", config.StartDate)
+		assert.Equal(t, 14, config.Duration)
+		require.Len(t, config.Iterations, 3)
+		assert.Equal(t, graphql.IterationInput{Title: "Sprint 3", StartDate: "2025-04-07", Duration: 14}, config.Iterations[2])
+	})
+}
@@ -0,0 +1,397 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// optionsDocumentHeader explains the options document opened in the editor
+const optionsDocumentHeader = `# Edit the options of %s.
+#
+# - Change a name, color or description; renamed options keep their items.
+# - Reorder the entries to reorder the options.
+# - Add an entry without an id to create an option.
+# - Remove an entry to delete the option. Its items lose their value.
+# - Set merge_into to another option's name to move the option's items to
+#   that option before deleting it.
+#
+# Colors: gray, blue, green, yellow, orange, red, pink, purple
+`
+
+// OptionEntry is an option in an editable options document
+type OptionEntry struct {
+	ID          string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        string `json:"name" yaml:"name"`
+	Color       string `json:"color" yaml:"color"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	MergeInto   string `json:"merge_into,omitempty" yaml:"merge_into,omitempty"`
+}
+
+// optionsDocument is the YAML document edited by users
+type optionsDocument struct {
+	Options []OptionEntry `yaml:"options"`
+}
+
+// OptionUpdate is a change to the name, color or description of an option
+type OptionUpdate struct {
+	From    graphql.ProjectV2SingleSelectFieldOption
+	To      OptionEntry
+	Changes []string
+}
+
+// OptionMerge moves the items of an option to another option and deletes it
+type OptionMerge struct {
+	Source graphql.ProjectV2SingleSelectFieldOption
+	Target string
+}
+
+// OptionEditPlan describes the changes between a field's options and an edited options document.
+// Order lists the remaining options in their new order; Reorder is set when it differs
+// from the order the options have after the other changes.
+type OptionEditPlan struct {
+	Creates []OptionEntry
+	Updates []OptionUpdate
+	Merges  []OptionMerge
+	Deletes []graphql.ProjectV2SingleSelectFieldOption
+	Order   []OptionEntry
+	Reorder bool
+}
+
+// OptionEditResult represents the result of applying an option edit plan
+type OptionEditResult struct {
+	Errors  []string
+	Applied int
+	Moved   int
+	Failed  int
+}
+
+// RenderOptionsDocument renders the options of a single select field for editing
+func RenderOptionsDocument(field *graphql.ProjectV2Field) (string, error) {
+	document := optionsDocument{Options: make([]OptionEntry, len(field.Options.Nodes))}
+	for i, option := range field.Options.Nodes {
+		document.Options[i] = OptionEntry{
+			ID:          option.ID,
+			Name:        option.Name,
+			Color:       strings.ToLower(option.Color),
+			Description: derefString(option.Description),
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return "", fmt.Errorf("failed to render options: %w", err)
+	}
+
+	return fmt.Sprintf(optionsDocumentHeader, field.Name) + buf.String(), nil
+}
+
+// ParseOptionsDocument parses an edited options document
+func ParseOptionsDocument(text string) ([]OptionEntry, error) {
+	var document optionsDocument
+	if err := yaml.Unmarshal([]byte(text), &document); err != nil {
+		return nil, fmt.Errorf("invalid options document: %w", err)
+	}
+
+	for i := range document.Options {
+		entry := &document.Options[i]
+		entry.Name = strings.TrimSpace(entry.Name)
+		entry.MergeInto = strings.TrimSpace(entry.MergeInto)
+		if entry.Color == "" {
+			entry.Color = defaultOptionColor
+		}
+		entry.Color = NormalizeColor(entry.Color)
+	}
+
+	return document.Options, nil
+}
+
+// PlanOptionEdits compares a field's options with edited entries
+func PlanOptionEdits(current []graphql.ProjectV2SingleSelectFieldOption, entries []OptionEntry) (*OptionEditPlan, error) {
+	existing := make(map[string]graphql.ProjectV2SingleSelectFieldOption, len(current))
+	for _, option := range current {
+		existing[option.ID] = option
+	}
+
+	if err := validateOptionEntries(entries, existing); err != nil {
+		return nil, err
+	}
+
+	plan := &OptionEditPlan{}
+	kept := make(map[string]bool)
+
+	for _, entry := range entries {
+		if entry.MergeInto != "" {
+			plan.Merges = append(plan.Merges, OptionMerge{Source: existing[entry.ID], Target: entry.MergeInto})
+			kept[entry.ID] = true
+			continue
+		}
+
+		plan.Order = append(plan.Order, entry)
+
+		if entry.ID == "" {
+			plan.Creates = append(plan.Creates, entry)
+			continue
+		}
+		kept[entry.ID] = true
+
+		option := existing[entry.ID]
+		var changes []string
+		if option.Name != entry.Name {
+			changes = append(changes, fmt.Sprintf("name %s → %s", option.Name, entry.Name))
+		}
+		if !strings.EqualFold(option.Color, entry.Color) {
+			changes = append(changes, fmt.Sprintf("color %s → %s", FormatColor(option.Color), FormatColor(entry.Color)))
+		}
+		if derefString(option.Description) != entry.Description {
+			changes = append(changes, "description")
+		}
+		if len(changes) > 0 {
+			plan.Updates = append(plan.Updates, OptionUpdate{From: option, To: entry, Changes: changes})
+		}
+	}
+
+	for _, option := range current {
+		if !kept[option.ID] {
+			plan.Deletes = append(plan.Deletes, option)
+		}
+	}
+
+	// Without reordering, the remaining options keep their order and new options are appended
+	var natural []string
+	for _, option := range current {
+		if kept[option.ID] && !isMergeSource(plan.Merges, option.ID) {
+			natural = append(natural, option.ID)
+		}
+	}
+	for range plan.Creates {
+		natural = append(natural, "")
+	}
+	for i, entry := range plan.Order {
+		if natural[i] != entry.ID {
+			plan.Reorder = true
+			break
+		}
+	}
+
+	return plan, nil
+}
+
+func validateOptionEntries(entries []OptionEntry, existing map[string]graphql.ProjectV2SingleSelectFieldOption) error {
+	ids := make(map[string]bool)
+	names := make(map[string]bool)
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Name == "" {
+			return fmt.Errorf("option %d has no name", i+1)
+		}
+		if err := ValidateColor(entry.Color); err != nil {
+			return fmt.Errorf("option %s: %w", entry.Name, err)
+		}
+
+		if entry.ID != "" {
+			if _, ok := existing[entry.ID]; !ok {
+				return fmt.Errorf("option %s has an unknown id: %s", entry.Name, entry.ID)
+			}
+			if ids[entry.ID] {
+				return fmt.Errorf("option id %s is listed more than once", entry.ID)
+			}
+			ids[entry.ID] = true
+		}
+
+		if entry.MergeInto != "" {
+			if entry.ID == "" {
+				return fmt.Errorf("new option %s cannot be merged", entry.Name)
+			}
+			continue
+		}
+
+		key := strings.ToLower(entry.Name)
+		if names[key] {
+			return fmt.Errorf("duplicate option name: %s", entry.Name)
+		}
+		names[key] = true
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.MergeInto != "" && !names[strings.ToLower(entry.MergeInto)] {
+			return fmt.Errorf("option %s is merged into %s, which is not a remaining option", entry.Name, entry.MergeInto)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty reports whether the plan makes no changes
+func (p *OptionEditPlan) IsEmpty() bool {
+	return len(p.Creates) == 0 && len(p.Updates) == 0 && len(p.Merges) == 0 && len(p.Deletes) == 0 && !p.Reorder
+}
+
+// DescribeOptionEditPlan lists the changes of a plan in the order they are applied
+func DescribeOptionEditPlan(plan *OptionEditPlan) []string {
+	var descriptions []string
+	for _, entry := range plan.Creates {
+		descriptions = append(descriptions, fmt.Sprintf("create option %s (%s)", entry.Name, FormatColor(entry.Color)))
+	}
+	for _, update := range plan.Updates {
+		descriptions = append(descriptions, fmt.Sprintf("update option %s: %s", update.From.Name, strings.Join(update.Changes, ", ")))
+	}
+	for _, merge := range plan.Merges {
+		descriptions = append(descriptions, fmt.Sprintf("merge option %s into %s", merge.Source.Name, merge.Target))
+	}
+	for _, option := range plan.Deletes {
+		descriptions = append(descriptions, fmt.Sprintf("delete option %s", option.Name))
+	}
+	if plan.Reorder {
+		names := make([]string, len(plan.Order))
+		for i, entry := range plan.Order {
+			names[i] = entry.Name
+		}
+		descriptions = append(descriptions, "reorder options: "+strings.Join(names, ", "))
+	}
+	return descriptions
+}
+
+// ApplyOptionEdits applies an option edit plan to a single select field. Options are
+// created and updated first, then merged options have their items moved before they
+// are deleted, and finally the options are reordered. A merged option is only deleted
+// when all of its items were moved.
+func (s *FieldService) ApplyOptionEdits(ctx context.Context, projectID string, field *graphql.ProjectV2Field, items []ProjectItemInfo, plan *OptionEditPlan) *OptionEditResult {
+	result := &OptionEditResult{}
+	fail := func(description string, err error) {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", description, err))
+		result.Failed++
+	}
+
+	// Option IDs by their final (lowercase) name, for merges and reordering
+	ids := make(map[string]string)
+	for _, entry := range plan.Order {
+		if entry.ID != "" {
+			ids[strings.ToLower(entry.Name)] = entry.ID
+		}
+	}
+
+	for _, entry := range plan.Creates {
+		description := entry.Description
+		option, err := s.CreateFieldOption(ctx, CreateFieldOptionInput{
+			FieldID:     field.ID,
+			Name:        entry.Name,
+			Color:       entry.Color,
+			Description: &description,
+		})
+		if err != nil {
+			fail("create option "+entry.Name, err)
+			continue
+		}
+		ids[strings.ToLower(entry.Name)] = option.ID
+		result.Applied++
+	}
+
+	for _, update := range plan.Updates {
+		name, color, description := update.To.Name, update.To.Color, update.To.Description
+		_, err := s.UpdateFieldOption(ctx, UpdateFieldOptionInput{
+			OptionID:    update.From.ID,
+			Name:        &name,
+			Color:       &color,
+			Description: &description,
+		})
+		if err != nil {
+			fail("update option "+update.From.Name, err)
+			continue
+		}
+		result.Applied++
+	}
+
+	itemService := NewItemService(s.client)
+	for _, merge := range plan.Merges {
+		targetID, ok := ids[strings.ToLower(merge.Target)]
+		if !ok {
+			fail("merge option "+merge.Source.Name, fmt.Errorf("option %s was not created", merge.Target))
+			continue
+		}
+
+		moved, failed := 0, 0
+		for i := range items {
+			item := &items[i]
+			if fieldValue(item, field.Name) != merge.Source.Name {
+				continue
+			}
+			err := itemService.SetItemFieldValues(ctx, projectID, item.ItemID, map[string]interface{}{
+				field.ID: map[string]interface{}{"singleSelectOptionId": targetID},
+			})
+			if err != nil {
+				fail(fmt.Sprintf("move %s to %s", itemLabel(item), merge.Target), err)
+				failed++
+				continue
+			}
+			moved++
+		}
+		result.Moved += moved
+
+		if failed > 0 {
+			fail("merge option "+merge.Source.Name, fmt.Errorf("kept the option because %d items could not be moved", failed))
+			continue
+		}
+		if err := s.DeleteFieldOption(ctx, DeleteFieldOptionInput{OptionID: merge.Source.ID}); err != nil {
+			fail("delete merged option "+merge.Source.Name, err)
+			continue
+		}
+		result.Applied++
+	}
+
+	for _, option := range plan.Deletes {
+		if err := s.DeleteFieldOption(ctx, DeleteFieldOptionInput{OptionID: option.ID}); err != nil {
+			fail("delete option "+option.Name, err)
+			continue
+		}
+		result.Applied++
+	}
+
+	if plan.Reorder && result.Failed > 0 {
+		fail("reorder options", fmt.Errorf("skipped because other changes failed"))
+		return result
+	}
+
+	if plan.Reorder {
+		options := make([]graphql.SingleSelectOptionInput, 0, len(plan.Order))
+		for _, entry := range plan.Order {
+			id, ok := ids[strings.ToLower(entry.Name)]
+			if !ok {
+				fail("reorder options", fmt.Errorf("option %s was not created", entry.Name))
+				return result
+			}
+			options = append(options, graphql.SingleSelectOptionInput{
+				ID:          id,
+				Name:        entry.Name,
+				Color:       entry.Color,
+				Description: entry.Description,
+			})
+		}
+
+		if _, err := s.UpdateField(ctx, UpdateFieldInput{FieldID: field.ID, SingleSelectOptions: options}); err != nil {
+			fail("reorder options", err)
+			return result
+		}
+		result.Applied++
+	}
+
+	return result
+}
+
+func isMergeSource(merges []OptionMerge, id string) bool {
+	for _, merge := range merges {
+		if merge.Source.ID == id {
+			return true
+		}
+	}
+	return false
+}
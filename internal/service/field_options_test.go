@@ -0,0 +1,137 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func testSelectField() *graphql.ProjectV2Field {
+	urgent := "Drop everything"
+	field := &graphql.ProjectV2Field{ID: "field-priority", Name: "Priority", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+	field.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{
+		{ID: "opt-p0", Name: "P0", Color: "RED", Description: &urgent},
+		{ID: "opt-p1", Name: "P1", Color: "ORANGE"},
+		{ID: "opt-high", Name: "High", Color: "YELLOW"},
+		{ID: "opt-later", Name: "Later", Color: "GRAY"},
+	}
+	return field
+}
+
+func TestOptionsDocument(t *testing.T) {
+	t.Run("Round trips the options of a field", func(t *testing.T) {
+		field := testSelectField()
+
+		text, err := RenderOptionsDocument(field)
+		require.NoError(t, err)
+		assert.Contains(t, text, "# Edit the options of Priority.")
+		assert.Contains(t, text, "id: opt-p0")
+		assert.Contains(t, text, "color: red")
+
+		entries, err := ParseOptionsDocument(text)
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		assert.Equal(t, OptionEntry{ID: "opt-p0", Name: "P0", Color: "RED", Description: "Drop everything"}, entries[0])
+
+		plan, err := PlanOptionEdits(field.Options.Nodes, entries)
+		require.NoError(t, err)
+		assert.True(t, plan.IsEmpty())
+	})
+
+	t.Run("Defaults new options to gray", func(t *testing.T) {
+		entries, err := ParseOptionsDocument("options:\n  - name: New\n")
+		require.NoError(t, err)
+		assert.Equal(t, "GRAY", entries[0].Color)
+	})
+}
+
+func TestPlanOptionEdits(t *testing.T) {
+	current := testSelectField().Options.Nodes
+
+	t.Run("Plans creates, updates, merges, deletes and reordering", func(t *testing.T) {
+		entries := []OptionEntry{
+			{ID: "opt-p1", Name: "P1", Color: "ORANGE"},
+			{ID: "opt-p0", Name: "Urgent", Color: "RED", Description: "Drop everything"},
+			{ID: "opt-high", Name: "High", Color: "YELLOW", MergeInto: "p1"},
+			{Name: "P2", Color: "BLUE"},
+		}
+
+		plan, err := PlanOptionEdits(current, entries)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"create option P2 (Blue)",
+			"update option P0: name P0 → Urgent",
+			"merge option High into p1",
+			"delete option Later",
+			"reorder options: P1, Urgent, P2",
+		}, DescribeOptionEditPlan(plan))
+	})
+
+	t.Run("Does not reorder when options keep their order", func(t *testing.T) {
+		entries := []OptionEntry{
+			{ID: "opt-p0", Name: "P0", Color: "RED", Description: "Drop everything"},
+			{ID: "opt-high", Name: "High", Color: "YELLOW", MergeInto: "P1"},
+			{ID: "opt-p1", Name: "P1", Color: "GREEN"},
+			{ID: "opt-later", Name: "Later", Color: "GRAY"},
+			{Name: "P3", Color: "GRAY"},
+		}
+
+		plan, err := PlanOptionEdits(current, entries)
+		require.NoError(t, err)
+
+		assert.False(t, plan.Reorder)
+		assert.Len(t, plan.Creates, 1)
+		assert.Len(t, plan.Updates, 1)
+		assert.Len(t, plan.Merges, 1)
+		assert.Empty(t, plan.Deletes)
+	})
+
+	t.Run("Reorders fields with more than 20 options without deleting any", func(t *testing.T) {
+		field := &graphql.ProjectV2Field{ID: "field-area", Name: "Area", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+		for i := 1; i <= 25; i++ {
+			field.Options.Nodes = append(field.Options.Nodes, graphql.ProjectV2SingleSelectFieldOption{
+				ID: fmt.Sprintf("opt-%d", i), Name: fmt.Sprintf("Area %d", i), Color: "GRAY",
+			})
+		}
+
+		text, err := RenderOptionsDocument(field)
+		require.NoError(t, err)
+		entries, err := ParseOptionsDocument(text)
+		require.NoError(t, err)
+		require.Len(t, entries, 25)
+
+		// Move the last option to the front
+		entries = append([]OptionEntry{entries[24]}, entries[:24]...)
+
+		plan, err := PlanOptionEdits(field.Options.Nodes, entries)
+		require.NoError(t, err)
+
+		assert.True(t, plan.Reorder)
+		assert.Empty(t, plan.Deletes)
+		require.Len(t, plan.Order, 25)
+		assert.Equal(t, "opt-25", plan.Order[0].ID)
+		assert.Equal(t, "opt-24", plan.Order[24].ID)
+	})
+
+	t.Run("Rejects invalid edits", func(t *testing.T) {
+		tests := map[string][]OptionEntry{
+			"empty name":      {{ID: "opt-p0", Color: "RED"}},
+			"invalid color":   {{ID: "opt-p0", Name: "P0", Color: "TEAL"}},
+			"unknown id":      {{ID: "opt-x", Name: "X", Color: "RED"}},
+			"duplicate id":    {{ID: "opt-p0", Name: "P0", Color: "RED"}, {ID: "opt-p0", Name: "P00", Color: "RED"}},
+			"duplicate name":  {{ID: "opt-p0", Name: "P0", Color: "RED"}, {ID: "opt-p1", Name: "p0", Color: "RED"}},
+			"merge new":       {{ID: "opt-p0", Name: "P0", Color: "RED"}, {Name: "New", Color: "RED", MergeInto: "P0"}},
+			"merge missing":   {{ID: "opt-p0", Name: "P0", Color: "RED", MergeInto: "P9"}},
+			"merge to merged": {{ID: "opt-p0", Name: "P0", Color: "RED", MergeInto: "P1"}, {ID: "opt-p1", Name: "P1", Color: "RED", MergeInto: "P0"}},
+		}
+		for name, entries := range tests {
+			_, err := PlanOptionEdits(current, entries)
+			assert.Error(t, err, name)
+		}
+	})
+}
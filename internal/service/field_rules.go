@@ -0,0 +1,347 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// fieldRulesFileName is the rule file looked up in the home directory
+const fieldRulesFileName = ".ghp-rules.yaml"
+
+// FieldRules holds local field validation rules, keyed by project (owner/number).
+// GitHub has no required fields or value constraints; these rules are checked by the
+// item commands before they change field values and reported by 'ghp field lint'.
+//
+// Example rule file:
+//
+//	projects:
+//	  octocat/123:
+//	    - field: Estimate
+//	      values: [1, 2, 3, 5, 8]
+//	    - field: Due
+//	      min: "@today+1d"
+//	      message: Due must be in the future
+//	    - field: Priority
+//	      required: true
+//	      when: status:"In Progress"
+type FieldRules struct {
+	Projects map[string][]FieldRule `json:"projects" yaml:"projects"`
+}
+
+// FieldRule constrains the value of a field. When limits the rule to items matching
+// an item filter. Min and max are inclusive and compare numbers as numbers and dates
+// (YYYY-MM-DD or @today expressions) as dates. Constraints other than required only
+// apply to items that have a value.
+type FieldRule struct {
+	when     *ItemFilter
+	pattern  *regexp.Regexp
+	Field    string   `json:"field" yaml:"field"`
+	When     string   `json:"when,omitempty" yaml:"when,omitempty"`
+	Min      string   `json:"min,omitempty" yaml:"min,omitempty"`
+	Max      string   `json:"max,omitempty" yaml:"max,omitempty"`
+	Pattern  string   `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Message  string   `json:"message,omitempty" yaml:"message,omitempty"`
+	Values   []string `json:"values,omitempty" yaml:"values,omitempty"`
+	Required bool     `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// RuleViolation is a field value that breaks a rule
+type RuleViolation struct {
+	Field   string
+	Value   string
+	Message string
+}
+
+// ItemViolations lists the rules an item breaks
+type ItemViolations struct {
+	Item       ProjectItemInfo
+	Violations []RuleViolation
+}
+
+// FieldRulesPath returns the rule file to use: the configured path, or
+// ~/.ghp-rules.yaml when none is configured
+func FieldRulesPath(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fieldRulesFileName
+	}
+	return filepath.Join(home, fieldRulesFileName)
+}
+
+// LoadProjectFieldRules reads the rules of a project from the configured rule file, or
+// from ~/.ghp-rules.yaml when none is configured. A missing default file means no rules.
+func LoadProjectFieldRules(configured, projectRef string) ([]FieldRule, error) {
+	path := FieldRulesPath(configured)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if configured == "" && os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read rule file: %w", err)
+	}
+
+	rules, err := ParseFieldRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return rules.ForProject(projectRef)
+}
+
+// ParseFieldRules parses and validates a rule file
+func ParseFieldRules(data []byte) (*FieldRules, error) {
+	rules := &FieldRules{}
+	if err := yaml.Unmarshal(data, rules); err != nil {
+		return nil, fmt.Errorf("failed to parse rule file: %w", err)
+	}
+
+	for project, projectRules := range rules.Projects {
+		if _, _, err := ParseProjectReference(project); err != nil {
+			return nil, fmt.Errorf("invalid project %s: %w", project, err)
+		}
+		for i := range projectRules {
+			if err := compileFieldRule(&projectRules[i]); err != nil {
+				return nil, fmt.Errorf("project %s: rule %d: %w", project, i+1, err)
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+func compileFieldRule(rule *FieldRule) error {
+	rule.Field = strings.TrimSpace(rule.Field)
+	if rule.Field == "" {
+		return fmt.Errorf("no field")
+	}
+	if !rule.Required && len(rule.Values) == 0 && rule.Min == "" && rule.Max == "" && rule.Pattern == "" {
+		return fmt.Errorf("%s has no constraint (expected required, values, min, max or pattern)", rule.Field)
+	}
+
+	if rule.When != "" {
+		filter, err := ParseItemFilter(rule.When)
+		if err != nil {
+			return fmt.Errorf("%s has an invalid condition: %w", rule.Field, err)
+		}
+		rule.when = filter
+	}
+
+	if rule.Pattern != "" {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return fmt.Errorf("%s has an invalid pattern: %w", rule.Field, err)
+		}
+		rule.pattern = pattern
+	}
+
+	for _, bound := range []string{rule.Min, rule.Max} {
+		if bound == "" {
+			continue
+		}
+		if _, err := resolveRuleBound(bound, time.Now()); err != nil {
+			return fmt.Errorf("%s: %w", rule.Field, err)
+		}
+	}
+
+	return nil
+}
+
+// ForProject returns the rules of a project. Owners are matched case-insensitively.
+func (r *FieldRules) ForProject(projectRef string) ([]FieldRule, error) {
+	owner, number, err := ParseProjectReference(projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	for project, rules := range r.Projects {
+		ruleOwner, ruleNumber, _ := ParseProjectReference(project)
+		if strings.EqualFold(ruleOwner, owner) && ruleNumber == number {
+			return rules, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ValidateFieldRules checks that the fields named by rules exist in the project and
+// that allowed values of single select fields are options of the field
+func ValidateFieldRules(rules []FieldRule, fields []graphql.ProjectV2Field) error {
+	for i := range rules {
+		rule := &rules[i]
+		field, err := FindProjectField(fields, rule.Field)
+		if err != nil {
+			return fmt.Errorf("rule for %s: %w", rule.Field, err)
+		}
+
+		if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+			continue
+		}
+		for _, value := range rule.Values {
+			if _, err := BuildFieldValue(field, value); err != nil {
+				return fmt.Errorf("rule for %s: %w", rule.Field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckFieldRules returns the rules an item breaks, in rule order
+func CheckFieldRules(rules []FieldRule, item *ProjectItemInfo, now time.Time) []RuleViolation {
+	var violations []RuleViolation
+
+	for i := range rules {
+		rule := &rules[i]
+		if rule.when != nil && !MatchesItemFilter(item, rule.when) {
+			continue
+		}
+
+		value := fieldValue(item, rule.Field)
+		if message := checkFieldRule(rule, value, now); message != "" {
+			if rule.Message != "" {
+				message = rule.Message
+			}
+			violations = append(violations, RuleViolation{Field: rule.Field, Value: value, Message: message})
+		}
+	}
+
+	return violations
+}
+
+// CheckFieldChange returns the rules an item would newly break once the given field
+// values (keyed by field name) are set. An empty value clears the field. Rules the
+// item already breaks are not reported, so unrelated edits are not blocked.
+func CheckFieldChange(rules []FieldRule, item *ProjectItemInfo, changes map[string]string, now time.Time) []RuleViolation {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	before := CheckFieldRules(rules, item, now)
+
+	changed := *item
+	changed.FieldValues = make(map[string]string, len(item.FieldValues)+len(changes))
+	for name, value := range item.FieldValues {
+		changed.FieldValues[name] = value
+	}
+	for name, value := range changes {
+		setItemFieldValue(&changed, name, value)
+	}
+
+	var violations []RuleViolation
+	for _, violation := range CheckFieldRules(rules, &changed, now) {
+		if !containsViolation(before, violation) {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// LintProjectItems returns the items that break rules, keeping the items' order.
+// Archived items are not checked.
+func LintProjectItems(rules []FieldRule, items []ProjectItemInfo, now time.Time) []ItemViolations {
+	var results []ItemViolations
+	for i := range items {
+		if items[i].Archived {
+			continue
+		}
+		if violations := CheckFieldRules(rules, &items[i], now); len(violations) > 0 {
+			results = append(results, ItemViolations{Item: items[i], Violations: violations})
+		}
+	}
+	return results
+}
+
+// FormatRuleViolations joins the messages of violations for use in errors
+func FormatRuleViolations(violations []RuleViolation) string {
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = violation.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// checkFieldRule returns a message when value breaks the rule
+func checkFieldRule(rule *FieldRule, value string, now time.Time) string {
+	if value == "" {
+		if rule.Required {
+			if rule.When != "" {
+				return fmt.Sprintf("%s is required when %s", rule.Field, rule.When)
+			}
+			return fmt.Sprintf("%s is required", rule.Field)
+		}
+		return ""
+	}
+
+	if len(rule.Values) > 0 && !matchesAllowedValue(rule.Values, value) {
+		return fmt.Sprintf("%s must be one of %s (got %s)", rule.Field, strings.Join(rule.Values, ", "), value)
+	}
+
+	if rule.Min != "" {
+		if bound, err := resolveRuleBound(rule.Min, now); err == nil && compareFilterValues(value, bound) < 0 {
+			return fmt.Sprintf("%s must be at least %s (got %s)", rule.Field, bound, value)
+		}
+	}
+	if rule.Max != "" {
+		if bound, err := resolveRuleBound(rule.Max, now); err == nil && compareFilterValues(value, bound) > 0 {
+			return fmt.Sprintf("%s must be at most %s (got %s)", rule.Field, bound, value)
+		}
+	}
+
+	if rule.pattern != nil && !rule.pattern.MatchString(value) {
+		return fmt.Sprintf("%s must match %s (got %s)", rule.Field, rule.Pattern, value)
+	}
+
+	return ""
+}
+
+// resolveRuleBound resolves a min or max bound: a number, a YYYY-MM-DD date or an
+// @today expression
+func resolveRuleBound(bound string, now time.Time) (string, error) {
+	bound = strings.TrimSpace(bound)
+	if strings.HasPrefix(strings.ToLower(bound), "@today") {
+		return resolveTodayExpression(bound, now)
+	}
+	if _, err := strconv.ParseFloat(bound, 64); err == nil {
+		return bound, nil
+	}
+	if _, err := time.Parse(dateLayout, bound); err == nil {
+		return bound, nil
+	}
+	return "", fmt.Errorf("invalid bound: %s (expected a number, YYYY-MM-DD or @today expression)", bound)
+}
+
+// matchesAllowedValue compares case-insensitively, and numerically when both are numbers
+func matchesAllowedValue(allowed []string, value string) bool {
+	number, numErr := strconv.ParseFloat(value, 64)
+	for _, candidate := range allowed {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+		if numErr == nil {
+			if allowedNumber, err := strconv.ParseFloat(candidate, 64); err == nil && allowedNumber == number {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsViolation(violations []RuleViolation, violation RuleViolation) bool {
+	for _, existing := range violations {
+		if existing.Field == violation.Field && existing.Message == violation.Message {
+			return true
+		}
+	}
+	return false
+}
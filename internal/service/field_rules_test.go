@@ -0,0 +1,215 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+const testFieldRules = `
+projects:
+  Octocat/123:
+    - field: Estimate
+      values: [1, 2, 3, 5, 8]
+    - field: Due
+      min: "@today+1d"
+      message: Due must be in the future
+    - field: Priority
+      required: true
+      when: status:"In Progress"
+    - field: Code
+      pattern: ^[A-Z]{3}-\d+$
+  octocat/7:
+    - field: Size
+      required: true
+`
+
+var rulesNow = time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)
+
+func testProjectRules(t *testing.T) []FieldRule {
+	t.Helper()
+	rules, err := ParseFieldRules([]byte(testFieldRules))
+	require.NoError(t, err)
+	projectRules, err := rules.ForProject("octocat/123")
+	require.NoError(t, err)
+	return projectRules
+}
+
+func TestParseFieldRules(t *testing.T) {
+	t.Run("Parses rules keyed by project", func(t *testing.T) {
+		rules := testProjectRules(t)
+
+		require.Len(t, rules, 4)
+		assert.Equal(t, []string{"1", "2", "3", "5", "8"}, rules[0].Values)
+		assert.Equal(t, "@today+1d", rules[1].Min)
+		assert.True(t, rules[2].Required)
+	})
+
+	t.Run("Projects without rules have none", func(t *testing.T) {
+		rules, err := ParseFieldRules([]byte(testFieldRules))
+		require.NoError(t, err)
+
+		projectRules, err := rules.ForProject("octocat/1")
+		assert.NoError(t, err)
+		assert.Empty(t, projectRules)
+	})
+
+	t.Run("Invalid rules return errors", func(t *testing.T) {
+		tests := []struct {
+			data    string
+			message string
+		}{
+			{"projects: {octocat: [{field: Size, required: true}]}", "invalid project"},
+			{"projects: {octocat/1: [{required: true}]}", "no field"},
+			{"projects: {octocat/1: [{field: Size}]}", "has no constraint"},
+			{"projects: {octocat/1: [{field: Size, required: true, when: 'updated:<@today-2x'}]}", "invalid condition"},
+			{"projects: {octocat/1: [{field: Code, pattern: '['}]}", "invalid pattern"},
+			{"projects: {octocat/1: [{field: Due, min: soon}]}", "invalid bound"},
+			{"projects: {", "failed to parse"},
+		}
+
+		for _, tt := range tests {
+			_, err := ParseFieldRules([]byte(tt.data))
+			assert.ErrorContains(t, err, tt.message)
+		}
+	})
+}
+
+func TestLoadProjectFieldRules(t *testing.T) {
+	t.Run("Reads the configured file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "rules.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(testFieldRules), 0o600))
+
+		rules, err := LoadProjectFieldRules(path, "octocat/7")
+
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "Size", rules[0].Field)
+	})
+
+	t.Run("A missing configured file is an error", func(t *testing.T) {
+		_, err := LoadProjectFieldRules(filepath.Join(t.TempDir(), "missing.yaml"), "octocat/7")
+		assert.ErrorContains(t, err, "failed to read rule file")
+	})
+
+	t.Run("A missing default file means no rules", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		rules, err := LoadProjectFieldRules("", "octocat/7")
+
+		assert.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+}
+
+func TestValidateFieldRules(t *testing.T) {
+	priority := graphql.ProjectV2Field{ID: "f-priority", Name: "Priority", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+	priority.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{{ID: "o-p0", Name: "P0"}, {ID: "o-p1", Name: "P1"}}
+	fields := []graphql.ProjectV2Field{
+		priority,
+		{ID: "f-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+	}
+
+	t.Run("Accepts rules on existing fields and options", func(t *testing.T) {
+		rules := []FieldRule{{Field: "priority", Values: []string{"P0", "p1"}}, {Field: "Estimate", Min: "1"}}
+		assert.NoError(t, ValidateFieldRules(rules, fields))
+	})
+
+	t.Run("Rejects unknown fields and options", func(t *testing.T) {
+		assert.ErrorContains(t, ValidateFieldRules([]FieldRule{{Field: "Size", Required: true}}, fields), "rule for Size")
+		assert.ErrorContains(t, ValidateFieldRules([]FieldRule{{Field: "Priority", Values: []string{"P9"}}}, fields), "rule for Priority")
+	})
+}
+
+func TestCheckFieldRules(t *testing.T) {
+	rules := testProjectRules(t)
+
+	t.Run("Items within the rules pass", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{
+			"Estimate": "5", "Due": "2025-03-20", "Status": "In Progress", "Priority": "P1", "Code": "API-12",
+		}}
+		assert.Empty(t, CheckFieldRules(rules, item, rulesNow))
+	})
+
+	t.Run("Reports every broken rule", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{
+			"estimate": "4", "Due": "2025-03-14", "Status": "In Progress", "Code": "api-12",
+		}}
+
+		violations := CheckFieldRules(rules, item, rulesNow)
+
+		require.Len(t, violations, 4)
+		assert.Equal(t, "Estimate must be one of 1, 2, 3, 5, 8 (got 4)", violations[0].Message)
+		assert.Equal(t, "Due must be in the future", violations[1].Message)
+		assert.Equal(t, `Priority is required when status:"In Progress"`, violations[2].Message)
+		assert.Equal(t, `Code must match ^[A-Z]{3}-\d+$ (got api-12)`, violations[3].Message)
+	})
+
+	t.Run("Numbers compare numerically", func(t *testing.T) {
+		bounded := []FieldRule{{Field: "Estimate", Values: []string{"1", "2", "3"}}, {Field: "Estimate", Max: "20"}}
+		item := &ProjectItemInfo{FieldValues: map[string]string{"Estimate": "3.0"}}
+		assert.Empty(t, CheckFieldRules(bounded, item, rulesNow))
+
+		item.FieldValues["Estimate"] = "100"
+		violations := CheckFieldRules(bounded[1:], item, rulesNow)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "Estimate must be at most 20 (got 100)", violations[0].Message)
+	})
+
+	t.Run("Conditional rules only apply to matching items", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{"Status": "Todo"}}
+		assert.Empty(t, CheckFieldRules(rules, item, rulesNow))
+	})
+}
+
+func TestCheckFieldChange(t *testing.T) {
+	rules := testProjectRules(t)
+
+	t.Run("Reports violations introduced by the change", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{"Status": "Todo"}}
+
+		violations := CheckFieldChange(rules, item, map[string]string{"status": "In Progress"}, rulesNow)
+
+		require.Len(t, violations, 1)
+		assert.Equal(t, "Priority", violations[0].Field)
+		assert.Equal(t, "Todo", item.FieldValues["Status"])
+	})
+
+	t.Run("Clearing a required value is a violation", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{"Status": "In Progress", "Priority": "P1"}}
+
+		violations := CheckFieldChange(rules, item, map[string]string{"Priority": ""}, rulesNow)
+
+		require.Len(t, violations, 1)
+		assert.Equal(t, "Priority", violations[0].Field)
+	})
+
+	t.Run("Existing violations do not block unrelated changes", func(t *testing.T) {
+		item := &ProjectItemInfo{FieldValues: map[string]string{"Status": "In Progress", "Estimate": "4"}}
+
+		violations := CheckFieldChange(rules, item, map[string]string{"Code": "API-1"}, rulesNow)
+
+		assert.Empty(t, violations)
+	})
+}
+
+func TestLintProjectItems(t *testing.T) {
+	rules := testProjectRules(t)
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", FieldValues: map[string]string{"Estimate": "3"}},
+		{ItemID: "item-2", FieldValues: map[string]string{"Estimate": "13"}},
+		{ItemID: "item-3", FieldValues: map[string]string{"Estimate": "13"}, Archived: true},
+	}
+
+	results := LintProjectItems(rules, items, rulesNow)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, "item-2", results[0].Item.ItemID)
+	assert.Equal(t, "Estimate", results[0].Violations[0].Field)
+}
@@ -0,0 +1,612 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// Schema operation kinds, in the order they are applied
+const (
+	SchemaRenameField  = "rename-field"
+	SchemaCreateField  = "create-field"
+	SchemaAddOption    = "add-option"
+	SchemaUpdateOption = "update-option"
+	SchemaSetDuration  = "set-iteration-duration"
+	SchemaDeleteOption = "delete-option"
+	// SchemaReorderOptions puts options in the schema's order once they are added and deleted
+	SchemaReorderOptions = "reorder-options"
+	SchemaDeleteField    = "delete-field"
+)
+
+// defaultOptionColor is the color of options created without a color
+const defaultOptionColor = graphql.SingleSelectColorGray
+
+// FieldSchema is a declarative description of a project's custom fields.
+//
+// Example schema file:
+//
+//	fields:
+//	  - name: Priority
+//	    type: single_select
+//	    options:
+//	      - name: P0
+//	        color: red
+//	        description: Drop everything
+//	      - name: P1
+//	        color: orange
+//	  - name: Severity
+//	    type: single_select
+//	    renamed_from: Sev
+//	  - name: Estimate
+//	    type: number
+//	  - name: Sprint
+//	    type: iteration
+//	    iteration:
+//	      duration: 2w
+//	      start_date: 2025-03-03
+type FieldSchema struct {
+	Fields []FieldSpec `json:"fields" yaml:"fields"`
+}
+
+// FieldSpec describes a single field. Options are only used by single select fields
+// and Iteration only by iteration fields. RenamedFrom names the field's previous name.
+type FieldSpec struct {
+	Iteration   *IterationSpec `json:"iteration,omitempty" yaml:"iteration,omitempty"`
+	Name        string         `json:"name" yaml:"name"`
+	Type        string         `json:"type" yaml:"type"`
+	RenamedFrom string         `json:"renamed_from,omitempty" yaml:"renamed_from,omitempty"`
+	Options     []OptionSpec   `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// OptionSpec describes a single select option. An omitted color or description
+// leaves the existing value alone; new options default to gray. Options are kept
+// in the order of the schema, followed by the options it does not list.
+type OptionSpec struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Name        string  `json:"name" yaml:"name"`
+	Color       string  `json:"color,omitempty" yaml:"color,omitempty"`
+	RenamedFrom string  `json:"renamed_from,omitempty" yaml:"renamed_from,omitempty"`
+}
+
+// IterationSpec describes the settings of an iteration field.
+// The start date is only used when the field is created.
+type IterationSpec struct {
+	Duration  string `json:"duration,omitempty" yaml:"duration,omitempty"`
+	StartDate string `json:"start_date,omitempty" yaml:"start_date,omitempty"`
+}
+
+// SchemaOperation is a single change needed to bring a project in line with a schema
+type SchemaOperation struct {
+	Spec     *FieldSpec
+	Option   *OptionSpec
+	Kind     string
+	Field    string
+	FieldID  string
+	OptionID string
+	Details  string
+	Duration int
+}
+
+// SchemaPlan is the set of changes needed to apply a schema. Unmanaged lists the
+// custom fields and options that are not in the schema and are kept without pruning.
+type SchemaPlan struct {
+	Operations []SchemaOperation
+	Unmanaged  []string
+}
+
+// SchemaApplyResult represents the result of applying a schema plan
+type SchemaApplyResult struct {
+	Errors  []string
+	Applied int
+	Failed  int
+}
+
+// LoadFieldSchema reads a field schema from a YAML or JSON file
+func LoadFieldSchema(path string) (*FieldSchema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema file: %w", err)
+	}
+
+	return ParseFieldSchema(data)
+}
+
+// ParseFieldSchema parses and validates a field schema
+func ParseFieldSchema(data []byte) (*FieldSchema, error) {
+	schema := &FieldSchema{}
+	if err := yaml.Unmarshal(data, schema); err != nil {
+		return nil, fmt.Errorf("failed to parse schema file: %w", err)
+	}
+
+	if len(schema.Fields) == 0 {
+		return nil, fmt.Errorf("schema file defines no fields")
+	}
+
+	names := make(map[string]bool, len(schema.Fields))
+	for i := range schema.Fields {
+		spec := &schema.Fields[i]
+		if err := validateFieldSpec(spec); err != nil {
+			return nil, err
+		}
+
+		key := strings.ToLower(spec.Name)
+		if names[key] {
+			return nil, fmt.Errorf("duplicate field in schema: %s", spec.Name)
+		}
+		names[key] = true
+	}
+
+	return schema, nil
+}
+
+func validateFieldSpec(spec *FieldSpec) error {
+	if err := ValidateFieldName(spec.Name); err != nil {
+		return fmt.Errorf("field %q: %w", spec.Name, err)
+	}
+
+	dataType, err := ValidateFieldType(strings.ReplaceAll(spec.Type, "-", "_"))
+	if err != nil {
+		return fmt.Errorf("field %s: %w", spec.Name, err)
+	}
+	spec.Type = string(dataType)
+
+	if len(spec.Options) > 0 && dataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return fmt.Errorf("field %s: options can only be set on single select fields", spec.Name)
+	}
+	if spec.Iteration != nil && dataType != graphql.ProjectV2FieldDataTypeIteration {
+		return fmt.Errorf("field %s: iteration settings can only be set on iteration fields", spec.Name)
+	}
+
+	if spec.Iteration != nil {
+		if spec.Iteration.Duration != "" {
+			if _, err := graphql.ParseDurationDays(spec.Iteration.Duration); err != nil {
+				return fmt.Errorf("field %s: %w", spec.Name, err)
+			}
+		}
+		if spec.Iteration.StartDate != "" {
+			if _, err := time.Parse(dateLayout, spec.Iteration.StartDate); err != nil {
+				return fmt.Errorf("field %s: invalid start date: %s (expected YYYY-MM-DD)", spec.Name, spec.Iteration.StartDate)
+			}
+		}
+	}
+
+	options := make(map[string]bool, len(spec.Options))
+	for i := range spec.Options {
+		option := &spec.Options[i]
+		if strings.TrimSpace(option.Name) == "" {
+			return fmt.Errorf("field %s: option %d has no name", spec.Name, i+1)
+		}
+		if options[strings.ToLower(option.Name)] {
+			return fmt.Errorf("field %s: duplicate option %s", spec.Name, option.Name)
+		}
+		options[strings.ToLower(option.Name)] = true
+
+		if option.Color != "" {
+			if err := ValidateColor(option.Color); err != nil {
+				return fmt.Errorf("field %s: option %s: %w", spec.Name, option.Name, err)
+			}
+			option.Color = NormalizeColor(option.Color)
+		}
+	}
+
+	return nil
+}
+
+// PlanFieldSchema compares a schema with the project's fields. Iteration durations
+// (in days, keyed by field ID) are compared for iteration fields that specify one.
+// Custom fields and options missing from the schema are deleted only when prune is set.
+func PlanFieldSchema(schema *FieldSchema, fields []FieldInfo, iterationDurations map[string]int, prune bool) (*SchemaPlan, error) {
+	plan := &SchemaPlan{}
+	managed := make(map[string]bool)
+
+	for i := range schema.Fields {
+		spec := &schema.Fields[i]
+
+		field := findFieldInfo(fields, spec.Name)
+		if field == nil && spec.RenamedFrom != "" {
+			field = findFieldInfo(fields, spec.RenamedFrom)
+			if field != nil {
+				plan.Operations = append(plan.Operations, SchemaOperation{
+					Kind:    SchemaRenameField,
+					Spec:    spec,
+					Field:   spec.Name,
+					FieldID: field.ID,
+					Details: fmt.Sprintf("%s → %s", field.Name, spec.Name),
+				})
+			}
+		}
+
+		if field == nil {
+			plan.Operations = append(plan.Operations, SchemaOperation{
+				Kind:  SchemaCreateField,
+				Spec:  spec,
+				Field: spec.Name,
+			})
+			continue
+		}
+
+		managed[field.ID] = true
+
+		if string(field.DataType) != spec.Type {
+			return nil, fmt.Errorf("field %s is a %s field but the schema declares %s (use 'ghp field convert' to change its type)",
+				field.Name, FormatFieldDataType(field.DataType), FormatFieldDataType(graphql.ProjectV2FieldDataType(spec.Type)))
+		}
+
+		if spec.Type == string(graphql.ProjectV2FieldDataTypeSingleSelect) {
+			plan.Operations = append(plan.Operations, planOptionOperations(spec, field, prune, &plan.Unmanaged)...)
+		}
+
+		if spec.Iteration != nil && spec.Iteration.Duration != "" {
+			days, _ := graphql.ParseDurationDays(spec.Iteration.Duration)
+			if current, ok := iterationDurations[field.ID]; ok && current != days {
+				plan.Operations = append(plan.Operations, SchemaOperation{
+					Kind:     SchemaSetDuration,
+					Spec:     spec,
+					Field:    spec.Name,
+					FieldID:  field.ID,
+					Duration: days,
+					Details:  fmt.Sprintf("%d → %d days", current, days),
+				})
+			}
+		}
+	}
+
+	for i := range fields {
+		field := &fields[i]
+		if managed[field.ID] || !isCustomField(field) {
+			continue
+		}
+		if !prune {
+			plan.Unmanaged = append(plan.Unmanaged, fmt.Sprintf("field %s (%s)", field.Name, FormatFieldDataType(field.DataType)))
+			continue
+		}
+		plan.Operations = append(plan.Operations, SchemaOperation{
+			Kind:    SchemaDeleteField,
+			Field:   field.Name,
+			FieldID: field.ID,
+			Details: FormatFieldDataType(field.DataType),
+		})
+	}
+
+	sortSchemaOperations(plan.Operations)
+	return plan, nil
+}
+
+func planOptionOperations(spec *FieldSpec, field *FieldInfo, prune bool, unmanaged *[]string) []SchemaOperation {
+	var operations []SchemaOperation
+	// Names of the existing options in the schema, keyed by option ID
+	matched := make(map[string]string)
+	var added []string
+
+	for i := range spec.Options {
+		optionSpec := &spec.Options[i]
+
+		option := findOptionInfo(field.Options, optionSpec.Name)
+		renamed := false
+		if option == nil && optionSpec.RenamedFrom != "" {
+			option = findOptionInfo(field.Options, optionSpec.RenamedFrom)
+			renamed = option != nil
+		}
+
+		if option == nil {
+			added = append(added, optionSpec.Name)
+			color := optionSpec.Color
+			if color == "" {
+				color = defaultOptionColor
+			}
+			operations = append(operations, SchemaOperation{
+				Kind:    SchemaAddOption,
+				Spec:    spec,
+				Option:  optionSpec,
+				Field:   spec.Name,
+				FieldID: field.ID,
+				Details: FormatColor(color),
+			})
+			continue
+		}
+
+		matched[option.ID] = optionSpec.Name
+
+		var changes []string
+		if renamed || option.Name != optionSpec.Name {
+			changes = append(changes, fmt.Sprintf("name %s → %s", option.Name, optionSpec.Name))
+		}
+		if optionSpec.Color != "" && !strings.EqualFold(option.Color, optionSpec.Color) {
+			changes = append(changes, fmt.Sprintf("color %s → %s", FormatColor(option.Color), FormatColor(optionSpec.Color)))
+		}
+		if optionSpec.Description != nil && derefString(option.Description) != *optionSpec.Description {
+			changes = append(changes, "description")
+		}
+		if len(changes) == 0 {
+			continue
+		}
+
+		operations = append(operations, SchemaOperation{
+			Kind:     SchemaUpdateOption,
+			Spec:     spec,
+			Option:   optionSpec,
+			Field:    spec.Name,
+			FieldID:  field.ID,
+			OptionID: option.ID,
+			Details:  strings.Join(changes, ", "),
+		})
+	}
+
+	// Order of the options once the other operations are applied: existing ones keep
+	// their place and new ones are appended
+	var order, kept []string
+	for i := range field.Options {
+		option := &field.Options[i]
+		if name, ok := matched[option.ID]; ok {
+			order = append(order, name)
+			continue
+		}
+		if !prune {
+			*unmanaged = append(*unmanaged, fmt.Sprintf("option %s in %s", option.Name, field.Name))
+			order = append(order, option.Name)
+			kept = append(kept, option.Name)
+			continue
+		}
+		operations = append(operations, SchemaOperation{
+			Kind:     SchemaDeleteOption,
+			Spec:     spec,
+			Option:   &OptionSpec{Name: option.Name},
+			Field:    spec.Name,
+			FieldID:  field.ID,
+			OptionID: option.ID,
+		})
+	}
+	order = append(order, added...)
+
+	wanted := make([]string, 0, len(order))
+	for i := range spec.Options {
+		wanted = append(wanted, spec.Options[i].Name)
+	}
+	wanted = append(wanted, kept...)
+
+	if !sameNamesInOrder(order, wanted) {
+		operations = append(operations, SchemaOperation{
+			Kind:    SchemaReorderOptions,
+			Spec:    spec,
+			Field:   spec.Name,
+			FieldID: field.ID,
+			Details: strings.Join(wanted, ", "),
+		})
+	}
+
+	return operations
+}
+
+// sameNamesInOrder compares two lists of names case-insensitively
+func sameNamesInOrder(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DescribeSchemaOperation returns a one-line description of an operation
+func DescribeSchemaOperation(op *SchemaOperation) string {
+	switch op.Kind {
+	case SchemaCreateField:
+		description := fmt.Sprintf("create field %s (%s)", op.Field, FormatFieldDataType(graphql.ProjectV2FieldDataType(op.Spec.Type)))
+		if len(op.Spec.Options) > 0 {
+			names := make([]string, len(op.Spec.Options))
+			for i := range op.Spec.Options {
+				names[i] = op.Spec.Options[i].Name
+			}
+			description += ": " + strings.Join(names, ", ")
+		}
+		return description
+	case SchemaRenameField:
+		return fmt.Sprintf("rename field %s", op.Details)
+	case SchemaAddOption:
+		return fmt.Sprintf("add option %s to %s (%s)", op.Option.Name, op.Field, op.Details)
+	case SchemaUpdateOption:
+		return fmt.Sprintf("update option %s in %s: %s", op.Option.Name, op.Field, op.Details)
+	case SchemaSetDuration:
+		return fmt.Sprintf("set iteration duration of %s: %s", op.Field, op.Details)
+	case SchemaDeleteOption:
+		return fmt.Sprintf("delete option %s from %s", op.Option.Name, op.Field)
+	case SchemaReorderOptions:
+		return fmt.Sprintf("reorder options of %s: %s", op.Field, op.Details)
+	case SchemaDeleteField:
+		return fmt.Sprintf("delete field %s (%s)", op.Field, op.Details)
+	default:
+		return op.Kind
+	}
+}
+
+// ApplyFieldSchema applies a schema plan to a project, continuing past failures
+func (s *FieldService) ApplyFieldSchema(ctx context.Context, projectID string, plan *SchemaPlan) *SchemaApplyResult {
+	result := &SchemaApplyResult{}
+
+	for i := range plan.Operations {
+		op := &plan.Operations[i]
+		if err := s.applySchemaOperation(ctx, projectID, op); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", DescribeSchemaOperation(op), err))
+			result.Failed++
+			continue
+		}
+		result.Applied++
+	}
+
+	return result
+}
+
+func (s *FieldService) applySchemaOperation(ctx context.Context, projectID string, op *SchemaOperation) error {
+	switch op.Kind {
+	case SchemaRenameField:
+		name := op.Spec.Name
+		_, err := s.UpdateField(ctx, UpdateFieldInput{FieldID: op.FieldID, Name: &name})
+		return err
+	case SchemaCreateField:
+		return s.createSchemaField(ctx, projectID, op.Spec)
+	case SchemaAddOption:
+		color := op.Option.Color
+		if color == "" {
+			color = defaultOptionColor
+		}
+		_, err := s.CreateFieldOption(ctx, CreateFieldOptionInput{
+			FieldID:     op.FieldID,
+			Name:        op.Option.Name,
+			Color:       color,
+			Description: op.Option.Description,
+		})
+		return err
+	case SchemaUpdateOption:
+		name := op.Option.Name
+		input := UpdateFieldOptionInput{OptionID: op.OptionID, Name: &name, Description: op.Option.Description}
+		if op.Option.Color != "" {
+			color := op.Option.Color
+			input.Color = &color
+		}
+		_, err := s.UpdateFieldOption(ctx, input)
+		return err
+	case SchemaSetDuration:
+		schedule, err := s.GetIterationSchedule(ctx, op.FieldID)
+		if err != nil {
+			return err
+		}
+		if err := schedule.SetDuration(op.Duration); err != nil {
+			return err
+		}
+		_, err = s.UpdateIterationSchedule(ctx, schedule)
+		return err
+	case SchemaDeleteOption:
+		return s.DeleteFieldOption(ctx, DeleteFieldOptionInput{OptionID: op.OptionID})
+	case SchemaReorderOptions:
+		field, err := s.GetSingleSelectField(ctx, op.FieldID)
+		if err != nil {
+			return err
+		}
+		names := make([]string, len(op.Spec.Options))
+		for i := range op.Spec.Options {
+			names[i] = op.Spec.Options[i].Name
+		}
+		_, err = s.ReorderFieldOptions(ctx, field, names)
+		return err
+	case SchemaDeleteField:
+		return s.DeleteField(ctx, DeleteFieldInput{FieldID: op.FieldID})
+	default:
+		return fmt.Errorf("unknown operation: %s", op.Kind)
+	}
+}
+
+// createSchemaField creates a field with its options, then sets the option colors and descriptions
+func (s *FieldService) createSchemaField(ctx context.Context, projectID string, spec *FieldSpec) error {
+	input := CreateFieldInput{
+		ProjectID: projectID,
+		Name:      spec.Name,
+		DataType:  graphql.ProjectV2FieldDataType(spec.Type),
+	}
+	for i := range spec.Options {
+		input.SingleSelectOptions = append(input.SingleSelectOptions, spec.Options[i].Name)
+	}
+	if spec.Iteration != nil {
+		input.Duration = spec.Iteration.Duration
+		input.StartDate = spec.Iteration.StartDate
+	}
+
+	field, err := s.CreateField(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	for i := range spec.Options {
+		optionSpec := &spec.Options[i]
+		if (optionSpec.Color == "" || optionSpec.Color == defaultOptionColor) && optionSpec.Description == nil {
+			continue
+		}
+
+		var optionID string
+		for _, option := range field.Options.Nodes {
+			if strings.EqualFold(option.Name, optionSpec.Name) {
+				optionID = option.ID
+				break
+			}
+		}
+		if optionID == "" {
+			return fmt.Errorf("created field but option %s was not returned", optionSpec.Name)
+		}
+
+		input := UpdateFieldOptionInput{OptionID: optionID, Description: optionSpec.Description}
+		if optionSpec.Color != "" {
+			color := optionSpec.Color
+			input.Color = &color
+		}
+		if _, err := s.UpdateFieldOption(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isCustomField reports whether a field was created by users and can be deleted.
+// The built-in Status field is a single select field but cannot be deleted.
+func isCustomField(field *FieldInfo) bool {
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber, graphql.ProjectV2FieldDataTypeDate,
+		graphql.ProjectV2FieldDataTypeIteration:
+		return true
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		return !strings.EqualFold(field.Name, "Status")
+	default:
+		return false
+	}
+}
+
+func sortSchemaOperations(operations []SchemaOperation) {
+	order := map[string]int{
+		SchemaRenameField:    0,
+		SchemaCreateField:    1,
+		SchemaAddOption:      2,
+		SchemaUpdateOption:   3,
+		SchemaSetDuration:    4,
+		SchemaDeleteOption:   5,
+		SchemaReorderOptions: 6,
+		SchemaDeleteField:    7,
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		return order[operations[i].Kind] < order[operations[j].Kind]
+	})
+}
+
+func findFieldInfo(fields []FieldInfo, name string) *FieldInfo {
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+func findOptionInfo(options []FieldOptionInfo, name string) *FieldOptionInfo {
+	for i := range options {
+		if strings.EqualFold(options[i].Name, name) {
+			return &options[i]
+		}
+	}
+	return nil
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+const testSchemaYAML = `
+fields:
+  - name: Priority
+    type: single_select
+    options:
+      - name: P0
+        color: red
+        description: Drop everything
+      - name: P1
+        color: Orange
+      - name: P2
+        renamed_from: Later
+      - name: P3
+  - name: Severity
+    type: single-select
+    renamed_from: Sev
+  - name: Estimate
+    type: number
+  - name: Sprint
+    type: iteration
+    iteration:
+      duration: 1w
+`
+
+func testSchemaFields() []FieldInfo {
+	urgent := "Drop everything"
+	return []FieldInfo{
+		{ID: "field-title", Name: "Title", DataType: "TITLE"},
+		{ID: "field-status", Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "status-todo", Name: "Todo", Color: "GRAY"},
+		}},
+		{ID: "field-priority", Name: "Priority", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "opt-p0", Name: "P0", Color: "RED", Description: &urgent},
+			{ID: "opt-p1", Name: "P1", Color: "YELLOW"},
+			{ID: "opt-later", Name: "Later", Color: "GRAY"},
+			{ID: "opt-wontfix", Name: "Won't fix", Color: "GRAY"},
+		}},
+		{ID: "field-sev", Name: "Sev", DataType: graphql.ProjectV2FieldDataTypeSingleSelect},
+		{ID: "field-sprint", Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration},
+		{ID: "field-notes", Name: "Notes", DataType: graphql.ProjectV2FieldDataTypeText},
+	}
+}
+
+func schemaOperationDescriptions(plan *SchemaPlan) []string {
+	descriptions := make([]string, len(plan.Operations))
+	for i := range plan.Operations {
+		descriptions[i] = DescribeSchemaOperation(&plan.Operations[i])
+	}
+	return descriptions
+}
+
+func TestParseFieldSchema(t *testing.T) {
+	t.Run("Parses and normalizes a schema", func(t *testing.T) {
+		schema, err := ParseFieldSchema([]byte(testSchemaYAML))
+		require.NoError(t, err)
+
+		require.Len(t, schema.Fields, 4)
+		assert.Equal(t, "SINGLE_SELECT", schema.Fields[1].Type)
+		assert.Equal(t, "ORANGE", schema.Fields[0].Options[1].Color)
+		assert.Equal(t, "1w", schema.Fields[3].Iteration.Duration)
+	})
+
+	t.Run("Rejects invalid schemas", func(t *testing.T) {
+		tests := map[string]string{
+			"no fields":         `fields: []`,
+			"invalid type":      "fields:\n  - name: A\n    type: list",
+			"duplicate field":   "fields:\n  - name: A\n    type: text\n  - name: a\n    type: number",
+			"options on text":   "fields:\n  - name: A\n    type: text\n    options:\n      - name: X",
+			"invalid color":     "fields:\n  - name: A\n    type: single_select\n    options:\n      - name: X\n        color: teal",
+			"duplicate option":  "fields:\n  - name: A\n    type: single_select\n    options:\n      - name: X\n      - name: x",
+			"invalid duration":  "fields:\n  - name: A\n    type: iteration\n    iteration:\n      duration: 2y",
+			"iteration on date": "fields:\n  - name: A\n    type: date\n    iteration:\n      duration: 2w",
+			"empty name":        "fields:\n  - type: text",
+		}
+		for name, data := range tests {
+			_, err := ParseFieldSchema([]byte(data))
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestPlanFieldSchema(t *testing.T) {
+	schema, err := ParseFieldSchema([]byte(testSchemaYAML))
+	require.NoError(t, err)
+
+	t.Run("Plans changes without pruning", func(t *testing.T) {
+		plan, err := PlanFieldSchema(schema, testSchemaFields(), map[string]int{"field-sprint": 14}, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"rename field Sev → Severity",
+			"create field Estimate (Number)",
+			"add option P3 to Priority (Gray)",
+			"update option P1 in Priority: color Yellow → Orange",
+			"update option P2 in Priority: name Later → P2",
+			"set iteration duration of Sprint: 14 → 7 days",
+			"reorder options of Priority: P0, P1, P2, P3, Won't fix",
+		}, schemaOperationDescriptions(plan))
+		assert.Equal(t, []string{"option Won't fix in Priority", "field Notes (Text)"}, plan.Unmanaged)
+	})
+
+	t.Run("Prunes custom fields and options but keeps built-in fields", func(t *testing.T) {
+		plan, err := PlanFieldSchema(schema, testSchemaFields(), nil, true)
+		require.NoError(t, err)
+
+		descriptions := schemaOperationDescriptions(plan)
+		assert.Contains(t, descriptions, "delete option Won't fix from Priority")
+		assert.Contains(t, descriptions, "delete field Notes (Text)")
+		assert.Equal(t, "delete field Notes (Text)", descriptions[len(descriptions)-1])
+		assert.NotContains(t, descriptions, "delete field Status (Single Select)")
+		assert.Empty(t, plan.Unmanaged)
+	})
+
+	t.Run("Applied schema plans no changes", func(t *testing.T) {
+		fields := []FieldInfo{
+			{ID: "field-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+			{ID: "field-severity", Name: "Severity", DataType: graphql.ProjectV2FieldDataTypeSingleSelect},
+		}
+		small, err := ParseFieldSchema([]byte("fields:\n  - name: Estimate\n    type: number\n  - name: Severity\n    type: single_select\n    renamed_from: Sev"))
+		require.NoError(t, err)
+
+		plan, err := PlanFieldSchema(small, fields, nil, true)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Operations)
+	})
+
+	t.Run("Reorders options only when they are out of order", func(t *testing.T) {
+		sizes, err := ParseFieldSchema([]byte("fields:\n  - name: Size\n    type: single_select\n    options:\n      - name: S\n      - name: M"))
+		require.NoError(t, err)
+
+		field := FieldInfo{ID: "field-size", Name: "Size", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "opt-s", Name: "S"}, {ID: "opt-m", Name: "m"}, {ID: "opt-xl", Name: "XL"},
+		}}
+
+		plan, err := PlanFieldSchema(sizes, []FieldInfo{field}, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"update option M in Size: name m → M"}, schemaOperationDescriptions(plan))
+
+		field.Options[0], field.Options[1] = field.Options[1], field.Options[0]
+		plan, err = PlanFieldSchema(sizes, []FieldInfo{field}, nil, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"update option M in Size: name m → M",
+			"delete option XL from Size",
+			"reorder options of Size: S, M",
+		}, schemaOperationDescriptions(plan))
+	})
+
+	t.Run("Rejects type changes", func(t *testing.T) {
+		fields := []FieldInfo{{ID: "field-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeText}}
+
+		_, err := PlanFieldSchema(schema, fields, nil, false)
+		assert.ErrorContains(t, err, "ghp field convert")
+	})
+
+	t.Run("Creates single select fields with their options", func(t *testing.T) {
+		plan, err := PlanFieldSchema(schema, nil, nil, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, "create field Priority (Single Select): P0, P1, P2, P3", DescribeSchemaOperation(&plan.Operations[0]))
+		assert.Len(t, plan.Operations, 4)
+	})
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// FieldStats summarizes how a field is used across a project's items
+type FieldStats struct {
+	LastUpdated *time.Time
+	ID          string
+	Name        string
+	DataType    graphql.ProjectV2FieldDataType
+	Options     []OptionUsage
+	Items       int
+	Filled      int
+	Distinct    int
+	// Custom is set for fields that can be deleted
+	Custom bool
+}
+
+// OptionUsage counts the items using a single select option
+type OptionUsage struct {
+	LastUpdated *time.Time
+	ID          string
+	Name        string
+	Items       int
+}
+
+// UnusedOption is a single select option that no item uses
+type UnusedOption struct {
+	Field  string
+	Option OptionUsage
+}
+
+// UnusedFieldsReport lists custom fields without values and options without items
+type UnusedFieldsReport struct {
+	Fields  []FieldStats
+	Options []UnusedOption
+}
+
+// FillRate returns the share of items with a value, between 0 and 1
+func (s *FieldStats) FillRate() float64 {
+	if s.Items == 0 {
+		return 0
+	}
+	return float64(s.Filled) / float64(s.Items)
+}
+
+// hasItemValues reports whether items store values of this field type
+func hasItemValues(dataType graphql.ProjectV2FieldDataType) bool {
+	switch dataType {
+	case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber, graphql.ProjectV2FieldDataTypeDate,
+		graphql.ProjectV2FieldDataTypeSingleSelect, graphql.ProjectV2FieldDataTypeIteration:
+		return true
+	default:
+		return false
+	}
+}
+
+// ComputeFieldStats computes the usage of each field holding item values, in the
+// project's field order. Built-in fields such as Assignees or Labels are skipped.
+func ComputeFieldStats(fields []FieldInfo, items []ProjectItemInfo) []FieldStats {
+	var stats []FieldStats
+
+	for i := range fields {
+		field := &fields[i]
+		if !hasItemValues(field.DataType) {
+			continue
+		}
+
+		stat := FieldStats{
+			ID:       field.ID,
+			Name:     field.Name,
+			DataType: field.DataType,
+			Items:    len(items),
+			Custom:   isCustomField(field),
+		}
+
+		options := make(map[string]int, len(field.Options))
+		for j, option := range field.Options {
+			stat.Options = append(stat.Options, OptionUsage{ID: option.ID, Name: option.Name})
+			options[option.Name] = j
+		}
+
+		distinct := make(map[string]bool)
+		for j := range items {
+			item := &items[j]
+			value, ok := item.FieldValues[field.Name]
+			if !ok {
+				continue
+			}
+			stat.Filled++
+			distinct[strings.ToLower(value)] = true
+
+			updatedAt, hasUpdatedAt := item.FieldUpdatedAt[field.Name]
+			if hasUpdatedAt {
+				stat.LastUpdated = latestTime(stat.LastUpdated, updatedAt)
+			}
+
+			if index, isOption := options[value]; isOption {
+				usage := &stat.Options[index]
+				usage.Items++
+				if hasUpdatedAt {
+					usage.LastUpdated = latestTime(usage.LastUpdated, updatedAt)
+				}
+			}
+		}
+		stat.Distinct = len(distinct)
+
+		stats = append(stats, stat)
+	}
+
+	return stats
+}
+
+// FindUnusedFields lists the custom fields no item has a value for and the options
+// of single select fields, including Status, that no item uses
+func FindUnusedFields(stats []FieldStats) *UnusedFieldsReport {
+	report := &UnusedFieldsReport{}
+
+	for i := range stats {
+		stat := &stats[i]
+		if stat.Filled == 0 && stat.Custom {
+			report.Fields = append(report.Fields, *stat)
+			continue
+		}
+
+		for _, option := range stat.Options {
+			if option.Items == 0 {
+				report.Options = append(report.Options, UnusedOption{Field: stat.Name, Option: option})
+			}
+		}
+	}
+
+	return report
+}
+
+func latestTime(current *time.Time, candidate time.Time) *time.Time {
+	if current == nil || candidate.After(*current) {
+		return &candidate
+	}
+	return current
+}
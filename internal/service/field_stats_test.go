@@ -0,0 +1,84 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func TestComputeFieldStats(t *testing.T) {
+	monday := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	friday := time.Date(2025, 3, 7, 17, 0, 0, 0, time.UTC)
+
+	fields := []FieldInfo{
+		{ID: "f-title", Name: "Title", DataType: "TITLE"},
+		{ID: "f-status", Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-todo", Name: "Todo"}, {ID: "o-done", Name: "Done"}, {ID: "o-blocked", Name: "Blocked"},
+		}},
+		{ID: "f-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "f-legacy", Name: "Legacy", DataType: graphql.ProjectV2FieldDataTypeSingleSelect, Options: []FieldOptionInfo{
+			{ID: "o-old", Name: "Old"},
+		}},
+		{ID: "f-notes", Name: "Notes", DataType: graphql.ProjectV2FieldDataTypeText},
+	}
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", FieldValues: map[string]string{"Status": "Todo", "Estimate": "3"},
+			FieldUpdatedAt: map[string]time.Time{"Status": monday, "Estimate": monday}},
+		{ItemID: "item-2", FieldValues: map[string]string{"Status": "Todo", "Estimate": "5"},
+			FieldUpdatedAt: map[string]time.Time{"Status": friday}},
+		{ItemID: "item-3", FieldValues: map[string]string{"Status": "Done", "Notes": "ok"}},
+		{ItemID: "item-4", FieldValues: map[string]string{}},
+	}
+
+	stats := ComputeFieldStats(fields, items)
+
+	t.Run("Skips fields without item values", func(t *testing.T) {
+		require.Len(t, stats, 4)
+		assert.Equal(t, "Status", stats[0].Name)
+	})
+
+	t.Run("Counts filled values and distinct values", func(t *testing.T) {
+		status := stats[0]
+		assert.Equal(t, 4, status.Items)
+		assert.Equal(t, 3, status.Filled)
+		assert.Equal(t, 2, status.Distinct)
+		assert.InDelta(t, 0.75, status.FillRate(), 0.001)
+		assert.False(t, status.Custom)
+
+		estimate := stats[1]
+		assert.Equal(t, 2, estimate.Filled)
+		assert.Equal(t, 2, estimate.Distinct)
+		assert.True(t, estimate.Custom)
+		assert.Equal(t, monday, *estimate.LastUpdated)
+	})
+
+	t.Run("Counts option usage including unused options", func(t *testing.T) {
+		options := stats[0].Options
+		require.Len(t, options, 3)
+		assert.Equal(t, OptionUsage{ID: "o-todo", Name: "Todo", Items: 2, LastUpdated: &friday}, options[0])
+		assert.Equal(t, 1, options[1].Items)
+		assert.Nil(t, options[1].LastUpdated)
+		assert.Equal(t, 0, options[2].Items)
+		assert.Equal(t, friday, *stats[0].LastUpdated)
+	})
+
+	t.Run("Fill rate of a project without items is zero", func(t *testing.T) {
+		empty := ComputeFieldStats(fields, nil)
+		assert.Zero(t, empty[0].FillRate())
+	})
+
+	t.Run("Finds unused custom fields and options", func(t *testing.T) {
+		unused := FindUnusedFields(stats)
+
+		require.Len(t, unused.Fields, 1)
+		assert.Equal(t, "Legacy", unused.Fields[0].Name)
+
+		require.Len(t, unused.Options, 1)
+		assert.Equal(t, "Status", unused.Options[0].Field)
+		assert.Equal(t, "Blocked", unused.Options[0].Option.Name)
+	})
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// OptionSource is a repository label or milestone mirrored by a single select option
+type OptionSource struct {
+	// Source is the label name or milestone title
+	Source      string
+	Name        string
+	Color       string
+	Description string
+	// Closed marks a closed milestone, which keeps a matching option but is not added
+	Closed bool
+}
+
+// OptionSyncPlan describes the option changes needed to mirror a set of sources.
+// Stale lists the options without a source that are kept because pruning is off;
+// Sources lists the sources that have an option once the plan is applied.
+type OptionSyncPlan struct {
+	Edits   *OptionEditPlan
+	Stale   []graphql.ProjectV2SingleSelectFieldOption
+	Sources []OptionSource
+}
+
+// OptionBackfill is a field value set on an item from its labels or milestone
+type OptionBackfill struct {
+	Item    ProjectItemInfo
+	Current string
+	Value   string
+}
+
+// OptionBackfillPlan lists the values to set on items. Conflicts are items whose
+// value differs from their labels or milestone; Ambiguous items carry several
+// matching labels, joined in Value.
+type OptionBackfillPlan struct {
+	Assignments []OptionBackfill
+	Conflicts   []OptionBackfill
+	Ambiguous   []OptionBackfill
+}
+
+// OptionBackfillInput holds the parameters for planning a backfill
+type OptionBackfillInput struct {
+	Field      string
+	Repository string
+	Items      []ProjectItemInfo
+	Sources    []OptionSource
+	// Deleted options are treated as empty values
+	Deleted    []graphql.ProjectV2SingleSelectFieldOption
+	Milestones bool
+	Overwrite  bool
+}
+
+// LabelOptionSources returns the labels starting with prefix (case-insensitive) as
+// option sources named after the rest of the label, with the nearest option color
+func LabelOptionSources(labels []graphql.Label, prefix string) []OptionSource {
+	var sources []OptionSource
+	seen := make(map[string]bool)
+
+	for _, label := range labels {
+		if len(label.Name) < len(prefix) || !strings.EqualFold(label.Name[:len(prefix)], prefix) {
+			continue
+		}
+
+		name := strings.TrimSpace(label.Name[len(prefix):])
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+
+		sources = append(sources, OptionSource{
+			Source:      label.Name,
+			Name:        name,
+			Color:       NearestOptionColor(label.Color),
+			Description: derefString(label.Description),
+		})
+	}
+
+	return sources
+}
+
+// MilestoneOptionSources returns milestones as option sources. Milestones have no
+// color, so the colors of existing options are left alone. Closed milestones only
+// keep their existing options, so items shipped in them keep their values.
+func MilestoneOptionSources(milestones []graphql.Milestone) []OptionSource {
+	var sources []OptionSource
+	seen := make(map[string]bool)
+
+	for _, milestone := range milestones {
+		name := strings.TrimSpace(milestone.Title)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+
+		sources = append(sources, OptionSource{
+			Source:      milestone.Title,
+			Name:        name,
+			Description: derefString(milestone.Description),
+			Closed:      milestone.Closed,
+		})
+	}
+
+	return sources
+}
+
+// NearestOptionColor maps a hex label color such as "d73a4a" to the closest
+// single select option color. Pale, dark and unparsable colors map to gray.
+func NearestOptionColor(hex string) string {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) != 6 {
+		return defaultOptionColor
+	}
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return defaultOptionColor
+	}
+
+	r := float64(rgb>>16&0xff) / 255
+	g := float64(rgb>>8&0xff) / 255
+	b := float64(rgb&0xff) / 255
+
+	high := math.Max(r, math.Max(g, b))
+	low := math.Min(r, math.Min(g, b))
+	delta := high - low
+	lightness := (high + low) / 2
+
+	if delta == 0 || lightness > 0.95 || lightness < 0.1 {
+		return defaultOptionColor
+	}
+	if saturation := delta / (1 - math.Abs(2*lightness-1)); saturation < 0.15 {
+		return defaultOptionColor
+	}
+
+	var hue float64
+	switch high {
+	case r:
+		hue = math.Mod((g-b)/delta+6, 6) * 60
+	case g:
+		hue = ((b-r)/delta + 2) * 60
+	default:
+		hue = ((r-g)/delta + 4) * 60
+	}
+
+	switch {
+	case hue < 15 || hue >= 345:
+		return graphql.SingleSelectColorRed
+	case hue < 40:
+		return graphql.SingleSelectColorOrange
+	case hue < 70:
+		return graphql.SingleSelectColorYellow
+	case hue < 170:
+		return graphql.SingleSelectColorGreen
+	case hue < 245:
+		return graphql.SingleSelectColorBlue
+	case hue < 300:
+		return graphql.SingleSelectColorPurple
+	default:
+		return graphql.SingleSelectColorPink
+	}
+}
+
+// PlanOptionSync compares a field's options with their sources. Missing options are
+// appended unless their source is closed, matching options (case-insensitive) take
+// the source's color and description when it has one, and options without a source
+// are deleted when prune is set. Existing options keep their order.
+func PlanOptionSync(current []graphql.ProjectV2SingleSelectFieldOption, sources []OptionSource, prune bool) (*OptionSyncPlan, error) {
+	bySource := make(map[string]OptionSource, len(sources))
+	for _, source := range sources {
+		bySource[strings.ToLower(source.Name)] = source
+	}
+
+	plan := &OptionSyncPlan{}
+	matched := make(map[string]bool)
+	var entries []OptionEntry
+
+	for _, option := range current {
+		source, ok := bySource[strings.ToLower(option.Name)]
+		if !ok {
+			if !prune {
+				plan.Stale = append(plan.Stale, option)
+				entries = append(entries, OptionEntry{
+					ID:          option.ID,
+					Name:        option.Name,
+					Color:       option.Color,
+					Description: derefString(option.Description),
+				})
+			}
+			continue
+		}
+		matched[strings.ToLower(option.Name)] = true
+		plan.Sources = append(plan.Sources, source)
+
+		entry := OptionEntry{
+			ID:          option.ID,
+			Name:        option.Name,
+			Color:       option.Color,
+			Description: derefString(option.Description),
+		}
+		if source.Color != "" {
+			entry.Color = source.Color
+		}
+		if source.Description != "" {
+			entry.Description = source.Description
+		}
+		entries = append(entries, entry)
+	}
+
+	for _, source := range sources {
+		if matched[strings.ToLower(source.Name)] || source.Closed {
+			continue
+		}
+		plan.Sources = append(plan.Sources, source)
+		color := source.Color
+		if color == "" {
+			color = defaultOptionColor
+		}
+		entries = append(entries, OptionEntry{
+			Name:        source.Name,
+			Color:       color,
+			Description: source.Description,
+		})
+	}
+
+	edits, err := PlanOptionEdits(current, entries)
+	if err != nil {
+		return nil, err
+	}
+	plan.Edits = edits
+
+	return plan, nil
+}
+
+// PlanOptionBackfill finds the option each item of the source repository should
+// have according to its labels or milestone
+func PlanOptionBackfill(input OptionBackfillInput) *OptionBackfillPlan {
+	names := make(map[string]string, len(input.Sources))
+	for _, source := range input.Sources {
+		names[strings.ToLower(source.Source)] = source.Name
+	}
+	deleted := make(map[string]bool, len(input.Deleted))
+	for _, option := range input.Deleted {
+		deleted[strings.ToLower(option.Name)] = true
+	}
+
+	plan := &OptionBackfillPlan{}
+	for i := range input.Items {
+		item := &input.Items[i]
+		if item.Repository == nil || !strings.EqualFold(*item.Repository, input.Repository) {
+			continue
+		}
+
+		var values []string
+		if input.Milestones {
+			if item.Milestone != nil {
+				if name, ok := names[strings.ToLower(*item.Milestone)]; ok {
+					values = append(values, name)
+				}
+			}
+		} else {
+			for _, label := range item.Labels {
+				if name, ok := names[strings.ToLower(label)]; ok {
+					values = append(values, name)
+				}
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		current := fieldValue(item, input.Field)
+		if deleted[strings.ToLower(current)] {
+			current = ""
+		}
+		if containsFold(values, current) {
+			continue
+		}
+
+		backfill := OptionBackfill{Item: *item, Current: current, Value: values[0]}
+		switch {
+		case len(values) > 1:
+			backfill.Value = strings.Join(values, ", ")
+			plan.Ambiguous = append(plan.Ambiguous, backfill)
+		case current == "" || input.Overwrite:
+			plan.Assignments = append(plan.Assignments, backfill)
+		default:
+			plan.Conflicts = append(plan.Conflicts, backfill)
+		}
+	}
+
+	return plan
+}
+
+// ApplyOptionBackfill sets the planned option on each item
+func (s *ItemService) ApplyOptionBackfill(ctx context.Context, projectID string, field *graphql.ProjectV2Field, plan *OptionBackfillPlan) *BulkUpdateResult {
+	result := &BulkUpdateResult{}
+
+	for i := range plan.Assignments {
+		backfill := &plan.Assignments[i]
+
+		value, err := BuildFieldValue(field, backfill.Value)
+		if err == nil {
+			err = s.SetItemFieldValues(ctx, projectID, backfill.Item.ItemID, map[string]interface{}{field.ID: value})
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemLabel(&backfill.Item), err))
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	return result
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func TestNearestOptionColor(t *testing.T) {
+	tests := map[string]string{
+		"d73a4a":  "RED",
+		"#0075ca": "BLUE",
+		"a2eeef":  "BLUE",
+		"7057ff":  "PURPLE",
+		"e4e669":  "YELLOW",
+		"0e8a16":  "GREEN",
+		"f9a03f":  "ORANGE",
+		"e99695":  "RED",
+		"ff69b4":  "PINK",
+		"cfd3d7":  "GRAY",
+		"ffffff":  "GRAY",
+		"000000":  "GRAY",
+		"zzzzzz":  "GRAY",
+		"fff":     "GRAY",
+	}
+
+	for hex, expected := range tests {
+		t.Run(hex, func(t *testing.T) {
+			assert.Equal(t, expected, NearestOptionColor(hex))
+		})
+	}
+}
+
+func TestOptionSources(t *testing.T) {
+	t.Run("Labels are filtered by prefix and named without it", func(t *testing.T) {
+		description := "Web app"
+		labels := []graphql.Label{
+			{Name: "area/frontend", Color: "0075ca", Description: &description},
+			{Name: "Area/Backend", Color: "0e8a16"},
+			{Name: "bug", Color: "d73a4a"},
+			{Name: "area/", Color: "d73a4a"},
+		}
+
+		sources := LabelOptionSources(labels, "area/")
+
+		require.Len(t, sources, 2)
+		assert.Equal(t, OptionSource{Source: "area/frontend", Name: "frontend", Color: "BLUE", Description: "Web app"}, sources[0])
+		assert.Equal(t, "Backend", sources[1].Name)
+	})
+
+	t.Run("Milestones have no color", func(t *testing.T) {
+		sources := MilestoneOptionSources([]graphql.Milestone{{Title: "v1.0"}, {Title: "v1.1"}})
+
+		require.Len(t, sources, 2)
+		assert.Equal(t, OptionSource{Source: "v1.0", Name: "v1.0"}, sources[0])
+	})
+}
+
+func TestPlanOptionSync(t *testing.T) {
+	current := []graphql.ProjectV2SingleSelectFieldOption{
+		{ID: "opt-frontend", Name: "Frontend", Color: "GRAY"},
+		{ID: "opt-legacy", Name: "Legacy", Color: "GRAY"},
+	}
+	sources := []OptionSource{
+		{Source: "area/frontend", Name: "frontend", Color: "BLUE"},
+		{Source: "area/backend", Name: "backend", Color: "GREEN"},
+	}
+
+	t.Run("Adds missing options and recolors matching ones", func(t *testing.T) {
+		plan, err := PlanOptionSync(current, sources, false)
+		require.NoError(t, err)
+
+		require.Len(t, plan.Edits.Creates, 1)
+		assert.Equal(t, "backend", plan.Edits.Creates[0].Name)
+		assert.Equal(t, "GREEN", plan.Edits.Creates[0].Color)
+		require.Len(t, plan.Edits.Updates, 1)
+		assert.Equal(t, "Frontend", plan.Edits.Updates[0].To.Name)
+		assert.Equal(t, "BLUE", plan.Edits.Updates[0].To.Color)
+		assert.Empty(t, plan.Edits.Deletes)
+		assert.False(t, plan.Edits.Reorder)
+		require.Len(t, plan.Stale, 1)
+		assert.Equal(t, "Legacy", plan.Stale[0].Name)
+	})
+
+	t.Run("Prune deletes options without a source", func(t *testing.T) {
+		plan, err := PlanOptionSync(current, sources, true)
+		require.NoError(t, err)
+
+		assert.Empty(t, plan.Stale)
+		require.Len(t, plan.Edits.Deletes, 1)
+		assert.Equal(t, "opt-legacy", plan.Edits.Deletes[0].ID)
+	})
+
+	t.Run("Synced options make no changes", func(t *testing.T) {
+		synced := []graphql.ProjectV2SingleSelectFieldOption{
+			{ID: "opt-frontend", Name: "frontend", Color: "BLUE"},
+			{ID: "opt-backend", Name: "backend", Color: "GREEN"},
+		}
+
+		plan, err := PlanOptionSync(synced, sources, true)
+		require.NoError(t, err)
+		assert.True(t, plan.Edits.IsEmpty())
+	})
+
+	t.Run("Milestone sources keep option colors", func(t *testing.T) {
+		options := []graphql.ProjectV2SingleSelectFieldOption{{ID: "opt-1", Name: "v1.0", Color: "PURPLE"}}
+
+		plan, err := PlanOptionSync(options, MilestoneOptionSources([]graphql.Milestone{{Title: "v1.0"}, {Title: "v1.1"}}), false)
+		require.NoError(t, err)
+
+		assert.Empty(t, plan.Edits.Updates)
+		require.Len(t, plan.Edits.Creates, 1)
+		assert.Equal(t, "GRAY", plan.Edits.Creates[0].Color)
+	})
+
+	t.Run("Closed milestones keep their options but are not added", func(t *testing.T) {
+		options := []graphql.ProjectV2SingleSelectFieldOption{{ID: "opt-1", Name: "v1.0", Color: "PURPLE"}}
+		milestones := []graphql.Milestone{
+			{Title: "v1.0", Closed: true},
+			{Title: "v0.9", Closed: true},
+			{Title: "v1.1"},
+		}
+
+		plan, err := PlanOptionSync(options, MilestoneOptionSources(milestones), true)
+		require.NoError(t, err)
+
+		assert.Empty(t, plan.Edits.Deletes)
+		require.Len(t, plan.Edits.Creates, 1)
+		assert.Equal(t, "v1.1", plan.Edits.Creates[0].Name)
+
+		names := make([]string, len(plan.Sources))
+		for i, source := range plan.Sources {
+			names[i] = source.Name
+		}
+		assert.Equal(t, []string{"v1.0", "v1.1"}, names)
+	})
+}
+
+func TestPlanOptionBackfill(t *testing.T) {
+	repo, other := "octocat/app", "octocat/other"
+	milestone := "v1.0"
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", Repository: &repo, Labels: []string{"bug", "area/frontend"}, FieldValues: map[string]string{}},
+		{ItemID: "item-2", Repository: &repo, Labels: []string{"area/backend"}, FieldValues: map[string]string{"Area": "frontend"}},
+		{ItemID: "item-3", Repository: &repo, Labels: []string{"area/frontend", "area/backend"}, FieldValues: map[string]string{}},
+		{ItemID: "item-4", Repository: &repo, Labels: []string{"area/Frontend"}, FieldValues: map[string]string{"Area": "Frontend"}},
+		{ItemID: "item-5", Repository: &other, Labels: []string{"area/frontend"}, FieldValues: map[string]string{}},
+		{ItemID: "item-6", Repository: &repo, Labels: []string{"area/backend"}, FieldValues: map[string]string{"Area": "Legacy"}, Milestone: &milestone},
+		{ItemID: "item-7", Title: "Draft", FieldValues: map[string]string{}},
+	}
+	sources := []OptionSource{
+		{Source: "area/frontend", Name: "frontend"},
+		{Source: "area/backend", Name: "backend"},
+	}
+
+	t.Run("Fills empty values from labels of the source repository", func(t *testing.T) {
+		plan := PlanOptionBackfill(OptionBackfillInput{Field: "Area", Repository: "Octocat/App", Items: items, Sources: sources})
+
+		require.Len(t, plan.Assignments, 1)
+		assert.Equal(t, "item-1", plan.Assignments[0].Item.ItemID)
+		assert.Equal(t, "frontend", plan.Assignments[0].Value)
+
+		require.Len(t, plan.Conflicts, 2)
+		assert.Equal(t, "item-2", plan.Conflicts[0].Item.ItemID)
+		assert.Equal(t, "frontend", plan.Conflicts[0].Current)
+		assert.Equal(t, "backend", plan.Conflicts[0].Value)
+
+		require.Len(t, plan.Ambiguous, 1)
+		assert.Equal(t, "frontend, backend", plan.Ambiguous[0].Value)
+	})
+
+	t.Run("Overwrite replaces conflicting values", func(t *testing.T) {
+		plan := PlanOptionBackfill(OptionBackfillInput{Field: "Area", Repository: repo, Items: items, Sources: sources, Overwrite: true})
+
+		assert.Len(t, plan.Assignments, 3)
+		assert.Empty(t, plan.Conflicts)
+	})
+
+	t.Run("Values of deleted options count as empty", func(t *testing.T) {
+		plan := PlanOptionBackfill(OptionBackfillInput{
+			Field:      "Area",
+			Repository: repo,
+			Items:      items,
+			Sources:    sources,
+			Deleted:    []graphql.ProjectV2SingleSelectFieldOption{{ID: "opt-legacy", Name: "Legacy"}},
+		})
+
+		require.Len(t, plan.Assignments, 2)
+		assert.Equal(t, "item-6", plan.Assignments[1].Item.ItemID)
+		assert.Len(t, plan.Conflicts, 1)
+	})
+
+	t.Run("Milestones match the item's milestone", func(t *testing.T) {
+		plan := PlanOptionBackfill(OptionBackfillInput{
+			Field:      "Release",
+			Repository: repo,
+			Items:      items,
+			Sources:    []OptionSource{{Source: "v1.0", Name: "v1.0"}},
+			Milestones: true,
+		})
+
+		require.Len(t, plan.Assignments, 1)
+		assert.Equal(t, "item-6", plan.Assignments[0].Item.ItemID)
+	})
+}
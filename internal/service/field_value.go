@@ -0,0 +1,120 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+const (
+	// fieldAssignmentParts is the number of parts in a Name=Value assignment
+	fieldAssignmentParts = 2
+
+	// dateLayout is the layout used for date field values
+	dateLayout = "2006-01-02"
+)
+
+// ParseFieldAssignments parses "Name=Value" assignments into a map keyed by field name
+func ParseFieldAssignments(assignments []string) (map[string]string, error) {
+	values := make(map[string]string, len(assignments))
+
+	for _, assignment := range assignments {
+		parts := strings.SplitN(assignment, "=", fieldAssignmentParts)
+		if len(parts) != fieldAssignmentParts || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid field assignment: %s (expected Name=Value)", assignment)
+		}
+
+		values[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return values, nil
+}
+
+// FormatFieldAssignments formats field values keyed by field name as sorted "Name=Value" assignments
+func FormatFieldAssignments(values map[string]string) []string {
+	assignments := make([]string, 0, len(values))
+	for name, value := range values {
+		assignments = append(assignments, name+"="+value)
+	}
+	sort.Strings(assignments)
+	return assignments
+}
+
+// FindProjectField finds a project field by name (case-insensitive)
+func FindProjectField(fields []graphql.ProjectV2Field, name string) (*graphql.ProjectV2Field, error) {
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			return &fields[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("field '%s' not found in project", name)
+}
+
+// BuildFieldValue converts a raw string value into the ProjectV2FieldValue input for a field
+func BuildFieldValue(field *graphql.ProjectV2Field, raw string) (map[string]interface{}, error) {
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeText:
+		return map[string]interface{}{"text": raw}, nil
+	case graphql.ProjectV2FieldDataTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number for field '%s': %s", field.Name, raw)
+		}
+		return map[string]interface{}{"number": number}, nil
+	case graphql.ProjectV2FieldDataTypeDate:
+		if _, err := time.Parse(dateLayout, raw); err != nil {
+			return nil, fmt.Errorf("invalid date for field '%s': %s (expected YYYY-MM-DD)", field.Name, raw)
+		}
+		return map[string]interface{}{"date": raw}, nil
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		for _, option := range field.Options.Nodes {
+			if strings.EqualFold(option.Name, raw) {
+				return map[string]interface{}{"singleSelectOptionId": option.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("option '%s' not found in field '%s'", raw, field.Name)
+	case graphql.ProjectV2FieldDataTypeIteration:
+		iterations := field.IterationField.Configuration.Iterations
+		if len(iterations) == 0 {
+			return nil, fmt.Errorf("field '%s' has no iterations", field.Name)
+		}
+		for _, iteration := range iterations {
+			if iteration.ID == raw || strings.EqualFold(iteration.Title, raw) {
+				return map[string]interface{}{"iterationId": iteration.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("iteration '%s' not found in field '%s'", raw, field.Name)
+	default:
+		return nil, fmt.Errorf("unsupported field type for '%s': %s", field.Name, field.DataType)
+	}
+}
+
+// ResolveFieldValues resolves "Name=Value" assignments against project fields into values keyed by field ID
+func ResolveFieldValues(fields []graphql.ProjectV2Field, assignments []string) (map[string]interface{}, error) {
+	parsed, err := ParseFieldAssignments(assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(parsed))
+	for name, raw := range parsed {
+		field, findErr := FindProjectField(fields, name)
+		if findErr != nil {
+			return nil, findErr
+		}
+
+		value, buildErr := BuildFieldValue(field, raw)
+		if buildErr != nil {
+			return nil, buildErr
+		}
+
+		values[field.ID] = value
+	}
+
+	return values, nil
+}
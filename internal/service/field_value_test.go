@@ -0,0 +1,133 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func testProjectFields() []graphql.ProjectV2Field {
+	status := graphql.ProjectV2Field{
+		ID:       "field-status",
+		Name:     "Status",
+		DataType: graphql.ProjectV2FieldDataTypeSingleSelect,
+	}
+	status.Options.Nodes = []graphql.ProjectV2SingleSelectFieldOption{
+		{ID: "opt-todo", Name: "Todo"},
+		{ID: "opt-done", Name: "Done"},
+	}
+
+	return []graphql.ProjectV2Field{
+		status,
+		{ID: "field-estimate", Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber},
+		{ID: "field-due", Name: "Due", DataType: graphql.ProjectV2FieldDataTypeDate},
+		{ID: "field-notes", Name: "Notes", DataType: graphql.ProjectV2FieldDataTypeText},
+	}
+}
+
+func TestParseFieldAssignments(t *testing.T) {
+	t.Run("Parse valid assignments", func(t *testing.T) {
+		values, err := ParseFieldAssignments([]string{"Status=In Progress", "Estimate = 3"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "In Progress", values["Status"])
+		assert.Equal(t, "3", values["Estimate"])
+	})
+
+	t.Run("Value may contain equals sign", func(t *testing.T) {
+		values, err := ParseFieldAssignments([]string{"Notes=a=b"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "a=b", values["Notes"])
+	})
+
+	t.Run("Missing equals sign returns error", func(t *testing.T) {
+		_, err := ParseFieldAssignments([]string{"Status"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expected Name=Value")
+	})
+}
+
+func TestBuildFieldValue(t *testing.T) {
+	fields := testProjectFields()
+
+	t.Run("Single select resolves option ID", func(t *testing.T) {
+		value, err := BuildFieldValue(&fields[0], "done")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "opt-done", value["singleSelectOptionId"])
+	})
+
+	t.Run("Unknown option returns error", func(t *testing.T) {
+		_, err := BuildFieldValue(&fields[0], "Blocked")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "option 'Blocked' not found")
+	})
+
+	t.Run("Number is parsed", func(t *testing.T) {
+		value, err := BuildFieldValue(&fields[1], "5")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5.0, value["number"])
+	})
+
+	t.Run("Invalid date returns error", func(t *testing.T) {
+		_, err := BuildFieldValue(&fields[2], "31/12/2024")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid date")
+	})
+
+	t.Run("Iteration resolves by title", func(t *testing.T) {
+		field := graphql.ProjectV2Field{Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration}
+		field.IterationField.Configuration.Iterations = []graphql.ProjectV2IterationInfo{
+			{ID: "iter-1", Title: "Sprint 1"},
+			{ID: "iter-2", Title: "Sprint 2"},
+		}
+
+		value, err := BuildFieldValue(&field, "sprint 2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "iter-2", value["iterationId"])
+
+		_, err = BuildFieldValue(&field, "Sprint 9")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "iteration 'Sprint 9' not found")
+	})
+
+	t.Run("Iteration without loaded iterations returns error", func(t *testing.T) {
+		field := graphql.ProjectV2Field{Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration}
+
+		_, err := BuildFieldValue(&field, "iter-1")
+
+		assert.ErrorContains(t, err, "field 'Sprint' has no iterations")
+	})
+
+	t.Run("Text is passed through", func(t *testing.T) {
+		value, err := BuildFieldValue(&fields[3], "hello")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", value["text"])
+	})
+}
+
+func TestResolveFieldValues(t *testing.T) {
+	t.Run("Resolve values keyed by field ID", func(t *testing.T) {
+		values, err := ResolveFieldValues(testProjectFields(), []string{"status=Todo", "Due=2024-12-31"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "opt-todo"}, values["field-status"])
+		assert.Equal(t, map[string]interface{}{"date": "2024-12-31"}, values["field-due"])
+	})
+
+	t.Run("Unknown field returns error", func(t *testing.T) {
+		_, err := ResolveFieldValues(testProjectFields(), []string{"Priority=High"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'Priority' not found")
+	})
+}
@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// IssueService handles repository-level issue and pull request operations
+type IssueService struct {
+	client       *api.Client
+	repositories map[string]*graphql.RepositoryDetails
+	labels       map[string][]graphql.Label
+	milestones   map[string][]graphql.Milestone
+	users        map[string]string
+}
+
+// NewIssueService creates a new issue service
+func NewIssueService(client *api.Client) *IssueService {
+	return &IssueService{
+		client:       client,
+		repositories: make(map[string]*graphql.RepositoryDetails),
+		labels:       make(map[string][]graphql.Label),
+		milestones:   make(map[string][]graphql.Milestone),
+		users:        make(map[string]string),
+	}
+}
+
+// GetRepository retrieves a repository
+func (s *IssueService) GetRepository(ctx context.Context, owner, repo string) (*graphql.RepositoryDetails, error) {
+	variables := graphql.BuildGetRepositoryVariables(owner, repo)
+
+	var query graphql.GetRepositoryQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	if query.Repository.ID == "" {
+		return nil, fmt.Errorf("repository %s/%s not found", owner, repo)
+	}
+
+	return &query.Repository, nil
+}
+
+// GetRepositoryByName retrieves a repository given as owner/repo
+func (s *IssueService) GetRepositoryByName(ctx context.Context, nameWithOwner string) (*graphql.RepositoryDetails, error) {
+	owner, repo, err := splitRepositoryName(nameWithOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRepository(ctx, owner, repo)
+}
+
+// ListRepositoryLabels lists all labels of a repository given as owner/repo, following pagination
+func (s *IssueService) ListRepositoryLabels(ctx context.Context, nameWithOwner string) ([]graphql.Label, error) {
+	owner, repo, err := splitRepositoryName(nameWithOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var labels []graphql.Label
+	var after *string
+
+	for {
+		var query graphql.ListRepositoryLabelsQuery
+		err := s.client.Query(ctx, &query, graphql.BuildListRepositoryMetadataVariables(owner, repo, repositoryMetadataPageSize, after))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repository labels: %w", err)
+		}
+
+		page := query.Repository.Labels
+		labels = append(labels, page.Nodes...)
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return labels, nil
+}
+
+// ListRepositoryMilestones lists the open and closed milestones of a repository given
+// as owner/repo, following pagination
+func (s *IssueService) ListRepositoryMilestones(ctx context.Context, nameWithOwner string) ([]graphql.Milestone, error) {
+	owner, repo, err := splitRepositoryName(nameWithOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	var milestones []graphql.Milestone
+	var after *string
+
+	for {
+		var query graphql.ListRepositoryMilestonesQuery
+		err := s.client.Query(ctx, &query, graphql.BuildListRepositoryMetadataVariables(owner, repo, repositoryMetadataPageSize, after))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repository milestones: %w", err)
+		}
+
+		page := query.Repository.Milestones
+		milestones = append(milestones, page.Nodes...)
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return milestones, nil
+}
+
+// splitRepositoryName splits a repository given as owner/repo
+func splitRepositoryName(nameWithOwner string) (owner, repo string, err error) {
+	parts := strings.Split(nameWithOwner, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid repository format: %s (expected owner/repo)", nameWithOwner)
+	}
+	return parts[0], parts[1], nil
+}
+
+// GetUserID retrieves the node ID of a user by login
+func (s *IssueService) GetUserID(ctx context.Context, login string) (string, error) {
+	variables := graphql.BuildGetUserVariables(strings.TrimPrefix(login, "@"))
+
+	var query graphql.GetUserQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user '%s': %w", login, err)
+	}
+
+	if query.User.ID == "" {
+		return "", fmt.Errorf("user '%s' not found", login)
+	}
+
+	return query.User.ID, nil
+}
+
+// ResolveUserIDs retrieves the node IDs of several users by login
+func (s *IssueService) ResolveUserIDs(ctx context.Context, logins []string) ([]string, error) {
+	ids := make([]string, 0, len(logins))
+	for _, login := range logins {
+		id, err := s.GetUserID(ctx, login)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// AddLabels adds labels to an issue or pull request
+func (s *IssueService) AddLabels(ctx context.Context, labelableID string, labelIDs []string) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+
+	variables := graphql.BuildLabelsVariables(graphql.LabelsInput{
+		LabelableID: labelableID,
+		LabelIDs:    labelIDs,
+	})
+
+	var mutation graphql.AddLabelsMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to add labels: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveLabels removes labels from an issue or pull request
+func (s *IssueService) RemoveLabels(ctx context.Context, labelableID string, labelIDs []string) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+
+	variables := graphql.BuildLabelsVariables(graphql.LabelsInput{
+		LabelableID: labelableID,
+		LabelIDs:    labelIDs,
+	})
+
+	var mutation graphql.RemoveLabelsMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to remove labels: %w", err)
+	}
+
+	return nil
+}
+
+// AddAssignees adds assignees to an issue or pull request
+func (s *IssueService) AddAssignees(ctx context.Context, assignableID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	variables := graphql.BuildAssigneesVariables(graphql.AssigneesInput{
+		AssignableID: assignableID,
+		AssigneeIDs:  userIDs,
+	})
+
+	var mutation graphql.AddAssigneesMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to add assignees: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAssignees removes assignees from an issue or pull request
+func (s *IssueService) RemoveAssignees(ctx context.Context, assignableID string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	variables := graphql.BuildAssigneesVariables(graphql.AssigneesInput{
+		AssignableID: assignableID,
+		AssigneeIDs:  userIDs,
+	})
+
+	var mutation graphql.RemoveAssigneesMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to remove assignees: %w", err)
+	}
+
+	return nil
+}
+
+// SetMilestone sets the milestone of an issue or pull request. A nil milestoneID clears it.
+func (s *IssueService) SetMilestone(ctx context.Context, contentID, contentType string, milestoneID *string) error {
+	input := graphql.MilestoneInput{
+		ContentID:   contentID,
+		MilestoneID: milestoneID,
+	}
+
+	var err error
+	switch contentType {
+	case "Issue":
+		var mutation graphql.UpdateIssueMilestoneMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildUpdateIssueMilestoneVariables(input))
+	case "PullRequest":
+		var mutation graphql.UpdatePullRequestMilestoneMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildUpdatePullRequestMilestoneVariables(input))
+	default:
+		return fmt.Errorf("cannot set milestone on %s", contentType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to set milestone: %w", err)
+	}
+
+	return nil
+}
+
+// CloseItem closes an issue or pull request. The reason only applies to issues.
+func (s *IssueService) CloseItem(ctx context.Context, contentID, contentType, reason string) error {
+	var err error
+	switch contentType {
+	case "Issue":
+		var mutation graphql.CloseIssueMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildCloseIssueVariables(graphql.CloseIssueInput{
+			IssueID:     contentID,
+			StateReason: reason,
+		}))
+	case "PullRequest":
+		var mutation graphql.ClosePullRequestMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildPullRequestStateVariables(contentID))
+	default:
+		return fmt.Errorf("cannot close %s", contentType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to close item: %w", err)
+	}
+
+	return nil
+}
+
+// ReopenItem reopens a closed issue or pull request
+func (s *IssueService) ReopenItem(ctx context.Context, contentID, contentType string) error {
+	var err error
+	switch contentType {
+	case "Issue":
+		var mutation graphql.ReopenIssueMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildReopenIssueVariables(contentID))
+	case "PullRequest":
+		var mutation graphql.ReopenPullRequestMutation
+		err = s.client.Mutate(ctx, &mutation, graphql.BuildPullRequestStateVariables(contentID))
+	default:
+		return fmt.Errorf("cannot reopen %s", contentType)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to reopen item: %w", err)
+	}
+
+	return nil
+}
+
+// AddComment comments on an issue or pull request
+func (s *IssueService) AddComment(ctx context.Context, subjectID, body string) error {
+	variables := graphql.BuildAddCommentVariables(graphql.AddCommentInput{
+		SubjectID: subjectID,
+		Body:      body,
+	})
+
+	var mutation graphql.AddCommentMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveRepositoryLabelIDs maps label names to IDs using every label of a repository given as owner/repo
+func (s *IssueService) ResolveRepositoryLabelIDs(ctx context.Context, nameWithOwner string, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	labels, ok := s.labels[nameWithOwner]
+	if !ok {
+		var err error
+		labels, err = s.ListRepositoryLabels(ctx, nameWithOwner)
+		if err != nil {
+			return nil, err
+		}
+		s.labels[nameWithOwner] = labels
+	}
+
+	return ResolveLabelIDs(labels, nameWithOwner, names)
+}
+
+// ResolveRepositoryMilestoneID maps a milestone title or number to its ID using the open and
+// closed milestones of a repository given as owner/repo
+func (s *IssueService) ResolveRepositoryMilestoneID(ctx context.Context, nameWithOwner, milestone string) (string, error) {
+	milestones, ok := s.milestones[nameWithOwner]
+	if !ok {
+		var err error
+		milestones, err = s.ListRepositoryMilestones(ctx, nameWithOwner)
+		if err != nil {
+			return "", err
+		}
+		s.milestones[nameWithOwner] = milestones
+	}
+
+	return ResolveMilestoneID(milestones, nameWithOwner, milestone)
+}
+
+// ResolveLabelIDs maps label names to IDs using a repository's labels (case-insensitive)
+func ResolveLabelIDs(labels []graphql.Label, nameWithOwner string, names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+
+	for _, name := range names {
+		found := false
+		for _, label := range labels {
+			if strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("label '%s' not found in %s", name, nameWithOwner)
+		}
+	}
+
+	return ids, nil
+}
+
+// ResolveMilestoneID maps a milestone title or number to its ID using a repository's milestones
+func ResolveMilestoneID(milestones []graphql.Milestone, nameWithOwner, milestone string) (string, error) {
+	number, numErr := strconv.Atoi(strings.TrimPrefix(milestone, "#"))
+
+	for _, node := range milestones {
+		if strings.EqualFold(node.Title, milestone) || (numErr == nil && node.Number == number) {
+			return node.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("milestone '%s' not found in %s", milestone, nameWithOwner)
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// frontMatterDelimiter separates the front-matter of an issue template from its body
+const frontMatterDelimiter = "---"
+
+// IssueDraft describes a new repository issue and the project field values for its item
+type IssueDraft struct {
+	Repository string            `yaml:"repo"`
+	Title      string            `yaml:"title"`
+	Labels     []string          `yaml:"labels"`
+	Assignees  []string          `yaml:"assignees"`
+	Fields     map[string]string `yaml:"fields"`
+	Body       string            `yaml:"-"`
+}
+
+// CreateIssue creates an issue in the draft's repository, resolving label names and assignee logins
+func (s *IssueService) CreateIssue(ctx context.Context, draft *IssueDraft) (*graphql.CreatedIssue, error) {
+	repository, err := s.cachedRepository(ctx, draft.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs, err := s.ResolveRepositoryLabelIDs(ctx, draft.Repository, draft.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	assigneeIDs, err := s.cachedUserIDs(ctx, draft.Assignees)
+	if err != nil {
+		return nil, err
+	}
+
+	input := graphql.CreateIssueInput{
+		RepositoryID: repository.ID,
+		Title:        draft.Title,
+		LabelIDs:     labelIDs,
+		AssigneeIDs:  assigneeIDs,
+	}
+	if draft.Body != "" {
+		input.Body = &draft.Body
+	}
+
+	var mutation graphql.CreateIssueMutation
+	err = s.client.Mutate(ctx, &mutation, graphql.BuildCreateIssueVariables(input))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+
+	return &mutation.CreateIssue.Issue, nil
+}
+
+// RenderIssueTemplate renders a draft as a Markdown document with YAML front-matter
+// for editing. The project's editable fields and their options are listed as comments.
+func RenderIssueTemplate(draft *IssueDraft, fields []graphql.ProjectV2Field) (string, error) {
+	frontMatter := *draft
+	if frontMatter.Fields == nil {
+		frontMatter.Fields = map[string]string{}
+	}
+	if frontMatter.Labels == nil {
+		frontMatter.Labels = []string{}
+	}
+	if frontMatter.Assignees == nil {
+		frontMatter.Assignees = []string{}
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&frontMatter); err != nil {
+		return "", fmt.Errorf("failed to render issue template: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString(frontMatterDelimiter + "\n")
+	b.WriteString(buf.String())
+
+	if comments := fieldComments(fields); len(comments) > 0 {
+		b.WriteString("# Project fields:\n")
+		for _, comment := range comments {
+			b.WriteString("#   " + comment + "\n")
+		}
+	}
+
+	b.WriteString(frontMatterDelimiter + "\n")
+	b.WriteString(draft.Body)
+	if draft.Body != "" && !strings.HasSuffix(draft.Body, "\n") {
+		b.WriteString("\n")
+	}
+
+	return b.String(), nil
+}
+
+// ParseIssueTemplate parses a Markdown document with optional YAML front-matter into a draft.
+// Without front-matter the whole document is the body.
+func ParseIssueTemplate(text string) (*IssueDraft, error) {
+	draft := &IssueDraft{}
+
+	normalized := strings.ReplaceAll(text, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, frontMatterDelimiter+"\n") {
+		draft.Body = strings.TrimSpace(normalized)
+		return draft, nil
+	}
+
+	rest := strings.TrimPrefix(normalized, frontMatterDelimiter+"\n")
+	var frontMatter, body string
+	switch {
+	case strings.HasPrefix(rest, frontMatterDelimiter+"\n"):
+		body = strings.TrimPrefix(rest, frontMatterDelimiter+"\n")
+	default:
+		var found bool
+		frontMatter, body, found = strings.Cut(rest, "\n"+frontMatterDelimiter+"\n")
+		if !found {
+			frontMatter, found = strings.CutSuffix(rest, "\n"+frontMatterDelimiter)
+			if !found {
+				return nil, fmt.Errorf("front-matter is not closed with %s", frontMatterDelimiter)
+			}
+		}
+	}
+
+	if err := yaml.Unmarshal([]byte(frontMatter), draft); err != nil {
+		return nil, fmt.Errorf("invalid front-matter: %w", err)
+	}
+
+	draft.Title = strings.TrimSpace(draft.Title)
+	draft.Body = strings.TrimSpace(body)
+	return draft, nil
+}
+
+// fieldComments describes the project fields that can be set in the issue template
+func fieldComments(fields []graphql.ProjectV2Field) []string {
+	var comments []string
+	for i := range fields {
+		field := &fields[i]
+		switch field.DataType {
+		case graphql.ProjectV2FieldDataTypeSingleSelect:
+			options := make([]string, len(field.Options.Nodes))
+			for j, option := range field.Options.Nodes {
+				options[j] = option.Name
+			}
+			comments = append(comments, fmt.Sprintf("%s: %s", field.Name, strings.Join(options, ", ")))
+		case graphql.ProjectV2FieldDataTypeIteration:
+			comments = append(comments, fmt.Sprintf("%s (iteration title)", field.Name))
+		case graphql.ProjectV2FieldDataTypeDate:
+			comments = append(comments, fmt.Sprintf("%s (YYYY-MM-DD)", field.Name))
+		case graphql.ProjectV2FieldDataTypeText, graphql.ProjectV2FieldDataTypeNumber:
+			comments = append(comments, fmt.Sprintf("%s (%s)", field.Name, strings.ToLower(string(field.DataType))))
+		}
+	}
+	return comments
+}
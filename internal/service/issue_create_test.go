@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderIssueTemplate(t *testing.T) {
+	t.Run("Renders front-matter, field hints and body", func(t *testing.T) {
+		draft := &IssueDraft{
+			Repository: "octocat/api",
+			Title:      "Fix login bug",
+			Body:       "Steps to reproduce",
+			Labels:     []string{"bug"},
+			Fields:     map[string]string{"Status": "Todo"},
+		}
+
+		text, err := RenderIssueTemplate(draft, testProjectFields())
+
+		assert.NoError(t, err)
+		assert.Equal(t, `---
+repo: octocat/api
+title: Fix login bug
+labels:
+  - bug
+assignees: []
+fields:
+  Status: Todo
+# Project fields:
+#   Status: Todo, Done
+#   Estimate (number)
+#   Due (YYYY-MM-DD)
+#   Notes (text)
+---
+Steps to reproduce
+`, text)
+	})
+}
+
+func TestParseIssueTemplate(t *testing.T) {
+	t.Run("Round-trips a rendered template", func(t *testing.T) {
+		draft := &IssueDraft{
+			Repository: "octocat/api",
+			Title:      "Fix login bug",
+			Body:       "Steps to reproduce\n\n---\n\nMore details",
+			Labels:     []string{"bug", "p1"},
+			Assignees:  []string{"octocat"},
+			Fields:     map[string]string{"Status": "Todo", "Estimate": "3"},
+		}
+		text, err := RenderIssueTemplate(draft, testProjectFields())
+		assert.NoError(t, err)
+
+		parsed, err := ParseIssueTemplate(text)
+
+		assert.NoError(t, err)
+		assert.Equal(t, draft, parsed)
+	})
+
+	t.Run("Text without front-matter is the body", func(t *testing.T) {
+		parsed, err := ParseIssueTemplate("Just a body\n")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Just a body", parsed.Body)
+		assert.Empty(t, parsed.Title)
+	})
+
+	t.Run("Front-matter without body", func(t *testing.T) {
+		parsed, err := ParseIssueTemplate("---\r\ntitle: '  Spaced  '\r\n---")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Spaced", parsed.Title)
+		assert.Empty(t, parsed.Body)
+	})
+
+	t.Run("Unclosed front-matter returns error", func(t *testing.T) {
+		_, err := ParseIssueTemplate("---\ntitle: Fix\n")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not closed")
+	})
+
+	t.Run("Invalid YAML returns error", func(t *testing.T) {
+		_, err := ParseIssueTemplate("---\nlabels: [bug\n---\n")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid front-matter")
+	})
+}
+
+func TestFormatFieldAssignments(t *testing.T) {
+	t.Run("Assignments are sorted", func(t *testing.T) {
+		assignments := FormatFieldAssignments(map[string]string{"Status": "Todo", "Estimate": "3"})
+
+		assert.Equal(t, []string{"Estimate=3", "Status=Todo"}, assignments)
+	})
+}
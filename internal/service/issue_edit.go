@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// Issue state values used by IssueEdit
+const (
+	IssueStateOpen   = "OPEN"
+	IssueStateClosed = "CLOSED"
+)
+
+// IssueTarget identifies an issue or pull request to edit
+type IssueTarget struct {
+	ContentID  string
+	Type       string
+	Repository string
+	Reference  string
+}
+
+// IssueEdit describes repository-level changes to apply to issues and pull requests.
+// A non-nil Milestone sets the milestone by title or number; an empty string clears it.
+type IssueEdit struct {
+	Milestone       *string
+	State           string
+	StateReason     string
+	AddLabels       []string
+	RemoveLabels    []string
+	AddAssignees    []string
+	RemoveAssignees []string
+}
+
+// IssueEditResult represents the result of applying an edit to several items
+type IssueEditResult struct {
+	Errors    []string
+	Processed int
+	Failed    int
+}
+
+// IsEmpty reports whether the edit would change nothing
+func (e *IssueEdit) IsEmpty() bool {
+	return e.Milestone == nil && e.State == "" &&
+		len(e.AddLabels) == 0 && len(e.RemoveLabels) == 0 &&
+		len(e.AddAssignees) == 0 && len(e.RemoveAssignees) == 0
+}
+
+// IssueTargetFromItem builds an edit target from a resolved issue or pull request
+func IssueTargetFromItem(info *ItemInfo) IssueTarget {
+	target := IssueTarget{
+		ContentID: info.ID,
+		Type:      info.Type,
+		Reference: info.Title,
+	}
+
+	if info.Repository != nil {
+		target.Repository = *info.Repository
+		if info.Number != nil {
+			target.Reference = fmt.Sprintf("%s#%d", target.Repository, *info.Number)
+		}
+	}
+
+	return target
+}
+
+// IssueTargetsFromProjectItems builds edit targets from project items.
+// Draft issues have no repository metadata and are returned separately by title.
+func IssueTargetsFromProjectItems(items []ProjectItemInfo) (targets []IssueTarget, drafts []string) {
+	for i := range items {
+		item := &items[i]
+		if item.ContentID == "" || item.Repository == nil {
+			drafts = append(drafts, item.Title)
+			continue
+		}
+
+		reference := item.Title
+		if item.Number != nil {
+			reference = fmt.Sprintf("%s#%d", *item.Repository, *item.Number)
+		}
+
+		targets = append(targets, IssueTarget{
+			ContentID:  item.ContentID,
+			Type:       item.Type,
+			Repository: *item.Repository,
+			Reference:  reference,
+		})
+	}
+
+	return targets, drafts
+}
+
+// ApplyEdit applies an edit to a single issue or pull request
+func (s *IssueService) ApplyEdit(ctx context.Context, target IssueTarget, edit *IssueEdit) error {
+	if err := s.applyLabels(ctx, target, edit); err != nil {
+		return err
+	}
+
+	if edit.Milestone != nil {
+		var milestoneID *string
+		if *edit.Milestone != "" {
+			id, err := s.ResolveRepositoryMilestoneID(ctx, target.Repository, *edit.Milestone)
+			if err != nil {
+				return err
+			}
+			milestoneID = &id
+		}
+		if err := s.SetMilestone(ctx, target.ContentID, target.Type, milestoneID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.applyAssignees(ctx, target, edit); err != nil {
+		return err
+	}
+
+	switch edit.State {
+	case IssueStateClosed:
+		return s.CloseItem(ctx, target.ContentID, target.Type, edit.StateReason)
+	case IssueStateOpen:
+		return s.ReopenItem(ctx, target.ContentID, target.Type)
+	}
+
+	return nil
+}
+
+// ApplyEdits applies an edit to several issues and pull requests, continuing past failures
+func (s *IssueService) ApplyEdits(ctx context.Context, targets []IssueTarget, edit *IssueEdit) *IssueEditResult {
+	result := &IssueEditResult{}
+
+	for _, target := range targets {
+		if err := s.ApplyEdit(ctx, target, edit); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", target.Reference, err))
+			continue
+		}
+		result.Processed++
+	}
+
+	return result
+}
+
+func (s *IssueService) applyLabels(ctx context.Context, target IssueTarget, edit *IssueEdit) error {
+	addIDs, err := s.ResolveRepositoryLabelIDs(ctx, target.Repository, edit.AddLabels)
+	if err != nil {
+		return err
+	}
+
+	removeIDs, err := s.ResolveRepositoryLabelIDs(ctx, target.Repository, edit.RemoveLabels)
+	if err != nil {
+		return err
+	}
+
+	if err := s.AddLabels(ctx, target.ContentID, addIDs); err != nil {
+		return err
+	}
+
+	return s.RemoveLabels(ctx, target.ContentID, removeIDs)
+}
+
+func (s *IssueService) applyAssignees(ctx context.Context, target IssueTarget, edit *IssueEdit) error {
+	addIDs, err := s.cachedUserIDs(ctx, edit.AddAssignees)
+	if err != nil {
+		return err
+	}
+
+	removeIDs, err := s.cachedUserIDs(ctx, edit.RemoveAssignees)
+	if err != nil {
+		return err
+	}
+
+	if err := s.AddAssignees(ctx, target.ContentID, addIDs); err != nil {
+		return err
+	}
+
+	return s.RemoveAssignees(ctx, target.ContentID, removeIDs)
+}
+
+// cachedRepository looks up a repository once per service instance
+func (s *IssueService) cachedRepository(ctx context.Context, nameWithOwner string) (*graphql.RepositoryDetails, error) {
+	if repository, ok := s.repositories[nameWithOwner]; ok {
+		return repository, nil
+	}
+
+	repository, err := s.GetRepositoryByName(ctx, nameWithOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	s.repositories[nameWithOwner] = repository
+	return repository, nil
+}
+
+// cachedUserIDs resolves user logins once per service instance
+func (s *IssueService) cachedUserIDs(ctx context.Context, logins []string) ([]string, error) {
+	ids := make([]string, 0, len(logins))
+	for _, login := range logins {
+		id, ok := s.users[login]
+		if !ok {
+			var err error
+			id, err = s.GetUserID(ctx, login)
+			if err != nil {
+				return nil, err
+			}
+			s.users[login] = id
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
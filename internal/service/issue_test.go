@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func TestIssueService(t *testing.T) {
+	t.Run("NewIssueService creates new service", func(t *testing.T) {
+		client := api.NewClient("test-token")
+		service := NewIssueService(client)
+
+		assert.NotNil(t, service)
+		assert.IsType(t, &IssueService{}, service)
+	})
+
+	t.Run("GetRepositoryByName with invalid format returns error", func(t *testing.T) {
+		client := api.NewClient("test-token")
+		service := NewIssueService(client)
+
+		repo, err := service.GetRepositoryByName(context.Background(), "invalid")
+
+		assert.Error(t, err)
+		assert.Nil(t, repo)
+		assert.Contains(t, err.Error(), "invalid repository format")
+	})
+
+	t.Run("AddLabels without labels is a no-op", func(t *testing.T) {
+		client := api.NewClient("invalid-token")
+		service := NewIssueService(client)
+
+		err := service.AddLabels(context.Background(), "I_123", nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("RemoveAssignees without users is a no-op", func(t *testing.T) {
+		client := api.NewClient("invalid-token")
+		service := NewIssueService(client)
+
+		err := service.RemoveAssignees(context.Background(), "I_123", nil)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("SetMilestone on a draft returns error", func(t *testing.T) {
+		client := api.NewClient("invalid-token")
+		service := NewIssueService(client)
+
+		err := service.SetMilestone(context.Background(), "DI_1", "DraftIssue", nil)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot set milestone on DraftIssue")
+	})
+
+	t.Run("CloseItem with invalid token returns error", func(t *testing.T) {
+		client := api.NewClient("invalid-token")
+		service := NewIssueService(client)
+
+		err := service.CloseItem(context.Background(), "I_123", "Issue", "COMPLETED")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to close item")
+	})
+}
+
+func TestResolveLabelIDs(t *testing.T) {
+	labels := []graphql.Label{
+		{ID: "L_bug", Name: "bug"},
+		{ID: "L_triage", Name: "Triage"},
+	}
+
+	t.Run("Resolve labels case-insensitively", func(t *testing.T) {
+		ids, err := ResolveLabelIDs(labels, "octocat/api", []string{"BUG", "triage"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"L_bug", "L_triage"}, ids)
+	})
+
+	t.Run("Unknown label returns error", func(t *testing.T) {
+		_, err := ResolveLabelIDs(labels, "octocat/api", []string{"wontfix"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "label 'wontfix' not found in octocat/api")
+	})
+}
+
+func TestResolveMilestoneID(t *testing.T) {
+	milestones := []graphql.Milestone{
+		{ID: "M_1", Title: "v1.0", Number: 1},
+		{ID: "M_2", Title: "Sprint 14", Number: 2},
+		{ID: "M_3", Title: "v0.9", Number: 3, Closed: true},
+	}
+
+	t.Run("Resolve milestone by title case-insensitively", func(t *testing.T) {
+		id, err := ResolveMilestoneID(milestones, "octocat/api", "sprint 14")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "M_2", id)
+	})
+
+	t.Run("Resolve milestone by number", func(t *testing.T) {
+		id, err := ResolveMilestoneID(milestones, "octocat/api", "#1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "M_1", id)
+	})
+
+	t.Run("Resolve closed milestone", func(t *testing.T) {
+		id, err := ResolveMilestoneID(milestones, "octocat/api", "v0.9")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "M_3", id)
+	})
+
+	t.Run("Unknown milestone returns error", func(t *testing.T) {
+		_, err := ResolveMilestoneID(milestones, "octocat/api", "v2.0")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found in octocat/api")
+	})
+}
+
+func TestIssueTargetsFromProjectItems(t *testing.T) {
+	repo := "octocat/api"
+	number := 7
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", ContentID: "I_7", Type: "Issue", Title: "Fix login bug", Number: &number, Repository: &repo},
+		{ItemID: "item-2", Type: "DraftIssue", Title: "Write release notes"},
+	}
+
+	t.Run("Issues become targets referenced by owner/repo#number", func(t *testing.T) {
+		targets, _ := IssueTargetsFromProjectItems(items)
+
+		assert.Len(t, targets, 1)
+		assert.Equal(t, "I_7", targets[0].ContentID)
+		assert.Equal(t, "octocat/api", targets[0].Repository)
+		assert.Equal(t, "octocat/api#7", targets[0].Reference)
+	})
+
+	t.Run("Drafts are returned separately", func(t *testing.T) {
+		_, drafts := IssueTargetsFromProjectItems(items)
+
+		assert.Equal(t, []string{"Write release notes"}, drafts)
+	})
+}
+
+func TestIssueEditIsEmpty(t *testing.T) {
+	t.Run("Empty edit", func(t *testing.T) {
+		edit := &IssueEdit{}
+		assert.True(t, edit.IsEmpty())
+	})
+
+	t.Run("Clearing the milestone is a change", func(t *testing.T) {
+		none := ""
+		edit := &IssueEdit{Milestone: &none}
+		assert.False(t, edit.IsEmpty())
+	})
+}
@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
 	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
@@ -85,33 +87,7 @@ func (s *ItemService) SearchIssues(ctx context.Context, searchQuery string, limi
 
 	items := make([]ItemInfo, 0, len(query.Search.Nodes))
 	for i := range query.Search.Nodes {
-		node := &query.Search.Nodes[i]
-		issue := node.Issue
-
-		labels := make([]string, len(issue.Labels.Nodes))
-		for i, label := range issue.Labels.Nodes {
-			labels[i] = label.Name
-		}
-
-		assignees := make([]string, len(issue.Assignees.Nodes))
-		for i, assignee := range issue.Assignees.Nodes {
-			assignees[i] = assignee.Login
-		}
-
-		items = append(items, ItemInfo{
-			ID:         issue.ID,
-			Title:      issue.Title,
-			Number:     &issue.Number,
-			URL:        &issue.URL,
-			Type:       "Issue",
-			State:      issue.State,
-			Repository: &issue.Repository.NameWithOwner,
-			Author:     &issue.Author.Login,
-			CreatedAt:  issue.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:  issue.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Labels:     labels,
-			Assignees:  assignees,
-		})
+		items = append(items, issueToItemInfo(&query.Search.Nodes[i].Issue))
 	}
 
 	return items, nil
@@ -138,41 +114,82 @@ func (s *ItemService) SearchPullRequests(ctx context.Context, searchQuery string
 
 	items := make([]ItemInfo, 0, len(query.Search.Nodes))
 	for i := range query.Search.Nodes {
-		node := &query.Search.Nodes[i]
-		pr := node.PullRequest
+		items = append(items, pullRequestToItemInfo(&query.Search.Nodes[i].PullRequest))
+	}
 
-		labels := make([]string, len(pr.Labels.Nodes))
-		for i, label := range pr.Labels.Nodes {
-			labels[i] = label.Name
+	return items, nil
+}
+
+// SearchAllIssues searches for issues and follows pagination until limit is reached (0 means no limit)
+func (s *ItemService) SearchAllIssues(ctx context.Context, searchQuery string, limit int) ([]ItemInfo, error) {
+	var items []ItemInfo
+	var after *string
+
+	for {
+		variables := graphql.BuildSearchIssuesVariables(graphql.SearchOptions{
+			Query: searchQuery,
+			First: searchPageSize,
+			After: after,
+		})
+
+		var query graphql.SearchIssuesQuery
+		err := s.client.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search issues: %w", err)
 		}
 
-		assignees := make([]string, len(pr.Assignees.Nodes))
-		for i, assignee := range pr.Assignees.Nodes {
-			assignees[i] = assignee.Login
+		for i := range query.Search.Nodes {
+			if query.Search.Nodes[i].Issue.ID == "" {
+				continue
+			}
+			items = append(items, issueToItemInfo(&query.Search.Nodes[i].Issue))
+			if limit > 0 && len(items) >= limit {
+				return items, nil
+			}
 		}
 
-		state := pr.State
-		if pr.Merged {
-			state = "MERGED"
+		if !query.Search.PageInfo.HasNextPage {
+			return items, nil
 		}
+		cursor := query.Search.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// SearchAllPullRequests searches for pull requests and follows pagination until limit is reached (0 means no limit)
+func (s *ItemService) SearchAllPullRequests(ctx context.Context, searchQuery string, limit int) ([]ItemInfo, error) {
+	var items []ItemInfo
+	var after *string
 
-		items = append(items, ItemInfo{
-			ID:         pr.ID,
-			Title:      pr.Title,
-			Number:     &pr.Number,
-			URL:        &pr.URL,
-			Type:       "PullRequest",
-			State:      state,
-			Repository: &pr.Repository.NameWithOwner,
-			Author:     &pr.Author.Login,
-			CreatedAt:  pr.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:  pr.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Labels:     labels,
-			Assignees:  assignees,
+	for {
+		variables := graphql.BuildSearchPullRequestsVariables(graphql.SearchOptions{
+			Query: searchQuery,
+			First: searchPageSize,
+			After: after,
 		})
-	}
 
-	return items, nil
+		var query graphql.SearchPullRequestsQuery
+		err := s.client.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search pull requests: %w", err)
+		}
+
+		for i := range query.Search.Nodes {
+			if query.Search.Nodes[i].PullRequest.ID == "" {
+				continue
+			}
+			items = append(items, pullRequestToItemInfo(&query.Search.Nodes[i].PullRequest))
+			if limit > 0 && len(items) >= limit {
+				return items, nil
+			}
+		}
+
+		if !query.Search.PageInfo.HasNextPage {
+			return items, nil
+		}
+		cursor := query.Search.PageInfo.EndCursor
+		after = &cursor
+	}
 }
 
 // ListRepositoryIssues lists issues in a repository
@@ -198,31 +215,7 @@ func (s *ItemService) ListRepositoryIssues(ctx context.Context, owner, repo stri
 
 	items := make([]ItemInfo, len(query.Repository.Issues.Nodes))
 	for i := range query.Repository.Issues.Nodes {
-		issue := &query.Repository.Issues.Nodes[i]
-		labels := make([]string, len(issue.Labels.Nodes))
-		for j, label := range issue.Labels.Nodes {
-			labels[j] = label.Name
-		}
-
-		assignees := make([]string, len(issue.Assignees.Nodes))
-		for j, assignee := range issue.Assignees.Nodes {
-			assignees[j] = assignee.Login
-		}
-
-		items[i] = ItemInfo{
-			ID:         issue.ID,
-			Title:      issue.Title,
-			Number:     &issue.Number,
-			URL:        &issue.URL,
-			Type:       "Issue",
-			State:      issue.State,
-			Repository: &issue.Repository.NameWithOwner,
-			Author:     &issue.Author.Login,
-			CreatedAt:  issue.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:  issue.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Labels:     labels,
-			Assignees:  assignees,
-		}
+		items[i] = issueToItemInfo(&query.Repository.Issues.Nodes[i])
 	}
 
 	return items, nil
@@ -251,39 +244,129 @@ func (s *ItemService) ListRepositoryPullRequests(ctx context.Context, owner, rep
 
 	items := make([]ItemInfo, len(query.Repository.PullRequests.Nodes))
 	for i := range query.Repository.PullRequests.Nodes {
-		pr := &query.Repository.PullRequests.Nodes[i]
-		labels := make([]string, len(pr.Labels.Nodes))
-		for j, label := range pr.Labels.Nodes {
-			labels[j] = label.Name
-		}
+		items[i] = pullRequestToItemInfo(&query.Repository.PullRequests.Nodes[i])
+	}
 
-		assignees := make([]string, len(pr.Assignees.Nodes))
-		for j, assignee := range pr.Assignees.Nodes {
-			assignees[j] = assignee.Login
-		}
+	return items, nil
+}
 
-		state := pr.State
-		if pr.Merged {
-			state = "MERGED"
-		}
+// ResolveItemReference resolves an item reference to the issue or pull request it points to
+func (s *ItemService) ResolveItemReference(ctx context.Context, ref string) (*ItemInfo, error) {
+	owner, repo, number, err := ParseItemReference(ref)
+	if err != nil {
+		return nil, err
+	}
 
-		items[i] = ItemInfo{
-			ID:         pr.ID,
-			Title:      pr.Title,
-			Number:     &pr.Number,
-			URL:        &pr.URL,
-			Type:       "PullRequest",
-			State:      state,
-			Repository: &pr.Repository.NameWithOwner,
-			Author:     &pr.Author.Login,
-			CreatedAt:  pr.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:  pr.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Labels:     labels,
-			Assignees:  assignees,
-		}
+	issue, err := s.GetIssue(ctx, owner, repo, number)
+	if err == nil && issue.ID != "" {
+		info := issueToItemInfo(issue)
+		return &info, nil
 	}
 
-	return items, nil
+	pr, prErr := s.GetPullRequest(ctx, owner, repo, number)
+	if prErr != nil {
+		return nil, fmt.Errorf("failed to find issue or pull request %s: %w", ref, prErr)
+	}
+
+	info := pullRequestToItemInfo(pr)
+	return &info, nil
+}
+
+// GetContentNode resolves an issue or pull request node ID
+func (s *ItemService) GetContentNode(ctx context.Context, id string) (*ItemInfo, error) {
+	variables := graphql.BuildGetContentNodeVariables(id)
+
+	var query graphql.GetContentNodeQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", id, err)
+	}
+
+	var info ItemInfo
+	switch query.Node.TypeName {
+	case "Issue":
+		info = issueToItemInfo(&query.Node.Issue)
+	case "PullRequest":
+		info = pullRequestToItemInfo(&query.Node.PullRequest)
+	default:
+		return nil, fmt.Errorf("node %s is not an issue or pull request", id)
+	}
+
+	return &info, nil
+}
+
+// ResolveItem resolves a reference, URL or node ID to the issue or pull request it points to
+func (s *ItemService) ResolveItem(ctx context.Context, ref string) (*ItemInfo, error) {
+	if IsContentNodeID(ref) {
+		return s.GetContentNode(ctx, ref)
+	}
+	return s.ResolveItemReference(ctx, ref)
+}
+
+// IsContentNodeID reports whether a string looks like an issue or pull request node ID
+func IsContentNodeID(ref string) bool {
+	return strings.HasPrefix(ref, "I_") || strings.HasPrefix(ref, "PR_")
+}
+
+// issueToItemInfo converts a GraphQL issue to ItemInfo
+func issueToItemInfo(issue *graphql.Issue) ItemInfo {
+	labels := make([]string, len(issue.Labels.Nodes))
+	for i, label := range issue.Labels.Nodes {
+		labels[i] = label.Name
+	}
+
+	assignees := make([]string, len(issue.Assignees.Nodes))
+	for i, assignee := range issue.Assignees.Nodes {
+		assignees[i] = assignee.Login
+	}
+
+	return ItemInfo{
+		ID:         issue.ID,
+		Title:      issue.Title,
+		Number:     &issue.Number,
+		URL:        &issue.URL,
+		Type:       "Issue",
+		State:      issue.State,
+		Repository: &issue.Repository.NameWithOwner,
+		Author:     &issue.Author.Login,
+		CreatedAt:  issue.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:  issue.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Labels:     labels,
+		Assignees:  assignees,
+	}
+}
+
+// pullRequestToItemInfo converts a GraphQL pull request to ItemInfo
+func pullRequestToItemInfo(pr *graphql.PullRequest) ItemInfo {
+	labels := make([]string, len(pr.Labels.Nodes))
+	for i, label := range pr.Labels.Nodes {
+		labels[i] = label.Name
+	}
+
+	assignees := make([]string, len(pr.Assignees.Nodes))
+	for i, assignee := range pr.Assignees.Nodes {
+		assignees[i] = assignee.Login
+	}
+
+	state := pr.State
+	if pr.Merged {
+		state = "MERGED"
+	}
+
+	return ItemInfo{
+		ID:         pr.ID,
+		Title:      pr.Title,
+		Number:     &pr.Number,
+		URL:        &pr.URL,
+		Type:       "PullRequest",
+		State:      state,
+		Repository: &pr.Repository.NameWithOwner,
+		Author:     &pr.Author.Login,
+		CreatedAt:  pr.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:  pr.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Labels:     labels,
+		Assignees:  assignees,
+	}
 }
 
 // AddItemToProject adds an existing issue or PR to a project
@@ -335,6 +418,23 @@ func (s *ItemService) UpdateDraftIssue(ctx context.Context, draftIssueID string,
 	return &mutation.UpdateProjectV2DraftIssue.DraftIssue, nil
 }
 
+// ConvertDraftToIssue converts a draft issue item into an issue in the given repository.
+// The project item is kept, so its field values stay attached to the new issue.
+func (s *ItemService) ConvertDraftToIssue(ctx context.Context, itemID, repositoryID string) (*graphql.ProjectV2Item, error) {
+	variables := graphql.BuildConvertDraftIssueVariables(graphql.ConvertDraftIssueInput{
+		ItemID:       itemID,
+		RepositoryID: repositoryID,
+	})
+
+	var mutation graphql.ConvertDraftIssueMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert draft issue: %w", err)
+	}
+
+	return &mutation.ConvertProjectV2DraftIssueItemToIssue.Item, nil
+}
+
 // RemoveItemFromProject removes an item from a project
 func (s *ItemService) RemoveItemFromProject(ctx context.Context, projectID, itemID string) error {
 	input := RemoveItemInput{
@@ -484,16 +584,19 @@ type CreateItemInput struct {
 
 // BulkUpdateInput represents input for bulk update operations
 type BulkUpdateInput struct {
+	// Value is the ProjectV2FieldValue input set on every item (see BuildFieldValue)
+	Value     interface{}
 	ProjectID string
+	FieldID   string
 	ItemIDs   []string
-	FieldName string
-	Value     interface{}
 }
 
 // BulkAddInput represents input for bulk add operations
 type BulkAddInput struct {
-	ProjectID string
-	Items     []CreateItemInput
+	// FieldValues holds initial field values keyed by field ID
+	FieldValues map[string]interface{}
+	ProjectID   string
+	Items       []CreateItemInput
 }
 
 // BulkUpdateResult represents result of bulk update operation
@@ -505,60 +608,421 @@ type BulkUpdateResult struct {
 
 // BulkAddResult represents result of bulk add operation
 type BulkAddResult struct {
-	Added  int
-	Failed int
-	Errors []string
+	Added   int
+	Skipped int
+	Failed  int
+	// FieldsFailed counts added items whose initial field values could not all be set
+	FieldsFailed int
+	Errors       []string
 }
 
 // BulkUpdateItems updates multiple items with same field value
-func (s *ItemService) BulkUpdateItems(_ context.Context, input BulkUpdateInput) (*BulkUpdateResult, error) {
+func (s *ItemService) BulkUpdateItems(ctx context.Context, input BulkUpdateInput) (*BulkUpdateResult, error) {
 	result := &BulkUpdateResult{}
+	values := map[string]interface{}{input.FieldID: input.Value}
 
 	for _, itemID := range input.ItemIDs {
-		// For now, this is a placeholder implementation
-		// In real implementation, this would execute GraphQL mutations to update items
-		fmt.Printf("Updating item %s: %s = %v\n", itemID, input.FieldName, input.Value)
+		if err := s.SetItemFieldValues(ctx, input.ProjectID, itemID, values); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemID, err))
+			continue
+		}
 		result.Updated++
 	}
 
 	return result, nil
 }
 
-// BulkAddItems adds multiple items to a project
-func (s *ItemService) BulkAddItems(_ context.Context, input BulkAddInput) (*BulkAddResult, error) {
+// BulkAddItems adds multiple items to a project, skipping items that are already in it
+func (s *ItemService) BulkAddItems(ctx context.Context, input BulkAddInput) (*BulkAddResult, error) {
 	result := &BulkAddResult{}
+	projectService := NewProjectService(s.client)
+
+	existing, err := projectService.ListProjectItems(ctx, input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingContent := make(map[string]bool, len(existing))
+	for i := range existing {
+		if contentID := ProjectItemContentID(&existing[i]); contentID != "" {
+			existingContent[contentID] = true
+		}
+	}
 
 	for _, item := range input.Items {
-		// For now, this is a placeholder implementation
-		// In real implementation, this would execute GraphQL mutations to add items
-		fmt.Printf("Adding item: %s\n", item.Title)
+		if item.ContentID == nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: no content ID", item.Title))
+			continue
+		}
+
+		if existingContent[*item.ContentID] {
+			result.Skipped++
+			continue
+		}
+
+		added, addErr := s.AddItemToProject(ctx, input.ProjectID, *item.ContentID)
+		if addErr != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", item.Title, addErr))
+			continue
+		}
+		existingContent[*item.ContentID] = true
 		result.Added++
+
+		fieldsFailed := false
+		for fieldID, value := range input.FieldValues {
+			_, updateErr := projectService.UpdateItemField(ctx, UpdateItemFieldInput{
+				ProjectID: input.ProjectID,
+				ItemID:    added.ID,
+				FieldID:   fieldID,
+				Value:     value,
+			})
+			if updateErr != nil {
+				fieldsFailed = true
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", item.Title, updateErr))
+			}
+		}
+		if fieldsFailed {
+			result.FieldsFailed++
+		}
 	}
 
 	return result, nil
 }
 
-// GetItemsByFilter retrieves items based on filter criteria
-func (s *ItemService) GetItemsByFilter(_ context.Context, projectID, filter string) ([]string, error) {
-	// Parse filter string (e.g., "label:epic", "assignee:@me")
-	parts := strings.Split(filter, ":")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid filter format, expected 'key:value'")
+// ProjectItemInfo represents a project item with its content and field values
+type ProjectItemInfo struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Number      *int
+	URL         *string
+	Repository  *string
+	Body        *string
+	Parent      *string
+	Milestone   *string
+	FieldValues map[string]string
+	// FieldUpdatedAt holds when each field value was last changed
+	FieldUpdatedAt map[string]time.Time
+	// ClosingIssues holds owner/repo#number references of issues a pull request closes
+	ClosingIssues []string
+	ItemID        string
+	ContentID     string
+	Type          string
+	Title         string
+	State         string
+	Labels        []string
+	Assignees     []string
+	Archived      bool
+}
+
+// ConvertProjectItem converts a GraphQL project item into ProjectItemInfo
+func ConvertProjectItem(item *graphql.ProjectV2Item) ProjectItemInfo {
+	info := ProjectItemInfo{
+		ItemID:      item.ID,
+		ContentID:   ProjectItemContentID(item),
+		Type:        item.Content.TypeName,
+		Archived:    item.IsArchived,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+		FieldValues: make(map[string]string, len(item.FieldValues.Nodes)),
 	}
 
-	filterType := strings.TrimSpace(parts[0])
-	filterValue := strings.TrimSpace(parts[1])
+	content := &item.Content
+	switch content.TypeName {
+	case "Issue":
+		info.Title = content.IssueTitle
+		info.State = content.IssueState
+		info.Number = &content.IssueNumber
+		info.URL = &content.IssueURL
+		info.Repository = &content.IssueRepository.Repository.NameWithOwner
+		info.Labels = contentLabelNames(content.IssueLabels.Labels)
+		info.Assignees = contentAssigneeLogins(content.IssueAssignees.Assignees)
+		if milestone := content.IssueMilestone.Milestone; milestone != nil {
+			info.Milestone = &milestone.Title
+		}
+		if parent := content.IssueHierarchy.Parent; parent != nil {
+			reference := fmt.Sprintf("%s#%d", parent.Repository.NameWithOwner, parent.Number)
+			info.Parent = &reference
+		}
+	case "PullRequest":
+		info.Title = content.PRTitle
+		info.State = content.PRState
+		info.Number = &content.PRNumber
+		info.URL = &content.PRURL
+		info.Repository = &content.PRRepository.Repository.NameWithOwner
+		info.Labels = contentLabelNames(content.PRLabels.Labels)
+		info.Assignees = contentAssigneeLogins(content.PRAssignees.Assignees)
+		if milestone := content.PRMilestone.Milestone; milestone != nil {
+			info.Milestone = &milestone.Title
+		}
+		for _, issue := range content.PRClosingIssues.ClosingIssuesReferences.Nodes {
+			info.ClosingIssues = append(info.ClosingIssues, fmt.Sprintf("%s#%d", issue.Repository.NameWithOwner, issue.Number))
+		}
+	case "DraftIssue":
+		info.Title = content.DraftTitle
+		info.Body = content.DraftBody
+		info.State = "DRAFT"
+		info.Assignees = contentAssigneeLogins(content.DraftAssignees.Assignees)
+	default:
+		info.Title = "Unknown"
+	}
+
+	for i := range item.FieldValues.Nodes {
+		value := &item.FieldValues.Nodes[i]
+		if value.Field.Name == "" {
+			continue
+		}
+		if formatted := FormatFieldValue(value); formatted != "" {
+			info.FieldValues[value.Field.Name] = formatted
+			if value.Common.UpdatedAt != nil {
+				if info.FieldUpdatedAt == nil {
+					info.FieldUpdatedAt = make(map[string]time.Time)
+				}
+				info.FieldUpdatedAt[value.Field.Name] = *value.Common.UpdatedAt
+			}
+		}
+	}
+
+	return info
+}
+
+// ConvertProjectItems converts GraphQL project items into ProjectItemInfo values
+func ConvertProjectItems(items []graphql.ProjectV2Item) []ProjectItemInfo {
+	infos := make([]ProjectItemInfo, len(items))
+	for i := range items {
+		infos[i] = ConvertProjectItem(&items[i])
+	}
+	return infos
+}
+
+// NewProjectItemInfo describes an issue or pull request that is not in a project yet
+// with the field values it is about to be given, so field rules can be checked first
+func NewProjectItemInfo(info *ItemInfo, values map[string]string) ProjectItemInfo {
+	item := ProjectItemInfo{
+		ContentID:   info.ID,
+		Type:        info.Type,
+		Title:       info.Title,
+		State:       info.State,
+		Number:      info.Number,
+		URL:         info.URL,
+		Repository:  info.Repository,
+		Labels:      info.Labels,
+		Assignees:   info.Assignees,
+		FieldValues: make(map[string]string, len(values)),
+	}
+	for name, value := range values {
+		item.FieldValues[name] = value
+	}
+	return item
+}
+
+// ChangedFieldValues returns the names of fields whose values differ between two snapshots
+func ChangedFieldValues(before, after map[string]string) []string {
+	var changed []string
+	for name, value := range before {
+		if after[name] != value {
+			changed = append(changed, name)
+		}
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// FormatFieldValue formats a project item field value for display
+func FormatFieldValue(value *graphql.ProjectV2ItemFieldValue) string {
+	switch {
+	case value.TextValue != nil:
+		return *value.TextValue
+	case value.NumberValue != nil:
+		return strconv.FormatFloat(*value.NumberValue, 'f', -1, 64)
+	case value.DateValue != nil:
+		return value.DateValue.Format(dateLayout)
+	case value.SingleSelectValue != nil:
+		return value.SingleSelectValue.Name
+	case value.IterationValue != nil:
+		return value.IterationValue.Title
+	default:
+		return ""
+	}
+}
+
+func contentLabelNames(labels graphql.ContentLabels) []string {
+	names := make([]string, len(labels.Nodes))
+	for i, label := range labels.Nodes {
+		names[i] = label.Name
+	}
+	return names
+}
+
+func contentAssigneeLogins(assignees graphql.ContentAssignees) []string {
+	logins := make([]string, len(assignees.Nodes))
+	for i, assignee := range assignees.Nodes {
+		logins[i] = assignee.Login
+	}
+	return logins
+}
+
+// SetItemFieldValues sets several field values (keyed by field ID) on a project item
+func (s *ItemService) SetItemFieldValues(ctx context.Context, projectID, itemID string, values map[string]interface{}) error {
+	projectService := NewProjectService(s.client)
+	for fieldID, value := range values {
+		_, err := projectService.UpdateItemField(ctx, UpdateItemFieldInput{
+			ProjectID: projectID,
+			ItemID:    itemID,
+			FieldID:   fieldID,
+			Value:     value,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ArchiveItem archives an item in a project
+func (s *ItemService) ArchiveItem(ctx context.Context, projectID, itemID string) error {
+	variables := graphql.BuildArchiveItemVariables(graphql.ArchiveItemInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+	})
+
+	var mutation graphql.ArchiveItemMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to archive item: %w", err)
+	}
+
+	return nil
+}
+
+// UnarchiveItem restores an archived item in a project
+func (s *ItemService) UnarchiveItem(ctx context.Context, projectID, itemID string) error {
+	variables := graphql.BuildArchiveItemVariables(graphql.ArchiveItemInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+	})
+
+	var mutation graphql.UnarchiveItemMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to unarchive item: %w", err)
+	}
+
+	return nil
+}
+
+// BulkArchiveResult represents result of a bulk archive or unarchive operation
+type BulkArchiveResult struct {
+	Processed int
+	Failed    int
+	Errors    []string
+}
+
+// BulkArchiveItems archives (or unarchives when archive is false) multiple items
+func (s *ItemService) BulkArchiveItems(ctx context.Context, projectID string, itemIDs []string, archive bool) *BulkArchiveResult {
+	result := &BulkArchiveResult{}
+
+	for _, itemID := range itemIDs {
+		var err error
+		if archive {
+			err = s.ArchiveItem(ctx, projectID, itemID)
+		} else {
+			err = s.UnarchiveItem(ctx, projectID, itemID)
+		}
 
-	// For now, this is a placeholder implementation
-	// In real implementation, this would execute GraphQL queries to find matching items
-	fmt.Printf("Filtering items in project %s by %s = %s\n", projectID, filterType, filterValue)
+		if err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemID, err))
+			continue
+		}
+		result.Processed++
+	}
+
+	return result
+}
+
+// ListProjectItems lists project items as ProjectItemInfo, optionally restricted by a filter
+func (s *ItemService) ListProjectItems(ctx context.Context, projectID, filter string) ([]ProjectItemInfo, error) {
+	itemFilter, err := ParseItemFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	projectService := NewProjectService(s.client)
+	items, err := projectService.ListProjectItems(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return FilterProjectItems(ConvertProjectItems(items), itemFilter), nil
+}
+
+// ProjectItemContentID returns the ID of the issue or pull request behind a project item
+func ProjectItemContentID(item *graphql.ProjectV2Item) string {
+	switch item.Content.TypeName {
+	case "Issue":
+		return item.Content.IssueNode.ID
+	case "PullRequest":
+		return item.Content.PRNode.ID
+	default:
+		return ""
+	}
+}
+
+// FindProjectItem finds a project item by its project item ID or by a reference to its
+// issue or pull request (owner/repo#123 or a GitHub URL)
+func FindProjectItem(items []ProjectItemInfo, ref string) (*ProjectItemInfo, error) {
+	for i := range items {
+		if items[i].ItemID == ref {
+			return &items[i], nil
+		}
+	}
+
+	owner, repo, number, err := ParseItemReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("item '%s' not found in project", ref)
+	}
+
+	repository := owner + "/" + repo
+	for i := range items {
+		item := &items[i]
+		if item.Number != nil && *item.Number == number &&
+			item.Repository != nil && strings.EqualFold(*item.Repository, repository) {
+			return item, nil
+		}
+	}
+
+	return nil, fmt.Errorf("item '%s' not found in project", ref)
+}
+
+// GetItemsByFilter retrieves the IDs of project items matching a filter
+func (s *ItemService) GetItemsByFilter(ctx context.Context, projectID, filter string) ([]string, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, fmt.Errorf("filter cannot be empty")
+	}
+
+	items, err := s.ListProjectItems(ctx, projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(items))
+	for i := range items {
+		ids[i] = items[i].ItemID
+	}
 
-	// Return placeholder item IDs
-	return []string{"item_1", "item_2", "item_3"}, nil
+	return ids, nil
 }
 
-// ParseNumberRange parses number range string (e.g., "34-46") into item IDs
-func ParseNumberRange(rangeStr string) ([]string, error) {
+// ParseNumberRange parses number range string (e.g., "34-46") into issue and pull request numbers
+func ParseNumberRange(rangeStr string) ([]int, error) {
 	if strings.Contains(rangeStr, "-") {
 		parts := strings.Split(rangeStr, "-")
 		if len(parts) != 2 {
@@ -579,9 +1043,9 @@ func ParseNumberRange(rangeStr string) ([]string, error) {
 			return nil, fmt.Errorf("start number cannot be greater than end number")
 		}
 
-		var result []string
+		var result []int
 		for i := start; i <= end; i++ {
-			result = append(result, fmt.Sprintf("item_%d", i))
+			result = append(result, i)
 		}
 		return result, nil
 	}
@@ -592,7 +1056,7 @@ func ParseNumberRange(rangeStr string) ([]string, error) {
 		return nil, fmt.Errorf("invalid number: %s", rangeStr)
 	}
 
-	return []string{fmt.Sprintf("item_%d", num)}, nil
+	return []int{num}, nil
 }
 
 // RemoveDuplicates removes duplicate strings from slice
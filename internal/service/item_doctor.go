@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// Doctor finding kinds
+const (
+	DoctorDuplicateTitle  = "duplicate-title"
+	DoctorDraftDuplicate  = "draft-duplicate"
+	DoctorInaccessible    = "inaccessible"
+	DoctorClosedNotDone   = "closed-not-done"
+	DoctorPullRequestPair = "pr-issue-pair"
+)
+
+// Doctor fix actions
+const (
+	DoctorFixArchive    = "archive"
+	DoctorFixMerge      = "merge"
+	DoctorFixStatusSync = "status-sync"
+)
+
+// DoctorOptions configures project diagnosis
+type DoctorOptions struct {
+	StatusField string
+	DoneStatus  string
+	// Similarity is the minimum title similarity (0-1) for two items to count as duplicates
+	Similarity float64
+}
+
+// DoctorFinding represents a problem found in a project.
+// Items[0] is the item the fix applies to; for merges, Items[1] is the item kept.
+type DoctorFinding struct {
+	Kind    string
+	Message string
+	Fix     string
+	Items   []ProjectItemInfo
+}
+
+// DiagnoseProjectItems detects duplicates, inaccessible content, closed items that
+// are not marked done and pull requests tracked alongside the issues they close.
+func DiagnoseProjectItems(items []ProjectItemInfo, opts DoctorOptions) []DoctorFinding {
+	var findings []DoctorFinding
+
+	var accessible []ProjectItemInfo
+	for i := range items {
+		item := &items[i]
+		if !isAccessibleItem(item) {
+			findings = append(findings, DoctorFinding{
+				Kind:    DoctorInaccessible,
+				Message: "Content was deleted, transferred or is not accessible",
+				Fix:     DoctorFixArchive,
+				Items:   []ProjectItemInfo{*item},
+			})
+			continue
+		}
+		accessible = append(accessible, *item)
+	}
+
+	findings = append(findings, findDuplicateTitles(accessible, opts.Similarity)...)
+	findings = append(findings, findClosedNotDone(accessible, opts)...)
+	findings = append(findings, findPullRequestPairs(accessible)...)
+
+	return findings
+}
+
+// ApplyDoctorFix applies a finding's fix to the project
+func (s *ItemService) ApplyDoctorFix(ctx context.Context, project *graphql.ProjectV2, finding *DoctorFinding, opts DoctorOptions) error {
+	target := &finding.Items[0]
+
+	switch finding.Fix {
+	case DoctorFixArchive:
+		return s.ArchiveItem(ctx, project.ID, target.ItemID)
+	case DoctorFixMerge:
+		kept := &finding.Items[1]
+		values, warnings := MapFieldValues(MissingFieldValues(target.FieldValues, kept.FieldValues), project.Fields.Nodes, nil)
+		if len(warnings) > 0 {
+			return fmt.Errorf("cannot merge field values: %s", strings.Join(warnings, "; "))
+		}
+		if err := s.SetItemFieldValues(ctx, project.ID, kept.ItemID, values); err != nil {
+			return err
+		}
+		return s.ArchiveItem(ctx, project.ID, target.ItemID)
+	case DoctorFixStatusSync:
+		values, err := ResolveFieldValues(project.Fields.Nodes, []string{opts.StatusField + "=" + opts.DoneStatus})
+		if err != nil {
+			return err
+		}
+		return s.SetItemFieldValues(ctx, project.ID, target.ItemID, values)
+	default:
+		return fmt.Errorf("no automatic fix for %s", finding.Kind)
+	}
+}
+
+// MissingFieldValues returns the values set in from that are not set in to
+func MissingFieldValues(from, to map[string]string) map[string]string {
+	missing := make(map[string]string)
+	for name, value := range from {
+		if IsReadOnlyField(name) {
+			continue
+		}
+		if _, ok := to[name]; !ok {
+			missing[name] = value
+		}
+	}
+	return missing
+}
+
+// TitleSimilarity returns the Sørensen–Dice coefficient of two titles' character bigrams,
+// ignoring case, punctuation and repeated whitespace
+func TitleSimilarity(a, b string) float64 {
+	a, b = normalizeTitle(a), normalizeTitle(b)
+	if a == b {
+		return 1
+	}
+
+	bigramsA, bigramsB := titleBigrams(a), titleBigrams(b)
+	total := len(bigramsA) + len(bigramsB)
+	if total == 0 {
+		return 0
+	}
+
+	counts := make(map[string]int, len(bigramsA))
+	for _, bigram := range bigramsA {
+		counts[bigram]++
+	}
+
+	shared := 0
+	for _, bigram := range bigramsB {
+		if counts[bigram] > 0 {
+			counts[bigram]--
+			shared++
+		}
+	}
+
+	return float64(2*shared) / float64(total)
+}
+
+func isAccessibleItem(item *ProjectItemInfo) bool {
+	switch item.Type {
+	case "Issue", "PullRequest", "DraftIssue":
+		return true
+	default:
+		return false
+	}
+}
+
+// findDuplicateTitles groups items with similar titles. Drafts duplicating a real
+// issue or pull request become merge candidates; other groups are reported only.
+func findDuplicateTitles(items []ProjectItemInfo, similarity float64) []DoctorFinding {
+	parent := make([]int, len(items))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+
+	for i := range items {
+		for j := i + 1; j < len(items); j++ {
+			if TitleSimilarity(items[i].Title, items[j].Title) >= similarity {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groups := make(map[int][]int)
+	var roots []int
+	for i := range items {
+		root := find(i)
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], i)
+	}
+
+	var findings []DoctorFinding
+	for _, root := range roots {
+		group := groups[root]
+		if len(group) < 2 {
+			continue
+		}
+
+		var drafts, content []ProjectItemInfo
+		for _, index := range group {
+			if items[index].Type == "DraftIssue" {
+				drafts = append(drafts, items[index])
+			} else {
+				content = append(content, items[index])
+			}
+		}
+
+		if len(content) > 0 {
+			for _, draft := range drafts {
+				findings = append(findings, DoctorFinding{
+					Kind:    DoctorDraftDuplicate,
+					Message: fmt.Sprintf("Draft duplicates %s", itemLabel(&content[0])),
+					Fix:     DoctorFixMerge,
+					Items:   []ProjectItemInfo{draft, content[0]},
+				})
+			}
+		}
+
+		// Drafts are covered by merge findings when there is real content to merge into
+		duplicates := content
+		if len(content) == 0 {
+			duplicates = drafts
+		}
+
+		if len(duplicates) > 1 {
+			findings = append(findings, DoctorFinding{
+				Kind:    DoctorDuplicateTitle,
+				Message: fmt.Sprintf("%d items have similar titles", len(duplicates)),
+				Items:   duplicates,
+			})
+		}
+	}
+
+	return findings
+}
+
+func findClosedNotDone(items []ProjectItemInfo, opts DoctorOptions) []DoctorFinding {
+	var findings []DoctorFinding
+	for i := range items {
+		item := &items[i]
+		if item.State != "CLOSED" && item.State != "MERGED" {
+			continue
+		}
+
+		status := fieldValue(item, opts.StatusField)
+		if strings.EqualFold(status, opts.DoneStatus) {
+			continue
+		}
+
+		if status == "" {
+			status = "no status"
+		}
+		findings = append(findings, DoctorFinding{
+			Kind:    DoctorClosedNotDone,
+			Message: fmt.Sprintf("%s but %s is %s", strings.ToLower(item.State), opts.StatusField, status),
+			Fix:     DoctorFixStatusSync,
+			Items:   []ProjectItemInfo{*item},
+		})
+	}
+	return findings
+}
+
+func findPullRequestPairs(items []ProjectItemInfo) []DoctorFinding {
+	issues := make(map[string]*ProjectItemInfo)
+	for i := range items {
+		item := &items[i]
+		if item.Type == "Issue" && item.Repository != nil && item.Number != nil {
+			issues[strings.ToLower(fmt.Sprintf("%s#%d", *item.Repository, *item.Number))] = item
+		}
+	}
+
+	var findings []DoctorFinding
+	for i := range items {
+		item := &items[i]
+		if item.Type != "PullRequest" {
+			continue
+		}
+
+		for _, ref := range item.ClosingIssues {
+			issue, ok := issues[strings.ToLower(ref)]
+			if !ok {
+				continue
+			}
+			findings = append(findings, DoctorFinding{
+				Kind:    DoctorPullRequestPair,
+				Message: fmt.Sprintf("Pull request closes %s, which is also tracked", ref),
+				Fix:     DoctorFixArchive,
+				Items:   []ProjectItemInfo{*item, *issue},
+			})
+		}
+	}
+	return findings
+}
+
+// SortDoctorFindings orders findings by kind, keeping detection order within a kind
+func SortDoctorFindings(findings []DoctorFinding) {
+	order := map[string]int{
+		DoctorInaccessible:    0,
+		DoctorDraftDuplicate:  1,
+		DoctorDuplicateTitle:  2,
+		DoctorPullRequestPair: 3,
+		DoctorClosedNotDone:   4,
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		return order[findings[i].Kind] < order[findings[j].Kind]
+	})
+}
+
+func itemLabel(item *ProjectItemInfo) string {
+	if item.Repository != nil && item.Number != nil {
+		return fmt.Sprintf("%s#%d", *item.Repository, *item.Number)
+	}
+	return fmt.Sprintf("'%s'", item.Title)
+}
+
+func normalizeTitle(title string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+			space = false
+		case !space && b.Len() > 0:
+			b.WriteRune(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func titleBigrams(title string) []string {
+	runes := []rune(title)
+	if len(runes) < 2 {
+		return nil
+	}
+
+	bigrams := make([]string, 0, len(runes)-1)
+	for i := 0; i < len(runes)-1; i++ {
+		bigrams = append(bigrams, string(runes[i:i+2]))
+	}
+	return bigrams
+}
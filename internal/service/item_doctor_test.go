@@ -0,0 +1,161 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDoctorItems() []ProjectItemInfo {
+	repo := "octocat/api"
+	number := func(n int) *int { return &n }
+
+	return []ProjectItemInfo{
+		{
+			ItemID: "item-1", Type: "Issue", Title: "Fix login bug", State: "OPEN",
+			Repository: &repo, Number: number(1),
+			FieldValues: map[string]string{"Status": "Todo"},
+		},
+		{
+			ItemID: "item-2", Type: "DraftIssue", Title: "Fix login bug!", State: "DRAFT",
+			FieldValues: map[string]string{"Status": "Todo", "Priority": "High", "Title": "Fix login bug!"},
+		},
+		{
+			ItemID: "item-3", Type: "Issue", Title: "Update docs", State: "CLOSED",
+			Repository: &repo, Number: number(3),
+			FieldValues: map[string]string{"Status": "In Progress"},
+		},
+		{
+			ItemID: "item-4", Type: "PullRequest", Title: "Rewrite the documentation site", State: "MERGED",
+			Repository: &repo, Number: number(4), ClosingIssues: []string{"Octocat/API#3", "octocat/api#99"},
+			FieldValues: map[string]string{"Status": "Done"},
+		},
+		{
+			ItemID: "item-5", Type: "", Title: "Unknown", State: "",
+		},
+	}
+}
+
+func doctorFindingsByKind(findings []DoctorFinding) map[string][]DoctorFinding {
+	byKind := make(map[string][]DoctorFinding)
+	for _, finding := range findings {
+		byKind[finding.Kind] = append(byKind[finding.Kind], finding)
+	}
+	return byKind
+}
+
+func TestDiagnoseProjectItems(t *testing.T) {
+	opts := DoctorOptions{StatusField: "Status", DoneStatus: "Done", Similarity: 0.85}
+
+	t.Run("Finds each kind of problem", func(t *testing.T) {
+		findings := doctorFindingsByKind(DiagnoseProjectItems(testDoctorItems(), opts))
+
+		assert.Len(t, findings[DoctorInaccessible], 1)
+		assert.Equal(t, "item-5", findings[DoctorInaccessible][0].Items[0].ItemID)
+		assert.Equal(t, DoctorFixArchive, findings[DoctorInaccessible][0].Fix)
+
+		assert.Len(t, findings[DoctorDraftDuplicate], 1)
+		merge := findings[DoctorDraftDuplicate][0]
+		assert.Equal(t, DoctorFixMerge, merge.Fix)
+		assert.Equal(t, "item-2", merge.Items[0].ItemID)
+		assert.Equal(t, "item-1", merge.Items[1].ItemID)
+
+		assert.Len(t, findings[DoctorClosedNotDone], 1)
+		assert.Equal(t, "item-3", findings[DoctorClosedNotDone][0].Items[0].ItemID)
+		assert.Equal(t, DoctorFixStatusSync, findings[DoctorClosedNotDone][0].Fix)
+
+		assert.Len(t, findings[DoctorPullRequestPair], 1)
+		pair := findings[DoctorPullRequestPair][0]
+		assert.Equal(t, "item-4", pair.Items[0].ItemID)
+		assert.Equal(t, "item-3", pair.Items[1].ItemID)
+
+		assert.Empty(t, findings[DoctorDuplicateTitle])
+	})
+
+	t.Run("Similar issue titles are reported without a fix", func(t *testing.T) {
+		items := testDoctorItems()
+		items[2].Title = "Fix the login bug"
+
+		findings := doctorFindingsByKind(DiagnoseProjectItems(items, opts))
+
+		assert.Len(t, findings[DoctorDuplicateTitle], 1)
+		assert.Empty(t, findings[DoctorDuplicateTitle][0].Fix)
+		assert.Len(t, findings[DoctorDuplicateTitle][0].Items, 2)
+	})
+
+	t.Run("Duplicate drafts without content are reported", func(t *testing.T) {
+		items := []ProjectItemInfo{
+			{ItemID: "draft-1", Type: "DraftIssue", Title: "Plan launch"},
+			{ItemID: "draft-2", Type: "DraftIssue", Title: "plan launch"},
+		}
+
+		findings := DiagnoseProjectItems(items, opts)
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, DoctorDuplicateTitle, findings[0].Kind)
+	})
+
+	t.Run("Stricter similarity ignores near matches", func(t *testing.T) {
+		items := testDoctorItems()
+		items[1].Title = "Fix the login bugs"
+
+		strict := opts
+		strict.Similarity = 1
+
+		findings := doctorFindingsByKind(DiagnoseProjectItems(items, strict))
+
+		assert.Empty(t, findings[DoctorDraftDuplicate])
+	})
+
+	t.Run("Done status is matched case-insensitively", func(t *testing.T) {
+		items := testDoctorItems()
+		items[2].FieldValues["Status"] = "done"
+
+		findings := doctorFindingsByKind(DiagnoseProjectItems(items, opts))
+
+		assert.Empty(t, findings[DoctorClosedNotDone])
+	})
+}
+
+func TestSortDoctorFindings(t *testing.T) {
+	t.Run("Findings are grouped by kind", func(t *testing.T) {
+		findings := DiagnoseProjectItems(testDoctorItems(), DoctorOptions{
+			StatusField: "Status", DoneStatus: "Done", Similarity: 0.85,
+		})
+
+		SortDoctorFindings(findings)
+
+		kinds := make([]string, len(findings))
+		for i := range findings {
+			kinds[i] = findings[i].Kind
+		}
+		assert.Equal(t, []string{DoctorInaccessible, DoctorDraftDuplicate, DoctorPullRequestPair, DoctorClosedNotDone}, kinds)
+	})
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	t.Run("Case and punctuation are ignored", func(t *testing.T) {
+		assert.Equal(t, 1.0, TitleSimilarity("Fix login bug", "fix: login  bug!"))
+	})
+
+	t.Run("Near matches score high", func(t *testing.T) {
+		assert.Greater(t, TitleSimilarity("Fix login bug", "Fix the login bug"), 0.85)
+	})
+
+	t.Run("Different titles score low", func(t *testing.T) {
+		assert.Less(t, TitleSimilarity("Fix login bug", "Update docs"), 0.3)
+	})
+
+	t.Run("Empty titles do not match", func(t *testing.T) {
+		assert.Equal(t, 0.0, TitleSimilarity("a", "b"))
+	})
+}
+
+func TestMissingFieldValues(t *testing.T) {
+	t.Run("Returns unset editable values", func(t *testing.T) {
+		from := map[string]string{"Status": "Todo", "Priority": "High", "Title": "Draft"}
+		to := map[string]string{"Status": "In Progress"}
+
+		assert.Equal(t, map[string]string{"Priority": "High"}, MissingFieldValues(from, to))
+	})
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// todayExpression matches relative dates such as @today, @today-14d or @today+1w
+var todayExpression = regexp.MustCompile(`^@today(?:([+-])(\d+)([dwmy]))?$`)
+
+// ItemFilter represents a parsed project item filter such as
+// `status:"In Progress" label:bug -assignee:octocat`
+type ItemFilter struct {
+	Terms []FilterTerm
+	// IncludeArchived is set when the filter asks for archived items with is:archived
+	IncludeArchived bool
+}
+
+// FilterTerm represents a single qualifier in an item filter
+type FilterTerm struct {
+	Key    string
+	Values []string
+	Negate bool
+}
+
+// ParseItemFilter parses a filter string using GitHub Projects filter syntax.
+//
+// Supported qualifiers:
+//   - is:issue, is:pr, is:draft, is:open, is:closed, is:merged, is:archived
+//   - type:issue, type:pr, type:draft
+//   - label:<name>, assignee:<login>, repo:<owner/name>, title:<text>
+//   - parent:<owner/name#number> for sub-issues of a parent issue
+//   - has:<field>, no:<field>
+//   - created:<date>, updated:<date> for the item's creation and last update
+//   - <field>:<value> for any project field (e.g. status:"In Progress")
+//
+// Values may be quoted and comma-separated (OR), and a leading "-" negates a qualifier.
+// Dates, numbers and date fields can be compared with <, <=, > and >=, or matched
+// against an inclusive range such as 2025-01-01..2025-03-31 (use * for an open end).
+// Dates are YYYY-MM-DD or relative to today: @today, @today-14d, @today+2w (d, w, m, y).
+// Bare words match against the item title.
+func ParseItemFilter(filter string) (*ItemFilter, error) {
+	return parseItemFilterAt(filter, time.Now())
+}
+
+// parseItemFilterAt parses a filter, resolving relative dates against now
+func parseItemFilterAt(filter string, now time.Time) (*ItemFilter, error) {
+	tokens, err := tokenizeFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ItemFilter{}
+	for _, token := range tokens {
+		term := FilterTerm{}
+		if strings.HasPrefix(token, "-") && len(token) > 1 {
+			term.Negate = true
+			token = token[1:]
+		}
+
+		key, value, hasKey := strings.Cut(token, ":")
+		if !hasKey {
+			term.Values = []string{unquote(token)}
+		} else {
+			if key == "" || value == "" {
+				return nil, fmt.Errorf("invalid filter qualifier: %s", token)
+			}
+			term.Key = strings.ToLower(key)
+			term.Values = splitFilterValues(value)
+			for i, value := range term.Values {
+				resolved, err := resolveFilterDates(value, now)
+				if err != nil {
+					return nil, err
+				}
+				term.Values[i] = resolved
+			}
+		}
+
+		if term.Key == "is" && !term.Negate && containsFold(term.Values, "archived") {
+			result.IncludeArchived = true
+		}
+
+		result.Terms = append(result.Terms, term)
+	}
+
+	return result, nil
+}
+
+// FilterProjectItems returns the items matching the filter.
+// Archived items are only included when the filter contains is:archived.
+func FilterProjectItems(items []ProjectItemInfo, filter *ItemFilter) []ProjectItemInfo {
+	matched := make([]ProjectItemInfo, 0, len(items))
+	for i := range items {
+		if MatchesItemFilter(&items[i], filter) {
+			matched = append(matched, items[i])
+		}
+	}
+	return matched
+}
+
+// MatchesItemFilter reports whether an item matches every term of the filter
+func MatchesItemFilter(item *ProjectItemInfo, filter *ItemFilter) bool {
+	if filter == nil {
+		return !item.Archived
+	}
+
+	if item.Archived != filter.IncludeArchived {
+		return false
+	}
+
+	for _, term := range filter.Terms {
+		if matchesFilterTerm(item, &term) == term.Negate {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesFilterTerm reports whether any of the term's values match the item
+func matchesFilterTerm(item *ProjectItemInfo, term *FilterTerm) bool {
+	for _, value := range term.Values {
+		if matchesFilterValue(item, term.Key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesFilterValue(item *ProjectItemInfo, key, value string) bool {
+	if matched, ok := matchesComparison(item, key, value); ok {
+		return matched
+	}
+
+	switch key {
+	case "":
+		return strings.Contains(strings.ToLower(item.Title), strings.ToLower(value))
+	case "title":
+		return strings.Contains(strings.ToLower(item.Title), strings.ToLower(value))
+	case "is":
+		return matchesIsQualifier(item, value)
+	case "type":
+		return matchesItemType(item, value)
+	case "label":
+		return containsFold(item.Labels, value)
+	case "assignee":
+		return containsFold(item.Assignees, value)
+	case "repo":
+		return item.Repository != nil && strings.EqualFold(*item.Repository, value)
+	case "parent":
+		return matchesParent(item, value)
+	case "created":
+		return item.CreatedAt.Local().Format(dateLayout) == value
+	case "updated":
+		return item.UpdatedAt.Local().Format(dateLayout) == value
+	case "has":
+		return fieldValue(item, value) != ""
+	case "no":
+		return fieldValue(item, value) == ""
+	default:
+		return strings.EqualFold(fieldValue(item, key), value)
+	}
+}
+
+// matchesComparison evaluates comparison (<, <=, >, >=) and range (a..b) values of
+// created, updated and project field qualifiers. ok is false when the value is not a comparison.
+func matchesComparison(item *ProjectItemInfo, key, value string) (matched, ok bool) {
+	switch key {
+	case "", "title", "is", "type", "label", "assignee", "repo", "parent", "has", "no":
+		return false, false
+	}
+
+	operator, operand := cutComparisonOperator(value)
+	low, high, isRange := strings.Cut(operand, "..")
+	if operator == "" && !isRange {
+		return false, false
+	}
+
+	actual := comparableItemValue(item, key)
+	if actual == "" {
+		return false, true
+	}
+
+	if isRange {
+		return (low == "*" || compareFilterValues(actual, low) >= 0) &&
+			(high == "*" || compareFilterValues(actual, high) <= 0), true
+	}
+
+	cmp := compareFilterValues(actual, operand)
+	switch operator {
+	case "<":
+		return cmp < 0, true
+	case "<=":
+		return cmp <= 0, true
+	case ">":
+		return cmp > 0, true
+	default:
+		return cmp >= 0, true
+	}
+}
+
+// comparableItemValue returns the value compared by a comparison qualifier
+func comparableItemValue(item *ProjectItemInfo, key string) string {
+	switch key {
+	case "created":
+		return item.CreatedAt.Local().Format(dateLayout)
+	case "updated":
+		return item.UpdatedAt.Local().Format(dateLayout)
+	default:
+		return fieldValue(item, key)
+	}
+}
+
+// compareFilterValues compares numerically when both values are numbers;
+// otherwise it compares strings, which orders YYYY-MM-DD dates correctly
+func compareFilterValues(a, b string) int {
+	numberA, errA := strconv.ParseFloat(a, 64)
+	numberB, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case numberA < numberB:
+			return -1
+		case numberA > numberB:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+func cutComparisonOperator(value string) (operator, operand string) {
+	for _, operator := range []string{"<=", ">=", "<", ">"} {
+		if strings.HasPrefix(value, operator) {
+			return operator, strings.TrimPrefix(value, operator)
+		}
+	}
+	return "", value
+}
+
+// resolveFilterDates replaces @today expressions in a filter value with YYYY-MM-DD dates
+func resolveFilterDates(value string, now time.Time) (string, error) {
+	if !strings.Contains(strings.ToLower(value), "@today") {
+		return value, nil
+	}
+
+	operator, operand := cutComparisonOperator(value)
+	parts := strings.Split(operand, "..")
+	for i, part := range parts {
+		if !strings.HasPrefix(strings.ToLower(part), "@today") {
+			continue
+		}
+		date, err := resolveTodayExpression(part, now)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = date
+	}
+
+	return operator + strings.Join(parts, ".."), nil
+}
+
+func resolveTodayExpression(expression string, now time.Time) (string, error) {
+	match := todayExpression.FindStringSubmatch(strings.ToLower(expression))
+	if match == nil {
+		return "", fmt.Errorf("invalid date expression: %s (expected @today, @today-14d, @today+2w)", expression)
+	}
+
+	if match[1] == "" {
+		return now.Format(dateLayout), nil
+	}
+
+	amount, err := strconv.Atoi(match[2])
+	if err != nil {
+		return "", fmt.Errorf("invalid date expression: %s", expression)
+	}
+	if match[1] == "-" {
+		amount = -amount
+	}
+
+	switch match[3] {
+	case "d":
+		now = now.AddDate(0, 0, amount)
+	case "w":
+		now = now.AddDate(0, 0, 7*amount)
+	case "m":
+		now = now.AddDate(0, amount, 0)
+	default:
+		now = now.AddDate(amount, 0, 0)
+	}
+
+	return now.Format(dateLayout), nil
+}
+
+// matchesParent reports whether the item's parent issue matches an owner/repo#number reference or URL
+func matchesParent(item *ProjectItemInfo, value string) bool {
+	if item.Parent == nil {
+		return false
+	}
+
+	owner, repo, number, err := ParseItemReference(value)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(*item.Parent, FormatItemReference(owner, repo, number))
+}
+
+func matchesIsQualifier(item *ProjectItemInfo, value string) bool {
+	switch strings.ToLower(value) {
+	case "open", "closed", "merged":
+		return strings.EqualFold(item.State, value)
+	case "archived":
+		return item.Archived
+	default:
+		return matchesItemType(item, value)
+	}
+}
+
+func matchesItemType(item *ProjectItemInfo, value string) bool {
+	switch strings.ToLower(value) {
+	case "issue":
+		return item.Type == "Issue"
+	case "pr", "pullrequest", "pull_request":
+		return item.Type == "PullRequest"
+	case "draft", "draftissue", "draft_issue":
+		return item.Type == "DraftIssue"
+	default:
+		return false
+	}
+}
+
+// fieldValue returns the item's value for a field name (case-insensitive)
+func fieldValue(item *ProjectItemInfo, name string) string {
+	if value, ok := item.FieldValues[name]; ok {
+		return value
+	}
+
+	for fieldName, value := range item.FieldValues {
+		if strings.EqualFold(fieldName, name) {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// tokenizeFilter splits a filter on whitespace, keeping quoted sections together
+func tokenizeFilter(filter string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range filter {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case unicode.IsSpace(r) && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote in filter: %s", filter)
+	}
+
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+// splitFilterValues splits comma-separated values, honoring quotes
+func splitFilterValues(value string) []string {
+	var values []string
+	var current strings.Builder
+	inQuotes := false
+
+	for _, r := range value {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ',' && !inQuotes:
+			values = append(values, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return append(values, current.String())
+}
+
+func unquote(value string) string {
+	return strings.Trim(value, `"`)
+}
+
+func containsFold(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testProjectItems() []ProjectItemInfo {
+	repo := "octocat/api"
+	number := 1
+	parent := "octocat/api#12"
+
+	return []ProjectItemInfo{
+		{
+			ItemID:      "item-1",
+			Type:        "Issue",
+			Title:       "Fix login bug",
+			State:       "OPEN",
+			Number:      &number,
+			Repository:  &repo,
+			Parent:      &parent,
+			Labels:      []string{"bug"},
+			Assignees:   []string{"octocat"},
+			UpdatedAt:   time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC),
+			FieldValues: map[string]string{"Status": "In Progress", "Priority": "High", "Estimate": "8", "Due": "2025-03-10"},
+		},
+		{
+			ItemID:      "item-2",
+			Type:        "PullRequest",
+			Title:       "Add caching layer",
+			State:       "MERGED",
+			Repository:  &repo,
+			UpdatedAt:   time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC),
+			FieldValues: map[string]string{"Status": "Done", "Estimate": "3", "Due": "2025-03-20"},
+		},
+		{
+			ItemID:      "item-3",
+			Type:        "DraftIssue",
+			Title:       "Write release notes",
+			State:       "DRAFT",
+			FieldValues: map[string]string{},
+		},
+		{
+			ItemID:      "item-4",
+			Type:        "Issue",
+			Title:       "Old cleanup task",
+			State:       "CLOSED",
+			Archived:    true,
+			FieldValues: map[string]string{"Status": "Done"},
+		},
+	}
+}
+
+func filteredIDs(t *testing.T, filter string) []string {
+	t.Helper()
+
+	parsed, err := ParseItemFilter(filter)
+	assert.NoError(t, err)
+
+	items := FilterProjectItems(testProjectItems(), parsed)
+	ids := make([]string, len(items))
+	for i := range items {
+		ids[i] = items[i].ItemID
+	}
+	return ids
+}
+
+func TestParseItemFilter(t *testing.T) {
+	t.Run("Parse qualifiers, negation and quoted values", func(t *testing.T) {
+		filter, err := ParseItemFilter(`status:"In Progress",Todo -label:bug login`)
+
+		assert.NoError(t, err)
+		assert.Len(t, filter.Terms, 3)
+		assert.Equal(t, FilterTerm{Key: "status", Values: []string{"In Progress", "Todo"}}, filter.Terms[0])
+		assert.Equal(t, FilterTerm{Key: "label", Values: []string{"bug"}, Negate: true}, filter.Terms[1])
+		assert.Equal(t, FilterTerm{Values: []string{"login"}}, filter.Terms[2])
+		assert.False(t, filter.IncludeArchived)
+	})
+
+	t.Run("is:archived includes archived items", func(t *testing.T) {
+		filter, err := ParseItemFilter("is:archived")
+
+		assert.NoError(t, err)
+		assert.True(t, filter.IncludeArchived)
+	})
+
+	t.Run("Empty filter has no terms", func(t *testing.T) {
+		filter, err := ParseItemFilter("  ")
+
+		assert.NoError(t, err)
+		assert.Empty(t, filter.Terms)
+	})
+
+	t.Run("Unterminated quote returns error", func(t *testing.T) {
+		_, err := ParseItemFilter(`status:"In Progress`)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unterminated quote")
+	})
+
+	t.Run("Relative dates are resolved against today", func(t *testing.T) {
+		now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+		filter, err := parseItemFilterAt("updated:<@today-14d due:@today-1w..@today+1m assignee:@me", now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"<2025-03-01"}, filter.Terms[0].Values)
+		assert.Equal(t, []string{"2025-03-08..2025-04-15"}, filter.Terms[1].Values)
+		assert.Equal(t, []string{"@me"}, filter.Terms[2].Values)
+	})
+
+	t.Run("Invalid relative date returns error", func(t *testing.T) {
+		_, err := ParseItemFilter("updated:<@today-2x")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid date expression")
+	})
+
+	t.Run("Missing qualifier value returns error", func(t *testing.T) {
+		_, err := ParseItemFilter("status:")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid filter qualifier")
+	})
+}
+
+func TestFilterProjectItems(t *testing.T) {
+	t.Run("Empty filter excludes archived items", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1", "item-2", "item-3"}, filteredIDs(t, ""))
+	})
+
+	t.Run("is:archived selects archived items only", func(t *testing.T) {
+		assert.Equal(t, []string{"item-4"}, filteredIDs(t, "is:archived"))
+	})
+
+	t.Run("Field values match case-insensitively", func(t *testing.T) {
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "status:done"))
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, `Status:"in progress"`))
+	})
+
+	t.Run("Comma-separated values are OR'd", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1", "item-2"}, filteredIDs(t, `status:Done,"In Progress"`))
+	})
+
+	t.Run("Negated qualifiers exclude matches", func(t *testing.T) {
+		assert.Equal(t, []string{"item-2", "item-3"}, filteredIDs(t, "-label:bug"))
+	})
+
+	t.Run("Type and state qualifiers", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "is:issue"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "is:merged"))
+		assert.Equal(t, []string{"item-3"}, filteredIDs(t, "type:draft"))
+	})
+
+	t.Run("has and no qualifiers", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "has:priority"))
+		assert.Equal(t, []string{"item-3"}, filteredIDs(t, "no:status"))
+	})
+
+	t.Run("Assignee, repo and title qualifiers", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "assignee:octocat"))
+		assert.Equal(t, []string{"item-1", "item-2"}, filteredIDs(t, "repo:octocat/api"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "caching"))
+	})
+
+	t.Run("Updated dates are compared by day", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1", "item-3"}, filteredIDs(t, "updated:<2025-03-10"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "updated:>=2025-03-10"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "updated:2025-03-14"))
+	})
+
+	t.Run("Number and date fields are compared", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "estimate:>5"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "estimate:<=3"))
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "due:2025-03-01..2025-03-15"))
+		assert.Equal(t, []string{"item-2"}, filteredIDs(t, "due:2025-03-15..*"))
+	})
+
+	t.Run("Comparisons never match empty fields", func(t *testing.T) {
+		assert.Equal(t, []string{"item-3"}, filteredIDs(t, "-estimate:>0"))
+	})
+
+	t.Run("Parent qualifier matches references and URLs", func(t *testing.T) {
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, "parent:octocat/api#12"))
+		assert.Equal(t, []string{"item-1"}, filteredIDs(t, `parent:"https://github.com/octocat/api/issues/12"`))
+		assert.Equal(t, []string{"item-2", "item-3"}, filteredIDs(t, "-parent:octocat/api#12"))
+	})
+}
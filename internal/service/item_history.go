@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// History event kinds
+const (
+	HistoryAdded     = "added"
+	HistoryRemoved   = "removed"
+	HistoryConverted = "converted"
+	HistoryStatus    = "status"
+	HistoryClosed    = "closed"
+	HistoryReopened  = "reopened"
+	HistoryMerged    = "merged"
+	HistoryArchived  = "archived"
+)
+
+// HistoryEvent represents a single entry in a project item's history
+type HistoryEvent struct {
+	Time   time.Time `json:"time"`
+	Kind   string    `json:"event"`
+	Actor  string    `json:"actor,omitempty"`
+	Field  string    `json:"field,omitempty"`
+	From   string    `json:"from,omitempty"`
+	To     string    `json:"to,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// GetItemHistory builds a chronological history of a project item.
+// Status changes, additions, removals and state changes come from the content's timeline;
+// draft issues only have their creation and archive state.
+func (s *ItemService) GetItemHistory(ctx context.Context, projectID string, item *ProjectItemInfo) ([]HistoryEvent, error) {
+	var events []HistoryEvent
+
+	if item.ContentID == "" {
+		events = append(events, HistoryEvent{
+			Time:   item.CreatedAt,
+			Kind:   HistoryAdded,
+			Detail: "Draft issue created",
+		})
+	} else {
+		timeline, err := s.getTimeline(ctx, item.ContentID, item.Type)
+		if err != nil {
+			return nil, err
+		}
+		events = ConvertTimelineEvents(timeline, projectID)
+	}
+
+	if item.Archived {
+		events = append(events, HistoryEvent{
+			Time:   item.UpdatedAt,
+			Kind:   HistoryArchived,
+			Detail: "Archived (time of last update)",
+		})
+	}
+
+	SortHistoryEvents(events)
+	return events, nil
+}
+
+// getTimeline retrieves all project-related timeline events of an issue or pull request
+func (s *ItemService) getTimeline(ctx context.Context, contentID, contentType string) ([]graphql.TimelineEvent, error) {
+	var events []graphql.TimelineEvent
+	var after *string
+
+	for {
+		variables := graphql.BuildGetTimelineVariables(graphql.TimelineOptions{
+			ContentID: contentID,
+			First:     timelinePageSize,
+			After:     after,
+		})
+
+		var page *graphql.TimelineItems
+		switch contentType {
+		case "PullRequest":
+			var query graphql.GetPullRequestTimelineQuery
+			if err := s.client.Query(ctx, &query, variables); err != nil {
+				return nil, fmt.Errorf("failed to get item timeline: %w", err)
+			}
+			page = &query.Node.PullRequest.TimelineItems
+		default:
+			var query graphql.GetIssueTimelineQuery
+			if err := s.client.Query(ctx, &query, variables); err != nil {
+				return nil, fmt.Errorf("failed to get item timeline: %w", err)
+			}
+			page = &query.Node.Issue.TimelineItems
+		}
+
+		events = append(events, page.Nodes...)
+
+		if !page.PageInfo.HasNextPage {
+			return events, nil
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// ConvertTimelineEvents converts timeline events into history events.
+// Project events for other projects, or whose project is not visible, are dropped;
+// state changes are always kept.
+func ConvertTimelineEvents(events []graphql.TimelineEvent, projectID string) []HistoryEvent {
+	var history []HistoryEvent
+
+	inProject := func(project *graphql.TimelineProject) bool {
+		return project != nil && project.ID == projectID
+	}
+
+	for i := range events {
+		event := &events[i]
+		switch event.TypeName {
+		case "AddedToProjectV2Event":
+			if inProject(event.AddedToProject.Project) {
+				history = append(history, HistoryEvent{
+					Time:   event.AddedToProject.CreatedAt,
+					Kind:   HistoryAdded,
+					Actor:  actorLogin(event.AddedToProject.Actor),
+					Detail: "Added to project",
+				})
+			}
+		case "RemovedFromProjectV2Event":
+			if inProject(event.RemovedFromProject.Project) {
+				history = append(history, HistoryEvent{
+					Time:   event.RemovedFromProject.CreatedAt,
+					Kind:   HistoryRemoved,
+					Actor:  actorLogin(event.RemovedFromProject.Actor),
+					Detail: "Removed from project",
+				})
+			}
+		case "ConvertedFromDraftEvent":
+			if inProject(event.ConvertedFromDraft.Project) {
+				history = append(history, HistoryEvent{
+					Time:   event.ConvertedFromDraft.CreatedAt,
+					Kind:   HistoryConverted,
+					Actor:  actorLogin(event.ConvertedFromDraft.Actor),
+					Detail: "Converted from draft issue",
+				})
+			}
+		case "ProjectV2ItemStatusChangedEvent":
+			if inProject(event.StatusChanged.Project) {
+				history = append(history, HistoryEvent{
+					Time:  event.StatusChanged.CreatedAt,
+					Kind:  HistoryStatus,
+					Actor: actorLogin(event.StatusChanged.Actor),
+					Field: "Status",
+					From:  event.StatusChanged.PreviousStatus,
+					To:    event.StatusChanged.Status,
+				})
+			}
+		case "ClosedEvent":
+			closed := HistoryEvent{
+				Time:  event.Closed.CreatedAt,
+				Kind:  HistoryClosed,
+				Actor: actorLogin(event.Closed.Actor),
+			}
+			if event.Closed.StateReason != nil {
+				closed.Detail = *event.Closed.StateReason
+			}
+			history = append(history, closed)
+		case "ReopenedEvent":
+			history = append(history, HistoryEvent{
+				Time:  event.Reopened.CreatedAt,
+				Kind:  HistoryReopened,
+				Actor: actorLogin(event.Reopened.Actor),
+			})
+		case "MergedEvent":
+			history = append(history, HistoryEvent{
+				Time:  event.Merged.CreatedAt,
+				Kind:  HistoryMerged,
+				Actor: actorLogin(event.Merged.Actor),
+			})
+		}
+	}
+
+	return history
+}
+
+// SortHistoryEvents sorts history events chronologically, keeping the order of simultaneous events
+func SortHistoryEvents(events []HistoryEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+}
+
+// FormatHistoryEvent renders the change described by a history event
+func FormatHistoryEvent(event *HistoryEvent) string {
+	if event.Field != "" {
+		from := event.From
+		if from == "" {
+			from = "(none)"
+		}
+		to := event.To
+		if to == "" {
+			to = "(none)"
+		}
+		return fmt.Sprintf("%s: %s → %s", event.Field, from, to)
+	}
+
+	return event.Detail
+}
+
+func actorLogin(actor *graphql.TimelineActor) string {
+	if actor == nil {
+		return ""
+	}
+	return actor.Login
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func testTimelineEvents() []graphql.TimelineEvent {
+	base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	project := &graphql.TimelineProject{ID: "PVT_1", Title: "Roadmap"}
+	other := &graphql.TimelineProject{ID: "PVT_2", Title: "Other"}
+	reason := "COMPLETED"
+
+	events := make([]graphql.TimelineEvent, 5)
+
+	events[0].TypeName = "AddedToProjectV2Event"
+	events[0].AddedToProject.CreatedAt = base
+	events[0].AddedToProject.Actor = &graphql.TimelineActor{Login: "octocat"}
+	events[0].AddedToProject.Project = project
+
+	events[1].TypeName = "ProjectV2ItemStatusChangedEvent"
+	events[1].StatusChanged.CreatedAt = base.Add(2 * time.Hour)
+	events[1].StatusChanged.Actor = &graphql.TimelineActor{Login: "hubot"}
+	events[1].StatusChanged.Project = project
+	events[1].StatusChanged.PreviousStatus = "Todo"
+	events[1].StatusChanged.Status = "In Progress"
+
+	events[2].TypeName = "ProjectV2ItemStatusChangedEvent"
+	events[2].StatusChanged.CreatedAt = base.Add(time.Hour)
+	events[2].StatusChanged.Project = other
+	events[2].StatusChanged.Status = "Done"
+
+	events[3].TypeName = "ClosedEvent"
+	events[3].Closed.CreatedAt = base.Add(3 * time.Hour)
+	events[3].Closed.StateReason = &reason
+
+	events[4].TypeName = "LabeledEvent"
+
+	return events
+}
+
+func TestConvertTimelineEvents(t *testing.T) {
+	t.Run("Keeps events for the project and state changes", func(t *testing.T) {
+		history := ConvertTimelineEvents(testTimelineEvents(), "PVT_1")
+
+		assert.Len(t, history, 3)
+		assert.Equal(t, HistoryAdded, history[0].Kind)
+		assert.Equal(t, "octocat", history[0].Actor)
+		assert.Equal(t, HistoryStatus, history[1].Kind)
+		assert.Equal(t, "Todo", history[1].From)
+		assert.Equal(t, "In Progress", history[1].To)
+		assert.Equal(t, HistoryClosed, history[2].Kind)
+		assert.Equal(t, "COMPLETED", history[2].Detail)
+	})
+
+	t.Run("Events for other projects are dropped", func(t *testing.T) {
+		history := ConvertTimelineEvents(testTimelineEvents(), "PVT_2")
+
+		assert.Len(t, history, 2)
+		assert.Equal(t, "Done", history[0].To)
+		assert.Equal(t, HistoryClosed, history[1].Kind)
+	})
+
+	t.Run("Events without a project are dropped", func(t *testing.T) {
+		events := testTimelineEvents()
+		events[0].AddedToProject.Project = nil
+
+		history := ConvertTimelineEvents(events, "PVT_1")
+
+		assert.Len(t, history, 2)
+		assert.Equal(t, HistoryStatus, history[0].Kind)
+	})
+}
+
+func TestSortHistoryEvents(t *testing.T) {
+	t.Run("Sorts events chronologically", func(t *testing.T) {
+		base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+		events := []HistoryEvent{
+			{Time: base.Add(time.Hour), Kind: HistoryStatus},
+			{Time: base, Kind: HistoryAdded},
+		}
+
+		SortHistoryEvents(events)
+
+		assert.Equal(t, HistoryAdded, events[0].Kind)
+		assert.Equal(t, HistoryStatus, events[1].Kind)
+	})
+}
+
+func TestFormatHistoryEvent(t *testing.T) {
+	t.Run("Field changes show old and new values", func(t *testing.T) {
+		event := &HistoryEvent{Field: "Status", To: "Done"}
+
+		assert.Equal(t, "Status: (none) → Done", FormatHistoryEvent(event))
+	})
+
+	t.Run("Other events show their detail", func(t *testing.T) {
+		event := &HistoryEvent{Kind: HistoryAdded, Detail: "Added to project"}
+
+		assert.Equal(t, "Added to project", FormatHistoryEvent(event))
+	})
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// SortKey represents a project field used to order items
+type SortKey struct {
+	Field      *graphql.ProjectV2Field
+	Descending bool
+}
+
+// ItemMove represents a single position update. A nil AfterID moves the item to the top.
+type ItemMove struct {
+	AfterID *string
+	ItemID  string
+}
+
+// MoveItem moves an item after another item, or to the top of the project when afterID is nil
+func (s *ItemService) MoveItem(ctx context.Context, projectID, itemID string, afterID *string) error {
+	variables := graphql.BuildUpdateItemPositionVariables(graphql.UpdateItemPositionInput{
+		ProjectID: projectID,
+		ItemID:    itemID,
+		AfterID:   afterID,
+	})
+
+	var mutation graphql.UpdateItemPositionMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+
+	return nil
+}
+
+// ParseSortKeys parses a comma-separated list of field names such as "Priority,-Due".
+// A leading "-" sorts the field in descending order.
+func ParseSortKeys(fields []graphql.ProjectV2Field, spec string) ([]SortKey, error) {
+	var keys []SortKey
+
+	for _, part := range strings.Split(spec, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+
+		key := SortKey{}
+		if strings.HasPrefix(name, "-") {
+			key.Descending = true
+			name = strings.TrimSpace(name[1:])
+		}
+
+		field, err := FindProjectField(fields, name)
+		if err != nil {
+			return nil, err
+		}
+		key.Field = field
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("at least one sort field is required")
+	}
+
+	return keys, nil
+}
+
+// SortProjectItems returns the items ordered by the sort keys.
+// Items without a value for a key sort last, and ties keep their current order.
+func SortProjectItems(items []ProjectItemInfo, keys []SortKey) []ProjectItemInfo {
+	sorted := make([]ProjectItemInfo, len(items))
+	copy(sorted, items)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for _, key := range keys {
+			if cmp := compareFieldValues(key, fieldValue(&sorted[i], key.Field.Name), fieldValue(&sorted[j], key.Field.Name)); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+
+	return sorted
+}
+
+// compareFieldValues compares two formatted field values according to the field type
+func compareFieldValues(key SortKey, a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	var cmp int
+	switch key.Field.DataType {
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		cmp = optionIndex(key.Field, a) - optionIndex(key.Field, b)
+	case graphql.ProjectV2FieldDataTypeNumber:
+		cmp = compareNumbers(a, b)
+	default:
+		// Dates are formatted as YYYY-MM-DD, so they order lexically as well
+		cmp = strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+
+	if key.Descending {
+		return -cmp
+	}
+	return cmp
+}
+
+func optionIndex(field *graphql.ProjectV2Field, name string) int {
+	for i, option := range field.Options.Nodes {
+		if strings.EqualFold(option.Name, name) {
+			return i
+		}
+	}
+	return len(field.Options.Nodes)
+}
+
+func compareNumbers(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// PlanItemMoves computes the position updates needed to turn the current order into the
+// desired order. Items on the longest run already in the right relative order stay in place;
+// every other item is moved directly after its predecessor in the desired order.
+func PlanItemMoves(current, desired []string) []ItemMove {
+	position := make(map[string]int, len(current))
+	for i, id := range current {
+		position[id] = i
+	}
+
+	stable := longestOrderedRun(desired, position)
+
+	var moves []ItemMove
+	for i, id := range desired {
+		if stable[id] {
+			continue
+		}
+
+		move := ItemMove{ItemID: id}
+		if i > 0 {
+			afterID := desired[i-1]
+			move.AfterID = &afterID
+		}
+		moves = append(moves, move)
+	}
+
+	return moves
+}
+
+// longestOrderedRun returns the largest set of desired items whose current positions are
+// already increasing, using a longest increasing subsequence over current positions
+func longestOrderedRun(desired []string, position map[string]int) map[string]bool {
+	// tails[k] holds the index in desired of the smallest tail of a run of length k+1
+	var tails []int
+	parent := make([]int, len(desired))
+
+	for i, id := range desired {
+		pos := position[id]
+		k := sort.Search(len(tails), func(k int) bool {
+			return position[desired[tails[k]]] >= pos
+		})
+
+		parent[i] = -1
+		if k > 0 {
+			parent[i] = tails[k-1]
+		}
+
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	stable := make(map[string]bool, len(tails))
+	if len(tails) == 0 {
+		return stable
+	}
+
+	for i := tails[len(tails)-1]; i >= 0; i = parent[i] {
+		stable[desired[i]] = true
+	}
+
+	return stable
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func applyItemMoves(order []string, moves []ItemMove) []string {
+	for _, move := range moves {
+		var rest []string
+		for _, id := range order {
+			if id != move.ItemID {
+				rest = append(rest, id)
+			}
+		}
+
+		if move.AfterID == nil {
+			order = append([]string{move.ItemID}, rest...)
+			continue
+		}
+
+		order = nil
+		for _, id := range rest {
+			order = append(order, id)
+			if id == *move.AfterID {
+				order = append(order, move.ItemID)
+			}
+		}
+	}
+	return order
+}
+
+func TestParseSortKeys(t *testing.T) {
+	t.Run("Parse ascending and descending keys", func(t *testing.T) {
+		keys, err := ParseSortKeys(testProjectFields(), "Status, -due")
+
+		assert.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.Equal(t, "Status", keys[0].Field.Name)
+		assert.False(t, keys[0].Descending)
+		assert.Equal(t, "Due", keys[1].Field.Name)
+		assert.True(t, keys[1].Descending)
+	})
+
+	t.Run("Unknown field returns error", func(t *testing.T) {
+		_, err := ParseSortKeys(testProjectFields(), "Priority")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "field 'Priority' not found")
+	})
+
+	t.Run("Empty spec returns error", func(t *testing.T) {
+		_, err := ParseSortKeys(testProjectFields(), " , ")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one sort field is required")
+	})
+}
+
+func TestSortProjectItems(t *testing.T) {
+	items := []ProjectItemInfo{
+		{ItemID: "a", FieldValues: map[string]string{"Status": "Done", "Due": "2024-03-01"}},
+		{ItemID: "b", FieldValues: map[string]string{}},
+		{ItemID: "c", FieldValues: map[string]string{"Status": "Todo", "Due": "2024-05-01"}},
+		{ItemID: "d", FieldValues: map[string]string{"Status": "Todo", "Due": "2024-01-01"}},
+	}
+
+	ids := func(sorted []ProjectItemInfo) []string {
+		result := make([]string, len(sorted))
+		for i := range sorted {
+			result[i] = sorted[i].ItemID
+		}
+		return result
+	}
+
+	t.Run("Single select sorts by option order with empty values last", func(t *testing.T) {
+		keys, err := ParseSortKeys(testProjectFields(), "Status,Due")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"d", "c", "a", "b"}, ids(SortProjectItems(items, keys)))
+	})
+
+	t.Run("Descending keys keep empty values last", func(t *testing.T) {
+		keys, err := ParseSortKeys(testProjectFields(), "-Due")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"c", "a", "d", "b"}, ids(SortProjectItems(items, keys)))
+	})
+
+	t.Run("Numbers sort numerically", func(t *testing.T) {
+		field := graphql.ProjectV2Field{Name: "Estimate", DataType: graphql.ProjectV2FieldDataTypeNumber}
+		numbered := []ProjectItemInfo{
+			{ItemID: "x", FieldValues: map[string]string{"Estimate": "10"}},
+			{ItemID: "y", FieldValues: map[string]string{"Estimate": "2"}},
+		}
+
+		sorted := SortProjectItems(numbered, []SortKey{{Field: &field}})
+		assert.Equal(t, []string{"y", "x"}, ids(sorted))
+	})
+}
+
+func TestPlanItemMoves(t *testing.T) {
+	t.Run("No moves when already ordered", func(t *testing.T) {
+		order := []string{"a", "b", "c"}
+
+		assert.Empty(t, PlanItemMoves(order, order))
+	})
+
+	t.Run("Single out-of-place item needs one move", func(t *testing.T) {
+		current := []string{"b", "c", "d", "a"}
+		desired := []string{"a", "b", "c", "d"}
+
+		moves := PlanItemMoves(current, desired)
+
+		assert.Len(t, moves, 1)
+		assert.Equal(t, "a", moves[0].ItemID)
+		assert.Nil(t, moves[0].AfterID)
+		assert.Equal(t, desired, applyItemMoves(current, moves))
+	})
+
+	t.Run("Reversed order is fully applied", func(t *testing.T) {
+		current := []string{"e", "d", "c", "b", "a"}
+		desired := []string{"a", "b", "c", "d", "e"}
+
+		moves := PlanItemMoves(current, desired)
+
+		assert.Len(t, moves, 4)
+		assert.Equal(t, desired, applyItemMoves(current, moves))
+	})
+
+	t.Run("Mixed order uses minimal moves", func(t *testing.T) {
+		current := []string{"c", "a", "e", "b", "d", "f"}
+		desired := []string{"a", "b", "c", "d", "e", "f"}
+
+		moves := PlanItemMoves(current, desired)
+
+		assert.Len(t, moves, 2)
+		assert.Equal(t, desired, applyItemMoves(current, moves))
+	})
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// FieldValueInfo represents a typed field value of a project item
+type FieldValueInfo struct {
+	Value interface{}                    `json:"value"`
+	Name  string                         `json:"name"`
+	Type  graphql.ProjectV2FieldDataType `json:"type"`
+}
+
+// ProjectMembership represents an issue or pull request's item in one project
+type ProjectMembership struct {
+	ProjectID     string           `json:"projectId"`
+	ProjectTitle  string           `json:"projectTitle"`
+	ProjectOwner  string           `json:"projectOwner"`
+	ProjectURL    string           `json:"projectUrl"`
+	ItemID        string           `json:"itemId"`
+	Fields        []FieldValueInfo `json:"fields"`
+	ProjectNumber int              `json:"projectNumber"`
+	Archived      bool             `json:"archived"`
+}
+
+// GetProjectMemberships retrieves the projects an issue or pull request belongs to,
+// including archived items, with the item's field values in each project
+func (s *ItemService) GetProjectMemberships(ctx context.Context, owner, repo string, number int, contentType string) ([]ProjectMembership, error) {
+	variables := graphql.BuildGetIssueVariables(owner, repo, number)
+
+	switch contentType {
+	case "Issue":
+		var query graphql.GetIssueProjectItemsQuery
+		if err := s.client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to get project items: %w", err)
+		}
+		return ConvertContentProjectItems(query.Repository.Issue.ProjectItems.Nodes), nil
+	case "PullRequest":
+		var query graphql.GetPullRequestProjectItemsQuery
+		if err := s.client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to get project items: %w", err)
+		}
+		return ConvertContentProjectItems(query.Repository.PullRequest.ProjectItems.Nodes), nil
+	default:
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+}
+
+// ConvertContentProjectItems converts GraphQL content project items into ProjectMembership values
+func ConvertContentProjectItems(items []graphql.ContentProjectItem) []ProjectMembership {
+	memberships := make([]ProjectMembership, len(items))
+
+	for i := range items {
+		item := &items[i]
+
+		owner := item.Project.Owner.Organization.Login
+		if owner == "" {
+			owner = item.Project.Owner.User.Login
+		}
+
+		memberships[i] = ProjectMembership{
+			ProjectID:     item.Project.ID,
+			ProjectTitle:  item.Project.Title,
+			ProjectOwner:  owner,
+			ProjectURL:    item.Project.URL,
+			ProjectNumber: item.Project.Number,
+			ItemID:        item.ID,
+			Archived:      item.IsArchived,
+			Fields:        ConvertFieldValues(item.FieldValues.Nodes),
+		}
+	}
+
+	return memberships
+}
+
+// ConvertFieldValues converts GraphQL field values into typed FieldValueInfo values,
+// skipping values that are empty or belong to unsupported field types
+func ConvertFieldValues(values []graphql.ProjectV2ItemFieldValue) []FieldValueInfo {
+	infos := make([]FieldValueInfo, 0, len(values))
+
+	for i := range values {
+		value := &values[i]
+		if value.Field.Name == "" {
+			continue
+		}
+
+		info := FieldValueInfo{Name: value.Field.Name}
+		switch {
+		case value.TextValue != nil:
+			info.Type = graphql.ProjectV2FieldDataTypeText
+			info.Value = *value.TextValue
+		case value.NumberValue != nil:
+			info.Type = graphql.ProjectV2FieldDataTypeNumber
+			info.Value = *value.NumberValue
+		case value.DateValue != nil:
+			info.Type = graphql.ProjectV2FieldDataTypeDate
+			info.Value = value.DateValue.Format(dateLayout)
+		case value.SingleSelectValue != nil:
+			info.Type = graphql.ProjectV2FieldDataTypeSingleSelect
+			info.Value = value.SingleSelectValue.Name
+		case value.IterationValue != nil:
+			info.Type = graphql.ProjectV2FieldDataTypeIteration
+			info.Value = value.IterationValue.Title
+		default:
+			continue
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+// FilterMembershipsByProject returns the memberships of the project given as owner/number
+func FilterMembershipsByProject(memberships []ProjectMembership, projectRef string) ([]ProjectMembership, error) {
+	owner, number, err := ParseProjectReference(projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []ProjectMembership
+	for i := range memberships {
+		if memberships[i].ProjectNumber == number && strings.EqualFold(memberships[i].ProjectOwner, owner) {
+			filtered = append(filtered, memberships[i])
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+func TestConvertFieldValues(t *testing.T) {
+	t.Run("Values keep their field types", func(t *testing.T) {
+		estimate := 5.0
+		due := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+		values := []graphql.ProjectV2ItemFieldValue{
+			{NumberValue: &estimate},
+			{DateValue: &due},
+			{},
+		}
+		values[0].Field.Name = "Estimate"
+		values[1].Field.Name = "Due"
+		values[2].Field.Name = "Empty"
+
+		infos := ConvertFieldValues(values)
+
+		assert.Len(t, infos, 2)
+		assert.Equal(t, FieldValueInfo{Name: "Estimate", Type: graphql.ProjectV2FieldDataTypeNumber, Value: 5.0}, infos[0])
+		assert.Equal(t, FieldValueInfo{Name: "Due", Type: graphql.ProjectV2FieldDataTypeDate, Value: "2024-12-31"}, infos[1])
+	})
+}
+
+func TestConvertContentProjectItems(t *testing.T) {
+	item := graphql.ContentProjectItem{ID: "item-1", IsArchived: true}
+	item.Project.ID = "project-1"
+	item.Project.Title = "Roadmap"
+	item.Project.Number = 5
+	item.Project.Owner.Organization.Login = "myorg"
+
+	userItem := graphql.ContentProjectItem{ID: "item-2"}
+	userItem.Project.Number = 1
+	userItem.Project.Owner.User.Login = "octocat"
+
+	memberships := ConvertContentProjectItems([]graphql.ContentProjectItem{item, userItem})
+
+	t.Run("Converts organization and user projects", func(t *testing.T) {
+		assert.Len(t, memberships, 2)
+		assert.Equal(t, "myorg", memberships[0].ProjectOwner)
+		assert.Equal(t, "Roadmap", memberships[0].ProjectTitle)
+		assert.True(t, memberships[0].Archived)
+		assert.Equal(t, "octocat", memberships[1].ProjectOwner)
+	})
+
+	t.Run("Filter memberships by project reference", func(t *testing.T) {
+		filtered, err := FilterMembershipsByProject(memberships, "MyOrg/5")
+
+		assert.NoError(t, err)
+		assert.Len(t, filtered, 1)
+		assert.Equal(t, "item-1", filtered[0].ItemID)
+	})
+
+	t.Run("Invalid project reference returns error", func(t *testing.T) {
+		_, err := FilterMembershipsByProject(memberships, "myorg")
+
+		assert.Error(t, err)
+	})
+}
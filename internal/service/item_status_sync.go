@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// Content states used in status mappings
+const (
+	SyncStateOpen   = "open"
+	SyncStateClosed = "closed"
+	SyncStateMerged = "merged"
+)
+
+// StatusMapping maps issue and pull request states to status values.
+// An empty value leaves items in that state alone. Open only applies to items
+// marked with the closed or merged status, such as reopened issues.
+type StatusMapping struct {
+	Open   string
+	Closed string
+	Merged string
+}
+
+// StatusChange is a status update needed to bring an item in line with its content state
+type StatusChange struct {
+	Item  ProjectItemInfo
+	State string
+	From  string
+	To    string
+}
+
+// DefaultStatusMapping returns the mapping for GitHub's default Todo/In Progress/Done statuses
+func DefaultStatusMapping() StatusMapping {
+	return StatusMapping{
+		Open:   "Todo",
+		Closed: "Done",
+		Merged: "Done",
+	}
+}
+
+// ParseStatusMapping applies "state=Value" entries (open, closed, merged) to the default mapping.
+// An empty value disables syncing for that state.
+func ParseStatusMapping(entries []string) (StatusMapping, error) {
+	mapping := DefaultStatusMapping()
+
+	for _, entry := range entries {
+		state, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return mapping, fmt.Errorf("invalid status mapping: %s (expected state=Value)", entry)
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(state)) {
+		case SyncStateOpen:
+			mapping.Open = value
+		case SyncStateClosed:
+			mapping.Closed = value
+		case SyncStateMerged:
+			mapping.Merged = value
+		default:
+			return mapping, fmt.Errorf("unknown state in status mapping: %s (expected open, closed or merged)", state)
+		}
+	}
+
+	return mapping, nil
+}
+
+// ValidateStatusMapping checks that the mapped values are options of the status field
+func ValidateStatusMapping(fields []graphql.ProjectV2Field, statusField string, mapping StatusMapping) error {
+	for _, value := range []string{mapping.Open, mapping.Closed, mapping.Merged} {
+		if value == "" {
+			continue
+		}
+		if _, err := ResolveFieldValues(fields, []string{statusField + "=" + value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PlanStatusSync returns the status changes needed for items whose status does not
+// match the state of their issue or pull request. Draft issues are never changed.
+func PlanStatusSync(items []ProjectItemInfo, statusField string, mapping StatusMapping) []StatusChange {
+	var changes []StatusChange
+
+	for i := range items {
+		item := &items[i]
+		if item.Type == "DraftIssue" {
+			continue
+		}
+
+		current := fieldValue(item, statusField)
+		state, target := syncTarget(item.State, current, mapping)
+		if target == "" || strings.EqualFold(current, target) {
+			continue
+		}
+
+		changes = append(changes, StatusChange{
+			Item:  *item,
+			State: state,
+			From:  current,
+			To:    target,
+		})
+	}
+
+	return changes
+}
+
+// syncTarget returns the content state and the status it maps to, or "" if the status should be left alone
+func syncTarget(itemState, current string, mapping StatusMapping) (state, target string) {
+	switch itemState {
+	case "CLOSED":
+		return SyncStateClosed, mapping.Closed
+	case "MERGED":
+		return SyncStateMerged, mapping.Merged
+	case "OPEN":
+		if current != "" && (strings.EqualFold(current, mapping.Closed) || strings.EqualFold(current, mapping.Merged)) {
+			return SyncStateOpen, mapping.Open
+		}
+	}
+	return "", ""
+}
+
+// ApplyStatusSync updates the status field of each item, continuing past failures
+func (s *ItemService) ApplyStatusSync(ctx context.Context, project *graphql.ProjectV2, statusField string, changes []StatusChange) *BulkUpdateResult {
+	result := &BulkUpdateResult{}
+	resolved := make(map[string]map[string]interface{})
+
+	for i := range changes {
+		change := &changes[i]
+
+		values, ok := resolved[change.To]
+		if !ok {
+			var err error
+			values, err = ResolveFieldValues(project.Fields.Nodes, []string{statusField + "=" + change.To})
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemLabel(&change.Item), err))
+				result.Failed++
+				continue
+			}
+			resolved[change.To] = values
+		}
+
+		if err := s.SetItemFieldValues(ctx, project.ID, change.Item.ItemID, values); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", itemLabel(&change.Item), err))
+			result.Failed++
+			continue
+		}
+
+		result.Updated++
+	}
+
+	return result
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testStatusSyncItems() []ProjectItemInfo {
+	return []ProjectItemInfo{
+		{ItemID: "closed-todo", Type: "Issue", State: "CLOSED", FieldValues: map[string]string{"Status": "Todo"}},
+		{ItemID: "closed-done", Type: "Issue", State: "CLOSED", FieldValues: map[string]string{"Status": "done"}},
+		{ItemID: "merged-none", Type: "PullRequest", State: "MERGED", FieldValues: map[string]string{}},
+		{ItemID: "reopened", Type: "Issue", State: "OPEN", FieldValues: map[string]string{"Status": "Done"}},
+		{ItemID: "open-progress", Type: "Issue", State: "OPEN", FieldValues: map[string]string{"Status": "In Progress"}},
+		{ItemID: "open-none", Type: "PullRequest", State: "OPEN", FieldValues: map[string]string{}},
+		{ItemID: "draft", Type: "DraftIssue", State: "DRAFT", FieldValues: map[string]string{"Status": "Done"}},
+	}
+}
+
+func statusChangeIDs(changes []StatusChange) []string {
+	ids := make([]string, len(changes))
+	for i := range changes {
+		ids[i] = changes[i].Item.ItemID
+	}
+	return ids
+}
+
+func TestParseStatusMapping(t *testing.T) {
+	t.Run("Defaults to Todo and Done", func(t *testing.T) {
+		mapping, err := ParseStatusMapping(nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultStatusMapping(), mapping)
+	})
+
+	t.Run("Entries override and disable states", func(t *testing.T) {
+		mapping, err := ParseStatusMapping([]string{"Merged=Shipped", "open="})
+
+		assert.NoError(t, err)
+		assert.Equal(t, StatusMapping{Closed: "Done", Merged: "Shipped"}, mapping)
+	})
+
+	t.Run("Invalid entries return errors", func(t *testing.T) {
+		_, err := ParseStatusMapping([]string{"closed"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expected state=Value")
+
+		_, err = ParseStatusMapping([]string{"draft=Todo"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown state")
+	})
+}
+
+func TestValidateStatusMapping(t *testing.T) {
+	t.Run("Mapped values must be status options", func(t *testing.T) {
+		assert.NoError(t, ValidateStatusMapping(testProjectFields(), "Status", DefaultStatusMapping()))
+
+		err := ValidateStatusMapping(testProjectFields(), "Status", StatusMapping{Closed: "Shipped"})
+		assert.Error(t, err)
+	})
+}
+
+func TestPlanStatusSync(t *testing.T) {
+	t.Run("Only out-of-sync items are changed", func(t *testing.T) {
+		changes := PlanStatusSync(testStatusSyncItems(), "Status", DefaultStatusMapping())
+
+		assert.Equal(t, []string{"closed-todo", "merged-none", "reopened"}, statusChangeIDs(changes))
+		assert.Equal(t, StatusChange{
+			Item:  testStatusSyncItems()[0],
+			State: SyncStateClosed,
+			From:  "Todo",
+			To:    "Done",
+		}, changes[0])
+		assert.Equal(t, SyncStateMerged, changes[1].State)
+		assert.Equal(t, "", changes[1].From)
+		assert.Equal(t, SyncStateOpen, changes[2].State)
+		assert.Equal(t, "Todo", changes[2].To)
+	})
+
+	t.Run("Disabled states are left alone", func(t *testing.T) {
+		changes := PlanStatusSync(testStatusSyncItems(), "Status", StatusMapping{Closed: "Done"})
+
+		assert.Equal(t, []string{"closed-todo"}, statusChangeIDs(changes))
+	})
+
+	t.Run("Applying the changes leaves nothing to sync", func(t *testing.T) {
+		items := testStatusSyncItems()
+		for _, change := range PlanStatusSync(items, "Status", DefaultStatusMapping()) {
+			for i := range items {
+				if items[i].ItemID == change.Item.ItemID {
+					items[i].FieldValues["Status"] = change.To
+				}
+			}
+		}
+
+		assert.Empty(t, PlanStatusSync(items, "Status", DefaultStatusMapping()))
+	})
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ItemStreamEntry is an item reference read from an input stream.
+// Line is the input line, or the element position for a JSON array.
+type ItemStreamEntry struct {
+	Ref  string
+	Line int
+}
+
+// ParseItemStream reads item references from a stream.
+//
+// Each line may be an issue or pull request URL, an owner/repo#number reference,
+// a node ID, or a JSON object such as those printed by "item list --format json".
+// A single JSON array of such objects is also accepted. Blank lines and lines
+// starting with "#" are skipped. Entries that cannot be understood are returned
+// as per-line errors so the remaining entries can still be processed.
+func ParseItemStream(r io.Reader) ([]ItemStreamEntry, []string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return parseItemArray(trimmed)
+	}
+
+	var entries []ItemStreamEntry
+	var failures []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "{") {
+			entries = append(entries, ItemStreamEntry{Ref: line, Line: lineNumber})
+			continue
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &object); err != nil {
+			failures = append(failures, fmt.Sprintf("line %d: invalid JSON: %v", lineNumber, err))
+			continue
+		}
+
+		ref, err := itemRefFromJSON(object)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("line %d: %v", lineNumber, err))
+			continue
+		}
+		entries = append(entries, ItemStreamEntry{Ref: ref, Line: lineNumber})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to read input: %w", err)
+	}
+
+	return entries, failures, nil
+}
+
+func parseItemArray(data []byte) ([]ItemStreamEntry, []string, error) {
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON array: %w", err)
+	}
+
+	var entries []ItemStreamEntry
+	var failures []string
+
+	for i, object := range objects {
+		ref, err := itemRefFromJSON(object)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("item %d: %v", i+1, err))
+			continue
+		}
+		entries = append(entries, ItemStreamEntry{Ref: ref, Line: i + 1})
+	}
+
+	return entries, failures, nil
+}
+
+// itemRefFromJSON picks the most specific issue or pull request reference from a JSON object
+func itemRefFromJSON(object map[string]interface{}) (string, error) {
+	if contentID, ok := object["contentId"].(string); ok && contentID != "" {
+		return contentID, nil
+	}
+
+	if url, ok := object["url"].(string); ok && url != "" {
+		return url, nil
+	}
+
+	repository, hasRepository := object["repository"].(string)
+	number, hasNumber := object["number"].(float64)
+	if hasRepository && hasNumber && repository != "" {
+		return fmt.Sprintf("%s#%d", repository, int(number)), nil
+	}
+
+	if id, ok := object["id"].(string); ok && IsContentNodeID(id) {
+		return id, nil
+	}
+
+	return "", fmt.Errorf("no issue or pull request reference in JSON object")
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseItemStream(t *testing.T) {
+	t.Run("Reads references, URLs and node IDs line by line", func(t *testing.T) {
+		input := `octocat/api#1
+
+# comment
+https://github.com/octocat/api/pull/2
+I_kwDOABC123
+`
+		entries, failures, err := ParseItemStream(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Empty(t, failures)
+		assert.Equal(t, []ItemStreamEntry{
+			{Ref: "octocat/api#1", Line: 1},
+			{Ref: "https://github.com/octocat/api/pull/2", Line: 4},
+			{Ref: "I_kwDOABC123", Line: 5},
+		}, entries)
+	})
+
+	t.Run("Reads JSON lines", func(t *testing.T) {
+		input := `{"id":"PVTI_1","contentId":"I_kwDO1","title":"Fix"}
+{"type":"Issue","repository":"octocat/api","number":7}
+{"type":"DraftIssue","id":"PVTI_2"}
+{not json`
+		entries, failures, err := ParseItemStream(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []ItemStreamEntry{
+			{Ref: "I_kwDO1", Line: 1},
+			{Ref: "octocat/api#7", Line: 2},
+		}, entries)
+		assert.Len(t, failures, 2)
+		assert.Contains(t, failures[0], "line 3: no issue or pull request reference")
+		assert.Contains(t, failures[1], "line 4: invalid JSON")
+	})
+
+	t.Run("Reads a JSON array as printed by item list", func(t *testing.T) {
+		input := `[
+  {
+    "id": "I_kwDO1",
+    "url": "https://github.com/octocat/api/issues/1",
+    "title": "Fix"
+  },
+  {
+    "id": "PVTI_2"
+  }
+]`
+		entries, failures, err := ParseItemStream(strings.NewReader(input))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []ItemStreamEntry{{Ref: "https://github.com/octocat/api/issues/1", Line: 1}}, entries)
+		assert.Equal(t, []string{"item 2: no issue or pull request reference in JSON object"}, failures)
+	})
+
+	t.Run("Invalid JSON array returns error", func(t *testing.T) {
+		_, _, err := ParseItemStream(strings.NewReader("[{"))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JSON array")
+	})
+}
+
+func TestIsContentNodeID(t *testing.T) {
+	t.Run("Issue and pull request node IDs", func(t *testing.T) {
+		assert.True(t, IsContentNodeID("I_kwDOABC123"))
+		assert.True(t, IsContentNodeID("PR_kwDOABC123"))
+	})
+
+	t.Run("Other references are not node IDs", func(t *testing.T) {
+		assert.False(t, IsContentNodeID("PVTI_lADOANN5s84"))
+		assert.False(t, IsContentNodeID("octocat/api#1"))
+	})
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// SweepConfig is a set of policies applied to a project by a sweep.
+//
+// Example policy file:
+//
+//	policies:
+//	  - name: stale-in-progress
+//	    filter: status:"In Progress" updated:<@today-14d
+//	    actions:
+//	      set:
+//	        Status: Todo
+//	      labels: [stale]
+//	      comment: No activity for two weeks, moving back to Todo.
+//	  - name: archive-done
+//	    filter: status:Done updated:<@today-30d
+//	    actions:
+//	      archive: true
+type SweepConfig struct {
+	Policies []SweepPolicy `json:"policies" yaml:"policies"`
+}
+
+// SweepPolicy selects items with a filter and applies actions to them
+type SweepPolicy struct {
+	Name    string       `json:"name" yaml:"name"`
+	Filter  string       `json:"filter" yaml:"filter"`
+	Actions SweepActions `json:"actions" yaml:"actions"`
+}
+
+// SweepActions describes the changes a policy makes to each matching item.
+// Labels and comments apply to the underlying issue or pull request. The comment
+// is only posted along with another change, so repeated sweeps do not repeat it.
+type SweepActions struct {
+	Set     map[string]string `json:"set,omitempty" yaml:"set,omitempty"`
+	Comment string            `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Labels  []string          `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Archive bool              `json:"archive,omitempty" yaml:"archive,omitempty"`
+}
+
+// SweepEntry is an item selected by a policy with the actions still needed for it.
+// Field values and labels already in place are left out; Skipped lists actions that
+// cannot apply to the item.
+type SweepEntry struct {
+	Set     map[string]string
+	Policy  string
+	Comment string
+	Item    ProjectItemInfo
+	Labels  []string
+	Skipped []string
+	Archive bool
+	policy  int
+}
+
+// SweepSummary counts a policy's matches and changes
+type SweepSummary struct {
+	Policy  string
+	Matched int
+	Planned int
+	Applied int
+	Failed  int
+}
+
+// SweepPlan is the set of changes a sweep would make
+type SweepPlan struct {
+	Entries   []SweepEntry
+	Summaries []SweepSummary
+}
+
+// SweepResult represents the result of applying a sweep plan
+type SweepResult struct {
+	Errors  []string
+	Applied int
+	Failed  int
+}
+
+// LoadSweepConfig reads sweep policies from a YAML or JSON file
+func LoadSweepConfig(path string) (*SweepConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	return ParseSweepConfig(data)
+}
+
+// ParseSweepConfig parses and validates sweep policies
+func ParseSweepConfig(data []byte) (*SweepConfig, error) {
+	config := &SweepConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file: %w", err)
+	}
+
+	if len(config.Policies) == 0 {
+		return nil, fmt.Errorf("policy file defines no policies")
+	}
+
+	names := make(map[string]bool, len(config.Policies))
+	for i := range config.Policies {
+		policy := &config.Policies[i]
+		if policy.Name == "" {
+			return nil, fmt.Errorf("policy %d has no name", i+1)
+		}
+		if names[policy.Name] {
+			return nil, fmt.Errorf("duplicate policy name: %s", policy.Name)
+		}
+		names[policy.Name] = true
+
+		if strings.TrimSpace(policy.Filter) == "" {
+			return nil, fmt.Errorf("policy %s has no filter", policy.Name)
+		}
+		if _, err := ParseItemFilter(policy.Filter); err != nil {
+			return nil, fmt.Errorf("policy %s has an invalid filter: %w", policy.Name, err)
+		}
+
+		actions := &policy.Actions
+		if len(actions.Set) == 0 && len(actions.Labels) == 0 && actions.Comment == "" && !actions.Archive {
+			return nil, fmt.Errorf("policy %s has no actions", policy.Name)
+		}
+		if len(actions.Set) == 0 && len(actions.Labels) == 0 && !actions.Archive {
+			return nil, fmt.Errorf("policy %s only comments; add a label, field value or archive so repeated sweeps skip handled items", policy.Name)
+		}
+	}
+
+	return config, nil
+}
+
+// ValidateSweepConfig checks that the fields and values set by the policies exist in the project
+func ValidateSweepConfig(config *SweepConfig, fields []graphql.ProjectV2Field) error {
+	for i := range config.Policies {
+		policy := &config.Policies[i]
+		for name := range policy.Actions.Set {
+			if IsReadOnlyField(name) {
+				return fmt.Errorf("policy %s sets read-only field %s", policy.Name, name)
+			}
+		}
+
+		if _, err := ResolveFieldValues(fields, FormatFieldAssignments(policy.Actions.Set)); err != nil {
+			return fmt.Errorf("policy %s: %w", policy.Name, err)
+		}
+	}
+	return nil
+}
+
+// PlanSweep matches items against each policy in order. Every policy sees the items
+// as they were before the sweep, so an item can be selected by several policies.
+func PlanSweep(items []ProjectItemInfo, config *SweepConfig) (*SweepPlan, error) {
+	plan := &SweepPlan{Summaries: make([]SweepSummary, len(config.Policies))}
+
+	for i := range config.Policies {
+		policy := &config.Policies[i]
+		plan.Summaries[i].Policy = policy.Name
+
+		filter, err := ParseItemFilter(policy.Filter)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s has an invalid filter: %w", policy.Name, err)
+		}
+
+		for _, item := range FilterProjectItems(items, filter) {
+			plan.Summaries[i].Matched++
+
+			entry := planSweepEntry(policy, item)
+			if len(entry.Set) == 0 && len(entry.Labels) == 0 && entry.Comment == "" && !entry.Archive {
+				continue
+			}
+
+			entry.policy = i
+			plan.Entries = append(plan.Entries, entry)
+			plan.Summaries[i].Planned++
+		}
+	}
+
+	return plan, nil
+}
+
+func planSweepEntry(policy *SweepPolicy, item ProjectItemInfo) SweepEntry {
+	entry := SweepEntry{
+		Policy:  policy.Name,
+		Item:    item,
+		Archive: policy.Actions.Archive && !item.Archived,
+	}
+
+	for name, value := range policy.Actions.Set {
+		if strings.EqualFold(fieldValue(&item, name), value) {
+			continue
+		}
+		if entry.Set == nil {
+			entry.Set = make(map[string]string)
+		}
+		entry.Set[name] = value
+	}
+
+	hasContent := item.ContentID != "" && item.Repository != nil
+
+	for _, label := range policy.Actions.Labels {
+		if containsFold(item.Labels, label) {
+			continue
+		}
+		if !hasContent {
+			entry.Skipped = append(entry.Skipped, fmt.Sprintf("label %s: draft issues have no labels", label))
+			continue
+		}
+		entry.Labels = append(entry.Labels, label)
+	}
+
+	// Without another change the policy was already applied, and commenting again would repeat it
+	if policy.Actions.Comment != "" && (len(entry.Set) > 0 || len(entry.Labels) > 0 || entry.Archive) {
+		if hasContent {
+			entry.Comment = policy.Actions.Comment
+		} else {
+			entry.Skipped = append(entry.Skipped, "comment: draft issues cannot be commented on")
+		}
+	}
+
+	return entry
+}
+
+// DescribeSweepEntry lists the actions of a sweep entry in the order they are applied
+func DescribeSweepEntry(entry *SweepEntry) []string {
+	var actions []string
+
+	names := make([]string, 0, len(entry.Set))
+	for name := range entry.Set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		actions = append(actions, fmt.Sprintf("set %s: %s → %s", name, displayValue(fieldValue(&entry.Item, name)), entry.Set[name]))
+	}
+
+	if len(entry.Labels) > 0 {
+		actions = append(actions, "add labels: "+strings.Join(entry.Labels, ", "))
+	}
+	if entry.Comment != "" {
+		actions = append(actions, "comment")
+	}
+	if entry.Archive {
+		actions = append(actions, "archive")
+	}
+
+	return actions
+}
+
+// ApplySweep applies a sweep plan, continuing past failures.
+// Per-policy counts are recorded in the plan's summaries.
+func (s *ItemService) ApplySweep(ctx context.Context, project *graphql.ProjectV2, plan *SweepPlan) *SweepResult {
+	result := &SweepResult{}
+	issueService := NewIssueService(s.client)
+
+	for i := range plan.Entries {
+		entry := &plan.Entries[i]
+		summary := &plan.Summaries[entry.policy]
+
+		if err := s.applySweepEntry(ctx, issueService, project, entry); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %s: %v", entry.Policy, itemLabel(&entry.Item), err))
+			result.Failed++
+			summary.Failed++
+			continue
+		}
+
+		result.Applied++
+		summary.Applied++
+	}
+
+	return result
+}
+
+func (s *ItemService) applySweepEntry(ctx context.Context, issueService *IssueService, project *graphql.ProjectV2, entry *SweepEntry) error {
+	item := &entry.Item
+
+	if len(entry.Set) > 0 {
+		values, err := ResolveFieldValues(project.Fields.Nodes, FormatFieldAssignments(entry.Set))
+		if err != nil {
+			return err
+		}
+		if err := s.SetItemFieldValues(ctx, project.ID, item.ItemID, values); err != nil {
+			return err
+		}
+	}
+
+	if len(entry.Labels) > 0 {
+		targets, _ := IssueTargetsFromProjectItems([]ProjectItemInfo{*item})
+		if len(targets) == 0 {
+			return fmt.Errorf("item has no issue or pull request to label")
+		}
+		if err := issueService.ApplyEdit(ctx, targets[0], &IssueEdit{AddLabels: entry.Labels}); err != nil {
+			return err
+		}
+	}
+
+	if entry.Comment != "" {
+		if err := issueService.AddComment(ctx, item.ContentID, entry.Comment); err != nil {
+			return err
+		}
+	}
+
+	if entry.Archive {
+		return s.ArchiveItem(ctx, project.ID, item.ItemID)
+	}
+
+	return nil
+}
+
+func displayValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSweepPolicies = `
+policies:
+  - name: stale
+    filter: updated:<2025-03-10
+    actions:
+      set:
+        Status: Todo
+      labels: [stale, bug]
+      comment: Still relevant?
+  - name: archive-done
+    filter: status:Done
+    actions:
+      archive: true
+`
+
+func TestParseSweepConfig(t *testing.T) {
+	t.Run("Parse policies and actions", func(t *testing.T) {
+		config, err := ParseSweepConfig([]byte(testSweepPolicies))
+
+		assert.NoError(t, err)
+		assert.Len(t, config.Policies, 2)
+		assert.Equal(t, "stale", config.Policies[0].Name)
+		assert.Equal(t, map[string]string{"Status": "Todo"}, config.Policies[0].Actions.Set)
+		assert.Equal(t, []string{"stale", "bug"}, config.Policies[0].Actions.Labels)
+		assert.True(t, config.Policies[1].Actions.Archive)
+	})
+
+	t.Run("Invalid policies return errors", func(t *testing.T) {
+		tests := []struct {
+			data    string
+			message string
+		}{
+			{"policies: []", "no policies"},
+			{"policies: [{filter: status:Done, actions: {archive: true}}]", "has no name"},
+			{"policies: [{name: a, actions: {archive: true}}]", "has no filter"},
+			{"policies: [{name: a, filter: status:Done}]", "has no actions"},
+			{"policies: [{name: a, filter: status:Done, actions: {comment: hello}}]", "only comments"},
+			{"policies: [{name: a, filter: 'updated:<@today-2x', actions: {archive: true}}]", "invalid filter"},
+			{"policies: [{name: a, filter: status:Done, actions: {archive: true}}, {name: a, filter: status:Todo, actions: {archive: true}}]", "duplicate policy"},
+			{"policies: {", "failed to parse"},
+		}
+
+		for _, tt := range tests {
+			_, err := ParseSweepConfig([]byte(tt.data))
+
+			assert.Error(t, err, tt.message)
+			if err != nil {
+				assert.Contains(t, err.Error(), tt.message)
+			}
+		}
+	})
+}
+
+func TestValidateSweepConfig(t *testing.T) {
+	t.Run("Known fields and options are valid", func(t *testing.T) {
+		config, _ := ParseSweepConfig([]byte(testSweepPolicies))
+
+		assert.NoError(t, ValidateSweepConfig(config, testProjectFields()))
+	})
+
+	t.Run("Unknown option returns error", func(t *testing.T) {
+		config, _ := ParseSweepConfig([]byte(testSweepPolicies))
+		config.Policies[0].Actions.Set["Status"] = "Blocked"
+
+		err := ValidateSweepConfig(config, testProjectFields())
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "policy stale")
+	})
+
+	t.Run("Read-only field returns error", func(t *testing.T) {
+		config, _ := ParseSweepConfig([]byte(testSweepPolicies))
+		config.Policies[0].Actions.Set["Labels"] = "stale"
+
+		err := ValidateSweepConfig(config, testProjectFields())
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "read-only field")
+	})
+}
+
+func TestPlanSweep(t *testing.T) {
+	items := testProjectItems()
+	items[0].ContentID = "I_1"
+
+	config, err := ParseSweepConfig([]byte(testSweepPolicies))
+	assert.NoError(t, err)
+
+	plan, err := PlanSweep(items, config)
+	assert.NoError(t, err)
+
+	t.Run("Policies select matching items", func(t *testing.T) {
+		assert.Len(t, plan.Entries, 3)
+		assert.Equal(t, []SweepSummary{
+			{Policy: "stale", Matched: 2, Planned: 2},
+			{Policy: "archive-done", Matched: 1, Planned: 1},
+		}, plan.Summaries)
+	})
+
+	t.Run("Values already in place are skipped", func(t *testing.T) {
+		entry := plan.Entries[0]
+
+		assert.Equal(t, "item-1", entry.Item.ItemID)
+		assert.Equal(t, map[string]string{"Status": "Todo"}, entry.Set)
+		assert.Equal(t, []string{"stale"}, entry.Labels)
+		assert.Equal(t, "Still relevant?", entry.Comment)
+		assert.Empty(t, entry.Skipped)
+	})
+
+	t.Run("Labels and comments are skipped for drafts", func(t *testing.T) {
+		entry := plan.Entries[1]
+
+		assert.Equal(t, "item-3", entry.Item.ItemID)
+		assert.Empty(t, entry.Labels)
+		assert.Empty(t, entry.Comment)
+		assert.Len(t, entry.Skipped, 3)
+	})
+
+	t.Run("Comments are not repeated once the other actions are in place", func(t *testing.T) {
+		handled := testProjectItems()
+		handled[0].ContentID = "I_1"
+		handled[0].Labels = []string{"bug", "stale"}
+		handled[0].FieldValues["Status"] = "Todo"
+
+		again, planErr := PlanSweep(handled, config)
+
+		assert.NoError(t, planErr)
+		for _, entry := range again.Entries {
+			assert.NotEqual(t, "item-1", entry.Item.ItemID)
+		}
+		assert.Equal(t, 2, again.Summaries[0].Matched)
+	})
+
+	t.Run("Items needing no changes are left out", func(t *testing.T) {
+		noop, planErr := PlanSweep(items, &SweepConfig{Policies: []SweepPolicy{{
+			Name:    "done",
+			Filter:  "status:Done",
+			Actions: SweepActions{Set: map[string]string{"Status": "Done"}},
+		}}})
+
+		assert.NoError(t, planErr)
+		assert.Empty(t, noop.Entries)
+		assert.Equal(t, 1, noop.Summaries[0].Matched)
+	})
+}
+
+func TestDescribeSweepEntry(t *testing.T) {
+	t.Run("Actions are listed in order", func(t *testing.T) {
+		entry := &SweepEntry{
+			Item:    ProjectItemInfo{FieldValues: map[string]string{"Status": "In Progress"}},
+			Set:     map[string]string{"Status": "Todo", "Due": "2025-04-01"},
+			Labels:  []string{"stale"},
+			Comment: "Still relevant?",
+			Archive: true,
+		}
+
+		assert.Equal(t, []string{
+			"set Due: (none) → 2025-04-01",
+			"set Status: In Progress → Todo",
+			"add labels: stale",
+			"comment",
+			"archive",
+		}, DescribeSweepEntry(entry))
+	})
+}
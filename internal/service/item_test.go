@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/roboco-io/gh-project-cli/internal/api"
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
 )
 
 func TestItemService(t *testing.T) {
@@ -65,6 +67,24 @@ func TestItemService(t *testing.T) {
 		assert.Nil(t, prs)
 		assert.Contains(t, err.Error(), "failed to search pull requests")
 	})
+
+	t.Run("BulkUpdateItems with invalid token counts failed items", func(t *testing.T) {
+		client := api.NewClient("invalid-token")
+		service := NewItemService(client)
+
+		ctx := context.Background()
+		result, err := service.BulkUpdateItems(ctx, BulkUpdateInput{
+			ProjectID: "project-1",
+			ItemIDs:   []string{"item-1", "item-2"},
+			FieldID:   "field-status",
+			Value:     map[string]interface{}{"text": "Done"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result.Updated)
+		assert.Equal(t, 2, result.Failed)
+		assert.Len(t, result.Errors, 2)
+	})
 }
 
 func TestParseItemReference(t *testing.T) {
@@ -194,3 +214,206 @@ func TestBuildSearchQuery(t *testing.T) {
 		assert.Equal(t, "", query)
 	})
 }
+
+func TestProjectItemContentID(t *testing.T) {
+	t.Run("Returns issue ID for issue items", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{}
+		item.Content.TypeName = "Issue"
+		item.Content.IssueNode.ID = "I_123"
+
+		assert.Equal(t, "I_123", ProjectItemContentID(item))
+	})
+
+	t.Run("Returns pull request ID for PR items", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{}
+		item.Content.TypeName = "PullRequest"
+		item.Content.PRNode.ID = "PR_456"
+
+		assert.Equal(t, "PR_456", ProjectItemContentID(item))
+	})
+
+	t.Run("Returns empty string for drafts", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{}
+		item.Content.TypeName = "DraftIssue"
+
+		assert.Equal(t, "", ProjectItemContentID(item))
+	})
+}
+
+func TestConvertProjectItem(t *testing.T) {
+	t.Run("Converts issue content and field values", func(t *testing.T) {
+		text := "Backend"
+		item := &graphql.ProjectV2Item{ID: "item-1", IsArchived: true}
+		item.Content.TypeName = "Issue"
+		item.Content.IssueNode.ID = "I_123"
+		item.Content.IssueTitle = "Fix login bug"
+		item.Content.IssueState = "OPEN"
+		item.Content.IssueNumber = 42
+		item.Content.IssueRepository.Repository.NameWithOwner = "octocat/api"
+		item.Content.IssueLabels.Labels.Nodes = []struct {
+			Name string `graphql:"name"`
+		}{{Name: "bug"}}
+		item.FieldValues.Nodes = []graphql.ProjectV2ItemFieldValue{
+			{TextValue: &text},
+		}
+		item.FieldValues.Nodes[0].Field.Name = "Team"
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, "item-1", info.ItemID)
+		assert.Equal(t, "I_123", info.ContentID)
+		assert.Equal(t, "Fix login bug", info.Title)
+		assert.Equal(t, 42, *info.Number)
+		assert.Equal(t, "octocat/api", *info.Repository)
+		assert.Equal(t, []string{"bug"}, info.Labels)
+		assert.Equal(t, "Backend", info.FieldValues["Team"])
+		assert.True(t, info.Archived)
+		assert.Nil(t, info.Parent)
+	})
+
+	t.Run("Parent issue is converted to a reference", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{ID: "item-3"}
+		item.Content.TypeName = "Issue"
+		item.Content.IssueHierarchy.Parent = &graphql.ContentIssueReference{Number: 12}
+		item.Content.IssueHierarchy.Parent.Repository.NameWithOwner = "octocat/api"
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, "octocat/api#12", *info.Parent)
+	})
+
+	t.Run("Field value update times are kept", func(t *testing.T) {
+		text := "Backend"
+		updatedAt := time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC)
+		item := &graphql.ProjectV2Item{ID: "item-6"}
+		item.Content.TypeName = "DraftIssue"
+		item.FieldValues.Nodes = []graphql.ProjectV2ItemFieldValue{{TextValue: &text}}
+		item.FieldValues.Nodes[0].Field.Name = "Team"
+		item.FieldValues.Nodes[0].Common.UpdatedAt = &updatedAt
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, updatedAt, info.FieldUpdatedAt["Team"])
+	})
+
+	t.Run("Milestone title is converted", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{ID: "item-5"}
+		item.Content.TypeName = "PullRequest"
+		item.Content.PRMilestone.Milestone = &graphql.ContentMilestone{Title: "v1.2"}
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, "v1.2", *info.Milestone)
+	})
+
+	t.Run("Pull request closing issues are converted to references", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{ID: "item-4"}
+		item.Content.TypeName = "PullRequest"
+		closing := graphql.ContentIssueReference{Number: 42}
+		closing.Repository.NameWithOwner = "octocat/api"
+		item.Content.PRClosingIssues.ClosingIssuesReferences.Nodes = []graphql.ContentIssueReference{closing}
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, []string{"octocat/api#42"}, info.ClosingIssues)
+	})
+
+	t.Run("Draft issues are marked as drafts", func(t *testing.T) {
+		item := &graphql.ProjectV2Item{ID: "item-2"}
+		item.Content.TypeName = "DraftIssue"
+		item.Content.DraftTitle = "Write notes"
+
+		info := ConvertProjectItem(item)
+
+		assert.Equal(t, "Write notes", info.Title)
+		assert.Equal(t, "DRAFT", info.State)
+		assert.Nil(t, info.Number)
+	})
+}
+
+func TestNewProjectItemInfo(t *testing.T) {
+	t.Run("Describes content with its new field values", func(t *testing.T) {
+		repository := "octocat/api"
+		info := &ItemInfo{ID: "I_123", Type: "Issue", Title: "Fix login bug", State: "OPEN", Repository: &repository, Labels: []string{"bug"}}
+		values := map[string]string{"Status": "Todo"}
+
+		item := NewProjectItemInfo(info, values)
+		values["Status"] = "Done"
+
+		assert.Equal(t, "I_123", item.ContentID)
+		assert.Equal(t, "Issue", item.Type)
+		assert.Equal(t, "octocat/api", *item.Repository)
+		assert.Equal(t, []string{"bug"}, item.Labels)
+		assert.Equal(t, map[string]string{"Status": "Todo"}, item.FieldValues)
+	})
+}
+
+func TestFormatFieldValue(t *testing.T) {
+	t.Run("Formats number values without trailing zeros", func(t *testing.T) {
+		number := 3.0
+		assert.Equal(t, "3", FormatFieldValue(&graphql.ProjectV2ItemFieldValue{NumberValue: &number}))
+	})
+
+	t.Run("Empty value formats as empty string", func(t *testing.T) {
+		assert.Equal(t, "", FormatFieldValue(&graphql.ProjectV2ItemFieldValue{}))
+	})
+}
+
+func TestFindProjectItem(t *testing.T) {
+	items := testProjectItems()
+
+	t.Run("Find by project item ID", func(t *testing.T) {
+		item, err := FindProjectItem(items, "item-3")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Write release notes", item.Title)
+	})
+
+	t.Run("Find by issue reference", func(t *testing.T) {
+		item, err := FindProjectItem(items, "octocat/api#1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "item-1", item.ItemID)
+	})
+
+	t.Run("Unknown item returns error", func(t *testing.T) {
+		_, err := FindProjectItem(items, "octocat/api#99")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "not found in project")
+	})
+}
+
+func TestChangedFieldValues(t *testing.T) {
+	t.Run("Identical values report no changes", func(t *testing.T) {
+		values := map[string]string{"Status": "Todo"}
+
+		assert.Empty(t, ChangedFieldValues(values, map[string]string{"Status": "Todo"}))
+	})
+
+	t.Run("Changed, removed and added fields are reported", func(t *testing.T) {
+		before := map[string]string{"Status": "Todo", "Priority": "High"}
+		after := map[string]string{"Status": "Done", "Estimate": "3"}
+
+		assert.Equal(t, []string{"Estimate", "Priority", "Status"}, ChangedFieldValues(before, after))
+	})
+}
+
+func TestParseNumberRange(t *testing.T) {
+	t.Run("Parses ranges and single numbers", func(t *testing.T) {
+		numbers, err := ParseNumberRange("34-37")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{34, 35, 36, 37}, numbers)
+
+		numbers, err = ParseNumberRange("12")
+		assert.NoError(t, err)
+		assert.Equal(t, []int{12}, numbers)
+	})
+
+	t.Run("Rejects invalid ranges", func(t *testing.T) {
+		for _, rangeStr := range []string{"a-3", "3-b", "5-2", "1-2-3", "x"} {
+			_, err := ParseNumberRange(rangeStr)
+			assert.Error(t, err, rangeStr)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// readOnlyFieldNames lists built-in fields whose values come from the issue or pull request
+// itself and cannot be set on a project item
+var readOnlyFieldNames = []string{
+	"Title", "Assignees", "Labels", "Linked pull requests", "Milestone",
+	"Repository", "Reviewers", "Parent issue", "Sub-issues progress",
+}
+
+// FieldMapping describes how field values are translated between projects.
+//
+// Example mapping file:
+//
+//	fields:
+//	  Status: Stage        # source field -> destination field
+//	values:
+//	  Status:
+//	    In Review: Review  # source option -> destination option
+type FieldMapping struct {
+	Fields map[string]string            `json:"fields" yaml:"fields"`
+	Values map[string]map[string]string `json:"values" yaml:"values"`
+}
+
+// TransferResult represents the result of copying or moving items between projects
+type TransferResult struct {
+	Errors   []string
+	Warnings []string
+	Copied   int
+	Removed  int
+	Failed   int
+	// NotRemoved counts moved items that were copied but are still in the source project
+	NotRemoved int
+}
+
+// LoadFieldMapping reads a field mapping from a YAML or JSON file
+func LoadFieldMapping(path string) (*FieldMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping file: %w", err)
+	}
+
+	mapping := &FieldMapping{}
+	if err := yaml.Unmarshal(data, mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping file: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// IsReadOnlyField reports whether a field is a built-in field that cannot be set on items
+func IsReadOnlyField(name string) bool {
+	return containsFold(readOnlyFieldNames, name)
+}
+
+// MapFieldValues translates an item's field values (by field name) into destination field
+// values keyed by field ID. Values that cannot be mapped are reported as warnings.
+func MapFieldValues(values map[string]string, fields []graphql.ProjectV2Field, mapping *FieldMapping) (map[string]interface{}, []string) {
+	mapped := make(map[string]interface{}, len(values))
+	var warnings []string
+
+	for name, value := range values {
+		if IsReadOnlyField(name) {
+			continue
+		}
+
+		targetName := name
+		targetValue := value
+		if mapping != nil {
+			if renamed, ok := lookupFold(mapping.Fields, name); ok {
+				targetName = renamed
+			}
+			if options, ok := lookupFoldValues(mapping.Values, name); ok {
+				if renamed, ok := lookupFold(options, value); ok {
+					targetValue = renamed
+				}
+			}
+		}
+
+		if targetName == "" {
+			continue
+		}
+
+		field, err := FindProjectField(fields, targetName)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+
+		fieldValue, err := BuildFieldValue(field, targetValue)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+
+		mapped[field.ID] = fieldValue
+	}
+
+	return mapped, warnings
+}
+
+// CopyItem adds an item's content to another project, recreating drafts, and sets field values
+func (s *ItemService) CopyItem(ctx context.Context, projectID string, item *ProjectItemInfo, fieldValues map[string]interface{}) (*graphql.ProjectV2Item, error) {
+	var copied *graphql.ProjectV2Item
+	var err error
+
+	if item.ContentID != "" {
+		copied, err = s.AddItemToProject(ctx, projectID, item.ContentID)
+	} else {
+		copied, err = s.CreateDraftIssue(ctx, projectID, item.Title, item.Body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	projectService := NewProjectService(s.client)
+	for fieldID, value := range fieldValues {
+		_, err = projectService.UpdateItemField(ctx, UpdateItemFieldInput{
+			ProjectID: projectID,
+			ItemID:    copied.ID,
+			FieldID:   fieldID,
+			Value:     value,
+		})
+		if err != nil {
+			return copied, fmt.Errorf("failed to set field value: %w", err)
+		}
+	}
+
+	return copied, nil
+}
+
+// TransferItems copies items into another project. When move is true, each item is removed
+// from the source project only once it has been copied with all of its field values.
+func (s *ItemService) TransferItems(ctx context.Context, srcProjectID string, dstProject *graphql.ProjectV2,
+	items []ProjectItemInfo, mapping *FieldMapping, move bool) *TransferResult {
+	result := &TransferResult{}
+
+	for i := range items {
+		item := &items[i]
+
+		fieldValues, warnings := MapFieldValues(item.FieldValues, dstProject.Fields.Nodes, mapping)
+		for _, warning := range warnings {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s", item.Title, warning))
+		}
+
+		if _, err := s.CopyItem(ctx, dstProject.ID, item, fieldValues); err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", item.Title, err))
+			continue
+		}
+		result.Copied++
+
+		if !move {
+			continue
+		}
+
+		if len(warnings) > 0 {
+			result.NotRemoved++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: copied but kept in source because some field values could not be mapped", item.Title))
+			continue
+		}
+
+		if err := s.RemoveItemFromProject(ctx, srcProjectID, item.ItemID); err != nil {
+			result.NotRemoved++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: copied but not removed from source: %v", item.Title, err))
+			continue
+		}
+		result.Removed++
+	}
+
+	return result
+}
+
+func lookupFold(values map[string]string, key string) (string, bool) {
+	if value, ok := values[key]; ok {
+		return value, true
+	}
+	for k, value := range values {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func lookupFoldValues(values map[string]map[string]string, key string) (map[string]string, bool) {
+	if value, ok := values[key]; ok {
+		return value, true
+	}
+	for k, value := range values {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+	return nil, false
+}
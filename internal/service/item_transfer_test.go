@@ -0,0 +1,81 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFieldValues(t *testing.T) {
+	t.Run("Map values by field and option name", func(t *testing.T) {
+		values := map[string]string{"Status": "Done", "Estimate": "3", "Title": "Fix bug"}
+
+		mapped, warnings := MapFieldValues(values, testProjectFields(), nil)
+
+		assert.Empty(t, warnings)
+		assert.Len(t, mapped, 2)
+		assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "opt-done"}, mapped["field-status"])
+		assert.Equal(t, map[string]interface{}{"number": 3.0}, mapped["field-estimate"])
+	})
+
+	t.Run("Mapping renames fields and options", func(t *testing.T) {
+		values := map[string]string{"Stage": "Finished"}
+		mapping := &FieldMapping{
+			Fields: map[string]string{"Stage": "Status"},
+			Values: map[string]map[string]string{"stage": {"finished": "Done"}},
+		}
+
+		mapped, warnings := MapFieldValues(values, testProjectFields(), mapping)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "opt-done"}, mapped["field-status"])
+	})
+
+	t.Run("Fields mapped to empty names are skipped", func(t *testing.T) {
+		values := map[string]string{"Team": "Backend"}
+		mapping := &FieldMapping{Fields: map[string]string{"Team": ""}}
+
+		mapped, warnings := MapFieldValues(values, testProjectFields(), mapping)
+
+		assert.Empty(t, warnings)
+		assert.Empty(t, mapped)
+	})
+
+	t.Run("Unmappable values produce warnings", func(t *testing.T) {
+		values := map[string]string{"Team": "Backend", "Status": "Blocked"}
+
+		mapped, warnings := MapFieldValues(values, testProjectFields(), nil)
+
+		assert.Empty(t, mapped)
+		assert.Len(t, warnings, 2)
+	})
+}
+
+func TestLoadFieldMapping(t *testing.T) {
+	t.Run("Load YAML mapping file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mapping.yaml")
+		content := "fields:\n  Stage: Status\nvalues:\n  Stage:\n    In Review: Review\n"
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		mapping, err := LoadFieldMapping(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Status", mapping.Fields["Stage"])
+		assert.Equal(t, "Review", mapping.Values["Stage"]["In Review"])
+	})
+
+	t.Run("Missing file returns error", func(t *testing.T) {
+		_, err := LoadFieldMapping(filepath.Join(t.TempDir(), "missing.yaml"))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read mapping file")
+	})
+}
+
+func TestIsReadOnlyField(t *testing.T) {
+	assert.True(t, IsReadOnlyField("title"))
+	assert.True(t, IsReadOnlyField("Linked pull requests"))
+	assert.False(t, IsReadOnlyField("Status"))
+}
@@ -507,6 +507,63 @@ func (s *ProjectService) AddItem(ctx context.Context, input AddItemInput) (*grap
 	return &mutation.AddProjectV2ItemByID.Item, nil
 }
 
+// ListProjectItems lists all items in a project, following pagination
+func (s *ProjectService) ListProjectItems(ctx context.Context, projectID string) ([]graphql.ProjectV2Item, error) {
+	var items []graphql.ProjectV2Item
+	var after *string
+
+	for {
+		variables := graphql.BuildListProjectItemsVariables(graphql.ListProjectItemsOptions{
+			ProjectID: projectID,
+			First:     projectItemsPageSize,
+			After:     after,
+		})
+
+		var query graphql.ListProjectItemsQuery
+		err := s.client.Query(ctx, &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list project items: %w", err)
+		}
+
+		page := query.Node.ProjectV2.Items
+		items = append(items, page.Nodes...)
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor := page.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	// Items are listed with their first field values only
+	for i := range items {
+		if err := s.listRemainingFieldValues(ctx, &items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// listRemainingFieldValues pages the field values of an item past the ones already loaded
+func (s *ProjectService) listRemainingFieldValues(ctx context.Context, item *graphql.ProjectV2Item) error {
+	values := &item.FieldValues
+	for values.PageInfo.HasNextPage {
+		cursor := values.PageInfo.EndCursor
+		variables := graphql.BuildListItemFieldValuesVariables(item.ID, itemFieldValuesPageSize, &cursor)
+
+		var query graphql.ListItemFieldValuesQuery
+		if err := s.client.Query(ctx, &query, variables); err != nil {
+			return fmt.Errorf("failed to list field values of item %s: %w", item.ID, err)
+		}
+
+		page := query.Node.ProjectV2Item.FieldValues
+		values.Nodes = append(values.Nodes, page.Nodes...)
+		values.PageInfo = page.PageInfo
+	}
+	return nil
+}
+
 // UpdateItemFieldInput represents input for updating an item field
 type UpdateItemFieldInput struct {
 	Value     interface{}
@@ -533,6 +590,30 @@ func (s *ProjectService) UpdateItemField(ctx context.Context, input UpdateItemFi
 	return &mutation.UpdateProjectV2ItemFieldValue.ProjectV2Item, nil
 }
 
+// ClearItemFieldInput represents input for clearing an item field value
+type ClearItemFieldInput struct {
+	ProjectID string
+	ItemID    string
+	FieldID   string
+}
+
+// ClearItemField clears a field value of an item
+func (s *ProjectService) ClearItemField(ctx context.Context, input ClearItemFieldInput) error {
+	variables := graphql.BuildClearItemFieldVariables(graphql.ClearItemFieldInput{
+		ProjectID: input.ProjectID,
+		ItemID:    input.ItemID,
+		FieldID:   input.FieldID,
+	})
+
+	var mutation graphql.ClearItemFieldMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to clear item field: %w", err)
+	}
+
+	return nil
+}
+
 // RemoveItemInput represents input for removing an item from a project
 type RemoveItemInput struct {
 	ProjectID string
@@ -584,6 +665,16 @@ func FormatProjectReference(owner string, number int) string {
 	return fmt.Sprintf("%s/%d", owner, number)
 }
 
+// ProjectViewURL returns the URL of a project view
+func ProjectViewURL(projectURL string, viewNumber int) string {
+	return fmt.Sprintf("%s/views/%d", strings.TrimSuffix(projectURL, "/"), viewNumber)
+}
+
+// ProjectSettingsURL returns the URL of a project's settings page, where its fields are managed
+func ProjectSettingsURL(projectURL string) string {
+	return strings.TrimSuffix(projectURL, "/") + "/settings"
+}
+
 // parseProjectID parses project ID in format "owner/number"
 func parseProjectID(projectID string) (owner string, number int, err error) {
 	return ParseProjectReference(projectID)
@@ -192,3 +192,15 @@ func TestProjectServiceMethods(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestProjectURLs(t *testing.T) {
+	t.Run("ProjectViewURL appends the view number", func(t *testing.T) {
+		url := ProjectViewURL("https://github.com/orgs/myorg/projects/5", 2)
+		assert.Equal(t, "https://github.com/orgs/myorg/projects/5/views/2", url)
+	})
+
+	t.Run("ProjectSettingsURL handles trailing slash", func(t *testing.T) {
+		url := ProjectSettingsURL("https://github.com/users/octocat/projects/1/")
+		assert.Equal(t, "https://github.com/users/octocat/projects/1/settings", url)
+	})
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/roboco-io/gh-project-cli/internal/api/graphql"
+)
+
+// IssueTreeNode represents an issue and its sub-issues
+type IssueTreeNode struct {
+	ID        string
+	Reference string
+	Title     string
+	State     string
+	URL       string
+	Status    string
+	Children  []*IssueTreeNode
+	Completed int
+	Total     int
+	InProject bool
+}
+
+// GetIssueHierarchy retrieves an issue with its parent and direct sub-issues
+func (s *IssueService) GetIssueHierarchy(ctx context.Context, issueID string) (*graphql.GetIssueHierarchyQuery, error) {
+	variables := graphql.BuildGetIssueHierarchyVariables(issueID)
+
+	var query graphql.GetIssueHierarchyQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	if query.Node.Issue.ID == "" {
+		return nil, fmt.Errorf("issue %s not found", issueID)
+	}
+
+	return &query, nil
+}
+
+// GetIssueTree retrieves the sub-issue tree below an issue, up to maxIssueTreeDepth levels
+func (s *IssueService) GetIssueTree(ctx context.Context, issueID string) (*IssueTreeNode, error) {
+	hierarchy, err := s.GetIssueHierarchy(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	root := issueTreeNode(&hierarchy.Node.Issue.IssueNode)
+	visited := map[string]bool{root.ID: true}
+	if err := s.loadSubIssues(ctx, root, hierarchy.Node.Issue.SubIssues.Nodes, 1, visited); err != nil {
+		return nil, err
+	}
+
+	RollUpIssueTree(root)
+	return root, nil
+}
+
+func (s *IssueService) loadSubIssues(ctx context.Context, parent *IssueTreeNode, subIssues []graphql.IssueNode, depth int, visited map[string]bool) error {
+	for i := range subIssues {
+		child := issueTreeNode(&subIssues[i])
+		if visited[child.ID] {
+			continue
+		}
+		visited[child.ID] = true
+		parent.Children = append(parent.Children, child)
+
+		if subIssues[i].SubIssuesSummary.Total == 0 || depth >= maxIssueTreeDepth {
+			continue
+		}
+
+		hierarchy, err := s.GetIssueHierarchy(ctx, child.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.loadSubIssues(ctx, child, hierarchy.Node.Issue.SubIssues.Nodes, depth+1, visited); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddSubIssue makes an issue a sub-issue of a parent issue
+func (s *IssueService) AddSubIssue(ctx context.Context, parentID, subIssueID string, replaceParent bool) error {
+	variables := graphql.BuildAddSubIssueVariables(graphql.AddSubIssueInput{
+		IssueID:       parentID,
+		SubIssueID:    subIssueID,
+		ReplaceParent: replaceParent,
+	})
+
+	var mutation graphql.AddSubIssueMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to add sub-issue: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveSubIssue removes an issue from its parent issue
+func (s *IssueService) RemoveSubIssue(ctx context.Context, parentID, subIssueID string) error {
+	variables := graphql.BuildRemoveSubIssueVariables(graphql.RemoveSubIssueInput{
+		IssueID:    parentID,
+		SubIssueID: subIssueID,
+	})
+
+	var mutation graphql.RemoveSubIssueMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to remove sub-issue: %w", err)
+	}
+
+	return nil
+}
+
+// RollUpIssueTree computes completion counts over all descendants of each node.
+// Closed issues count as completed.
+func RollUpIssueTree(node *IssueTreeNode) {
+	node.Completed = 0
+	node.Total = 0
+
+	for _, child := range node.Children {
+		RollUpIssueTree(child)
+		node.Total += child.Total + 1
+		node.Completed += child.Completed
+		if child.State == "CLOSED" {
+			node.Completed++
+		}
+	}
+}
+
+// AnnotateIssueTree sets each node's project status from the given project items.
+// Nodes whose issue is not in the project are left without a status.
+func AnnotateIssueTree(node *IssueTreeNode, items []ProjectItemInfo, statusField string) {
+	byContent := make(map[string]*ProjectItemInfo, len(items))
+	for i := range items {
+		if items[i].ContentID != "" {
+			byContent[items[i].ContentID] = &items[i]
+		}
+	}
+
+	annotateIssueTree(node, byContent, statusField)
+}
+
+func annotateIssueTree(node *IssueTreeNode, byContent map[string]*ProjectItemInfo, statusField string) {
+	if item, ok := byContent[node.ID]; ok {
+		node.InProject = true
+		node.Status = fieldValue(item, statusField)
+	}
+
+	for _, child := range node.Children {
+		annotateIssueTree(child, byContent, statusField)
+	}
+}
+
+func issueTreeNode(issue *graphql.IssueNode) *IssueTreeNode {
+	return &IssueTreeNode{
+		ID:        issue.ID,
+		Reference: fmt.Sprintf("%s#%d", issue.Repository.NameWithOwner, issue.Number),
+		Title:     issue.Title,
+		State:     issue.State,
+		URL:       issue.URL,
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testIssueTree() *IssueTreeNode {
+	return &IssueTreeNode{
+		ID:        "I_1",
+		Reference: "octocat/api#1",
+		State:     "OPEN",
+		Children: []*IssueTreeNode{
+			{
+				ID:        "I_2",
+				Reference: "octocat/api#2",
+				State:     "OPEN",
+				Children: []*IssueTreeNode{
+					{ID: "I_4", Reference: "octocat/api#4", State: "CLOSED"},
+					{ID: "I_5", Reference: "octocat/api#5", State: "OPEN"},
+				},
+			},
+			{ID: "I_3", Reference: "octocat/api#3", State: "CLOSED"},
+		},
+	}
+}
+
+func TestRollUpIssueTree(t *testing.T) {
+	t.Run("Counts closed issues over all descendants", func(t *testing.T) {
+		tree := testIssueTree()
+
+		RollUpIssueTree(tree)
+
+		assert.Equal(t, 4, tree.Total)
+		assert.Equal(t, 2, tree.Completed)
+		assert.Equal(t, 2, tree.Children[0].Total)
+		assert.Equal(t, 1, tree.Children[0].Completed)
+	})
+
+	t.Run("Leaf issues have no roll-up", func(t *testing.T) {
+		tree := testIssueTree()
+
+		RollUpIssueTree(tree)
+
+		assert.Equal(t, 0, tree.Children[1].Total)
+		assert.Equal(t, 0, tree.Children[1].Completed)
+	})
+}
+
+func TestAnnotateIssueTree(t *testing.T) {
+	items := []ProjectItemInfo{
+		{ItemID: "item-1", ContentID: "I_1", FieldValues: map[string]string{"Status": "In Progress"}},
+		{ItemID: "item-4", ContentID: "I_4", FieldValues: map[string]string{"Status": "Done"}},
+		{ItemID: "item-5", ContentID: "I_5", FieldValues: map[string]string{}},
+	}
+
+	t.Run("Sets status for issues in the project", func(t *testing.T) {
+		tree := testIssueTree()
+
+		AnnotateIssueTree(tree, items, "status")
+
+		assert.True(t, tree.InProject)
+		assert.Equal(t, "In Progress", tree.Status)
+		assert.Equal(t, "Done", tree.Children[0].Children[0].Status)
+	})
+
+	t.Run("Issues outside the project are not marked", func(t *testing.T) {
+		tree := testIssueTree()
+
+		AnnotateIssueTree(tree, items, "Status")
+
+		assert.False(t, tree.Children[1].InProject)
+		assert.True(t, tree.Children[0].Children[1].InProject)
+		assert.Equal(t, "", tree.Children[0].Children[1].Status)
+	})
+}
@@ -31,7 +31,9 @@ type ViewInfo struct {
 	ProjectName string
 	GroupBy     []ViewGroupByInfo
 	SortBy      []ViewSortByInfo
-	Number      int
+	// VerticalGroupBy holds the fields a board view uses for its columns
+	VerticalGroupBy []ViewGroupByInfo
+	Number          int
 }
 
 // ViewGroupByInfo represents group by configuration information
@@ -203,15 +205,6 @@ func (s *ViewService) GetProjectViews(ctx context.Context, projectID string) ([]
 	views := make([]ViewInfo, len(query.Node.ProjectV2.Views.Nodes))
 	for i := range query.Node.ProjectV2.Views.Nodes {
 		view := &query.Node.ProjectV2.Views.Nodes[i]
-		groupBy := make([]ViewGroupByInfo, len(view.GroupBy))
-		for j, gb := range view.GroupBy {
-			groupBy[j] = ViewGroupByInfo{
-				FieldID:   gb.Field.ID,
-				FieldName: gb.Field.Name,
-				Direction: gb.Direction,
-			}
-		}
-
 		sortBy := make([]ViewSortByInfo, len(view.SortBy))
 		for j, sb := range view.SortBy {
 			sortBy[j] = ViewSortByInfo{
@@ -222,14 +215,15 @@ func (s *ViewService) GetProjectViews(ctx context.Context, projectID string) ([]
 		}
 
 		views[i] = ViewInfo{
-			ID:        view.ID,
-			Name:      view.Name,
-			Layout:    view.Layout,
-			Number:    view.Number,
-			Filter:    view.Filter,
-			ProjectID: projectID,
-			GroupBy:   groupBy,
-			SortBy:    sortBy,
+			ID:              view.ID,
+			Name:            view.Name,
+			Layout:          view.Layout,
+			Number:          view.Number,
+			Filter:          view.Filter,
+			ProjectID:       projectID,
+			GroupBy:         convertViewGroupBy(view.GroupBy),
+			SortBy:          sortBy,
+			VerticalGroupBy: convertViewGroupBy(view.VerticalGroupBy),
 		}
 	}
 
@@ -250,15 +244,6 @@ func (s *ViewService) GetView(ctx context.Context, viewID string) (*ViewInfo, er
 
 	view := query.Node.ProjectV2View
 
-	groupBy := make([]ViewGroupByInfo, len(view.GroupBy))
-	for i, gb := range view.GroupBy {
-		groupBy[i] = ViewGroupByInfo{
-			FieldID:   gb.Field.ID,
-			FieldName: gb.Field.Name,
-			Direction: gb.Direction,
-		}
-	}
-
 	sortBy := make([]ViewSortByInfo, len(view.SortBy))
 	for i, sb := range view.SortBy {
 		sortBy[i] = ViewSortByInfo{
@@ -269,18 +254,31 @@ func (s *ViewService) GetView(ctx context.Context, viewID string) (*ViewInfo, er
 	}
 
 	viewInfo := &ViewInfo{
-		ID:      view.ID,
-		Name:    view.Name,
-		Layout:  view.Layout,
-		Number:  view.Number,
-		Filter:  view.Filter,
-		GroupBy: groupBy,
-		SortBy:  sortBy,
+		ID:              view.ID,
+		Name:            view.Name,
+		Layout:          view.Layout,
+		Number:          view.Number,
+		Filter:          view.Filter,
+		GroupBy:         convertViewGroupBy(view.GroupBy),
+		SortBy:          sortBy,
+		VerticalGroupBy: convertViewGroupBy(view.VerticalGroupBy),
 	}
 
 	return viewInfo, nil
 }
 
+func convertViewGroupBy(groupBy []graphql.ProjectV2ViewGroupBy) []ViewGroupByInfo {
+	infos := make([]ViewGroupByInfo, len(groupBy))
+	for i, gb := range groupBy {
+		infos[i] = ViewGroupByInfo{
+			FieldID:   gb.Field.ID,
+			FieldName: gb.Field.Name,
+			Direction: gb.Direction,
+		}
+	}
+	return infos
+}
+
 // ValidateViewName validates a view name
 func ValidateViewName(name string) error {
 	if strings.TrimSpace(name) == "" {